@@ -0,0 +1,103 @@
+package archive
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+)
+
+// MaxBlocks is the number of blocks of an era, and so the maximum number of blocks of an era1 archive
+const MaxBlocks = 8192
+
+// accumulatorDepth is the depth of the merkle tree of the accumulator, log2(MaxBlocks)
+const accumulatorDepth = 13
+
+// headerRecord is an accumulated block
+type headerRecord struct {
+	hash            []byte
+	totalDifficulty *big.Int
+}
+
+// Accumulator returns the root of the accumulator of blocks, given their hashes and total difficulties.  It is the
+// SSZ hash_tree_root of a List[HeaderRecord, 8192], each HeaderRecord being a container of the block hash and the
+// total difficulty as an uint256.
+func Accumulator(hashes []eth.Hash, totalDifficulties []eth.Quantity) (*eth.Hash, error) {
+	if len(hashes) != len(totalDifficulties) {
+		return nil, errors.New("hashes and total difficulties must have the same length")
+	}
+
+	records := make([]headerRecord, len(hashes))
+	for i := range hashes {
+		records[i] = headerRecord{hash: hashes[i].Bytes(), totalDifficulty: totalDifficulties[i].Big()}
+	}
+
+	return accumulator(records)
+}
+
+func accumulator(records []headerRecord) (*eth.Hash, error) {
+	if len(records) > MaxBlocks {
+		return nil, errors.Errorf("cannot accumulate more than %d blocks", MaxBlocks)
+	}
+
+	nodes := make([][]byte, len(records))
+	for i, r := range records {
+		td, err := uint256(r.totalDifficulty)
+		if err != nil {
+			return nil, err
+		}
+		nodes[i] = hashPair(r.hash, td)
+	}
+
+	// merkleize the records, padding the tree to MaxBlocks leaves with zero hashes
+	zero := make([]byte, 32)
+	for depth := 0; depth < accumulatorDepth; depth++ {
+		if len(nodes)%2 == 1 {
+			nodes = append(nodes, zero)
+		}
+		parents := make([][]byte, len(nodes)/2)
+		for i := range parents {
+			parents[i] = hashPair(nodes[2*i], nodes[2*i+1])
+		}
+		nodes = parents
+		zero = hashPair(zero, zero)
+	}
+
+	root := zero
+	if len(nodes) > 0 {
+		root = nodes[0]
+	}
+
+	// mix in the length of the list
+	length := make([]byte, 32)
+	binary.LittleEndian.PutUint64(length, uint64(len(records)))
+
+	hash := eth.Hash("0x" + hex.EncodeToString(hashPair(root, length)))
+	return &hash, nil
+}
+
+func hashPair(a, b []byte) []byte {
+	h := sha256.New()
+	h.Write(a)
+	h.Write(b)
+	return h.Sum(nil)
+}
+
+// uint256 returns the 32 byte little endian SSZ encoding of i
+func uint256(i *big.Int) ([]byte, error) {
+	if i.Sign() < 0 || i.BitLen() > 256 {
+		return nil, errors.Errorf("%s is not an uint256", i.String())
+	}
+
+	b := make([]byte, 32)
+	i.FillBytes(b)
+	for l, r := 0, len(b)-1; l < r; l, r = l+1, r-1 {
+		b[l], b[r] = b[r], b[l]
+	}
+
+	return b, nil
+}
@@ -0,0 +1,337 @@
+package archive_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/archive"
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+	"github.com/INFURA/go-ethlibs/rlp"
+)
+
+// from https://github.com/ethereum/tests/pull/774, a block with legacy and EIP-2930 transactions
+const raw = `0xf90d8ff901faa096ac1b3e915bd001d4d376ce2dbd58a4f9b509c2d76c5497e8c139e21b146382a01dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347948888f1f195afa192cfee860698584c030f4c9db1a0b925e41b8e6fa7125c4069ae207154bee1428899001a64b8d0481cf3ac92538ba0a84557edb533df722059565f40ccf352a0c3dd11934edbeb183161870c6f913ba04668fa49741587e589d605362546a9692767bb11779a846dd170e4fd62a41e0bb90100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008302000001832fefd88309304f8454c9906942a00000000000000000000000000000000000000000000000000000000000000000880000000000000000f90b8ef8a580018307a12094cccccccccccccccccccccccccccccccccccccccc80b8441a8451e6000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000010001ba04567290fa11724f00229e0ce2349dc28536ef988fa2cb57dcfe54576e0c316fba07928f94f58329ca04c6bc69905353d4763b1f17be3e8c3b4387016fbd7228e0db8e301f8e00101018307a12094cccccccccccccccccccccccccccccccccccccccc80b8441a8451e600000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccce1a0000000000000000000000000000000000000000000000000000000000000100001a0b71416e5476a8260406890715ddb9da18fb50ca92d248d4cf686dec725432ab8a06845cb1afbd983b87a8bdd87febab82e354386a35ac140a8e28ff77ae735eb3ff8a502018307a12094cccccccccccccccccccccccccccccccccccccccc80b8441a8451e6000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000000000000000000000000000000010001ba05b5870e375488507dff081c9bfe77435b7859d9c20b77c772959d515501e19c5a06abec2c2fdb3fff9bbe2a34c43162734dc2bcec39960e8baef5c277563453e07f8a503018307a12094cccccccccccccccccccccccccccccccccccccccd80b8441a8451e6000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000010001ca0f5f275c0826c59086d0c92d7c18bf3d0692f46d0a90b2fb08ae2c1ebc81130f1a0744fe24f012ab11dc67e5746a09f4d68622a5a466173045dfb84cac65490a5c5b8e301f8e00104018307a12094cccccccccccccccccccccccccccccccccccccccd80b8441a8451e600000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccde1a0000000000000000000000000000000000000000000000000000000000000100001a053379eefc1df12b6023838bb2c9167a355429abddf1aff874811d9bd2ab0fdeaa02615c47a2f30ba411fb69365bc037988ac2a7e4adb8b828fda5edb3c69e6a72af8a505018307a12094cccccccccccccccccccccccccccccccccccccccd80b8441a8451e6000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000000000000000000000000000000010001ba0be3de14d31bb7aaa2e985b8cd1631133a0c2c6fc5d2c1f4a90c8274bdcf3f619a050ca5c6909abb83fcf3fdfd6eea363ce38c25a83a9f65d77fe3e7e9a2d0b9f85b8e301f8e00106018307a12094cccccccccccccccccccccccccccccccccccccccd80b8441a8451e600000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccde1a0000000000000000000000000000000000000000000000000000000000000100080a0cd634a8f7342eea1cf6e504cb3a1f19701589be89e13cf153d77975c3be08d53a0186872642b07e076c95e6099b505be0a7c3a5c9bba3c6e716cc13efde86464bcb8e301f8e00107018307a12094cccccccccccccccccccccccccccccccccccccccd80b8441a8451e600000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000001000f838f794fccccccccccccccccccccccccccccccccccccccde1a0000000000000000000000000000000000000000000000000000000000000100080a0890553652792898fcc3fae23cdc145ae95509ac98f570705f711fc8b084b37d4a023e3468186d5d7e026beff1691d87af2fd82f64111f21e426f0648794f5a578bb8e301f8e00108018307a12094cccccccccccccccccccccccccccccccccccccccd80b84462ac2e9300000000000000000000000000000000000000000000000000000000000000050000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccde1a0000000000000000000000000000000000000000000000000000000000000100001a055caaa704de57dbd5658d4c4edaa7803d0fae81cc269489d4f3d7340ca707a04a043f0cf469823a0e77cd9b784d88c1c99afbf99ef5d8584320cf3304dc439b9cdb8e301f8e00109018307a12094cccccccccccccccccccccccccccccccccccccccd80b8441a8451e600000000000000000000000000000000000000000000000000000000000000050000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccde1a0000000000000000000000000000000000000000000000000000000000000100180a0e712707b085ca0dfa530ec63604815f1d44f7075f01f359614b5472924401751a02466db4f42b1e9923983ddc85341f1985e478f796baea2594b5e748117fef56fb8e301f8e0010a018307a12094cccccccccccccccccccccccccccccccccccccccc80b8441a8451e600000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccce1a0000000000000000000000000000000000000000000000000000000000000100001a058c583d6bdccf3cc1741e8bfbc9770d79d57eb34ddde07c88bd6a6a16f599f42a06eed5c876a6dd55dc8cbec7c9f2d01d4dd84ce38f56265fa6e20bef6824c4d71b8e301f8e0010b018307a12094cccccccccccccccccccccccccccccccccccccccc80b8441a8451e600000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000001000f838f794fccccccccccccccccccccccccccccccccccccccce1a0000000000000000000000000000000000000000000000000000000000000100001a0e7d97ba7ba5dd3295ac2633ff5213fe4f9c64856369dc7490264be04a8379630a06095f7d241d71cbabff508d3d82b7bc5638e06476264eff74a2b72d3aa0810bdb8e301f8e0010c018307a12094cccccccccccccccccccccccccccccccccccccccc80b8441a8451e600000000000000000000000000000000000000000000000000000000000000050000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccce1a0000000000000000000000000000000000000000000000000000000000000100001a051a2060ab24346f7a8f938076a0521a8dc337e3393f2120e5e10c1b8e0ee43f0a025e055b9d424144549c6ecea89596007457b772247f2fd783d9d7838dbe2edddb8e301f8e0010d018307a12094cccccccccccccccccccccccccccccccccccccccc80b8441a8451e600000000000000000000000000000000000000000000000000000000000000050000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccce1a0000000000000000000000000000000000000000000000000000000000000100101a04c4700cb2f9b3f36770cbcf5233315508e72361c94c99e93df8274e5fe56d7b1a045cf63a2455721c18df66576071e1a3a9a2646841ad5058b76bfb52b9d78d51dc0`
+
+type fixture struct {
+	block    eth.Block
+	uncles   []eth.Uncle
+	receipts []eth.TransactionReceipt
+}
+
+// hash returns the keccak hash of the RLP encoded header
+func hash(t *testing.T, raw *eth.Data) eth.Hash {
+	item, err := rlp.Decode(raw.Bytes())
+	require.NoError(t, err)
+	return eth.Hash(fmt.Sprintf("0x%x", item.Hash()))
+}
+
+// fixtures returns count consecutive blocks derived from the raw block, the second one having an uncle
+func fixtures(t *testing.T, count int) []fixture {
+	base := eth.Block{}
+	require.NoError(t, base.FromRaw(raw))
+
+	fixtures := make([]fixture, count)
+	parent := base.ParentHash
+	for i := range fixtures {
+		f := &fixtures[i]
+		f.block = base
+		number := eth.QuantityFromUInt64(base.Number.UInt64() + uint64(i))
+		f.block.Number = &number
+		f.block.ParentHash = parent
+		f.block.TotalDifficulty = eth.QuantityFromInt64(int64(131072 * (i + 1)))
+		f.block.Uncles = []eth.Hash{}
+
+		if i == 1 {
+			uncle := eth.Uncle{
+				Number:           base.Number,
+				ParentHash:       base.ParentHash,
+				SHA3Uncles:       base.SHA3Uncles,
+				StateRoot:        base.StateRoot,
+				TransactionsRoot: base.TransactionsRoot,
+				ReceiptsRoot:     base.ReceiptsRoot,
+				Miner:            base.Miner,
+				Difficulty:       base.Difficulty,
+				GasLimit:         base.GasLimit,
+				Timestamp:        base.Timestamp,
+				ExtraData:        "0x756e636c65",
+				MixHash:          base.MixHash,
+				Nonce:            base.Nonce,
+				LogsBloom:        base.LogsBloom,
+			}
+			uncleRaw, err := uncle.RawRepresentation()
+			require.NoError(t, err)
+			f.uncles = []eth.Uncle{uncle}
+			f.block.Uncles = []eth.Hash{hash(t, uncleRaw)}
+		}
+
+		header, err := f.block.HeaderRawRepresentation()
+		require.NoError(t, err)
+		h := hash(t, header)
+		f.block.Hash = &h
+		parent = h
+
+		cumulative := int64(0)
+		for j, tx := range f.block.Transactions {
+			cumulative += 21000 * int64(j+1)
+			status := eth.QuantityFromInt64(int64(j % 2))
+			receipt := eth.TransactionReceipt{
+				Type:              tx.Type,
+				TransactionHash:   tx.Hash,
+				BlockHash:         h,
+				BlockNumber:       number,
+				From:              tx.From,
+				To:                tx.To,
+				CumulativeGasUsed: eth.QuantityFromInt64(cumulative),
+				LogsBloom:         base.LogsBloom,
+				Status:            &status,
+				Logs:              []eth.Log{},
+			}
+			if j%3 == 0 {
+				receipt.Logs = append(receipt.Logs, eth.Log{
+					Address: *tx.To,
+					Topics:  []eth.Topic{"0x0000000000000000000000000000000000000000000000000000000000000001"},
+					Data:    "0xdeadbeef",
+				})
+			}
+			if tx.TransactionType() == eth.TransactionTypeLegacy {
+				receipt.Type = nil
+			}
+			f.receipts = append(f.receipts, receipt)
+		}
+	}
+
+	return fixtures
+}
+
+func write(t *testing.T, fixtures []fixture) ([]byte, *eth.Hash) {
+	buf := bytes.Buffer{}
+	w := archive.NewWriter(&buf)
+	for i := range fixtures {
+		require.NoError(t, w.Add(&fixtures[i].block, fixtures[i].uncles, fixtures[i].receipts))
+	}
+
+	root, err := w.Finalize()
+	require.NoError(t, err)
+	return buf.Bytes(), root
+}
+
+func TestArchive(t *testing.T) {
+	fixtures := fixtures(t, 3)
+	b, root := write(t, fixtures)
+
+	hashes := make([]eth.Hash, len(fixtures))
+	tds := make([]eth.Quantity, len(fixtures))
+	for i := range fixtures {
+		hashes[i] = *fixtures[i].block.Hash
+		tds[i] = fixtures[i].block.TotalDifficulty
+	}
+	expected, err := archive.Accumulator(hashes, tds)
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	r := archive.NewReader(bytes.NewReader(b))
+	for i := range fixtures {
+		entry, err := r.Next()
+		require.NoError(t, err)
+
+		block := entry.Block
+		require.Equal(t, fixtures[i].block.Hash, block.Hash)
+		require.Equal(t, fixtures[i].block.Number, block.Number)
+		require.Equal(t, fixtures[i].block.TotalDifficulty, block.TotalDifficulty)
+		require.Equal(t, fixtures[i].block.Uncles, block.Uncles)
+		require.NotZero(t, block.Size.UInt64())
+		require.Len(t, block.Transactions, len(fixtures[i].block.Transactions))
+
+		require.Len(t, entry.Receipts, len(block.Transactions))
+		logIndex := int64(0)
+		for j, receipt := range entry.Receipts {
+			tx := block.Transactions[j]
+			require.Equal(t, fixtures[i].block.Transactions[j].Hash, tx.Hash)
+			require.Equal(t, tx.Hash, receipt.TransactionHash)
+			require.Equal(t, int64(j), receipt.TransactionIndex.Int64())
+			require.Equal(t, *block.Hash, receipt.BlockHash)
+			require.Equal(t, tx.From, receipt.From)
+			require.Equal(t, tx.To, receipt.To)
+			require.Equal(t, tx.GasPrice, receipt.EffectiveGasPrice)
+			require.Equal(t, int64(21000*(j+1)), receipt.GasUsed.Int64())
+			require.Equal(t, fixtures[i].receipts[j].Type, receipt.Type)
+			require.Equal(t, fixtures[i].receipts[j].Status, receipt.Status)
+			require.Len(t, receipt.Logs, len(fixtures[i].receipts[j].Logs))
+			for _, log := range receipt.Logs {
+				require.Equal(t, logIndex, log.LogIndex.Int64())
+				require.Equal(t, tx.Hash, *log.TxHash)
+				require.Equal(t, "0xdeadbeef", log.Data.String())
+				logIndex++
+			}
+		}
+	}
+
+	_, err = r.Next()
+	require.Equal(t, io.EOF, err)
+	_, err = r.Next()
+	require.Equal(t, io.EOF, err)
+	accumulator, err := r.Accumulator()
+	require.NoError(t, err)
+	require.Equal(t, root, accumulator)
+
+	require.Equal(t, "mainnet-00001-"+root.String()[2:10]+".era1", archive.Filename("mainnet", 1, *root))
+}
+
+func TestReader_Verify(t *testing.T) {
+	fixtures := fixtures(t, 2)
+	b, _ := write(t, fixtures)
+
+	// the block index is the last entry, preceded by the accumulator
+	index := len(b) - (16 + 8*len(fixtures))
+	accumulator := index - 8 - 32
+
+	for name, corrupt := range map[string]func(b []byte) []byte{
+		"accumulator": func(b []byte) []byte {
+			b[accumulator] ^= 0xff
+			return b
+		},
+		"block offset": func(b []byte) []byte {
+			b[index+8+8] ^= 0x01
+			return b
+		},
+		"starting number": func(b []byte) []byte {
+			b[index] ^= 0x01
+			return b
+		},
+		"count": func(b []byte) []byte {
+			b[len(b)-8] ^= 0x01
+			return b
+		},
+		"truncated": func(b []byte) []byte {
+			return b[:len(b)-1]
+		},
+		"trailing data": func(b []byte) []byte {
+			return append(b, 0x00)
+		},
+		"version": func(b []byte) []byte {
+			b[0] ^= 0x01
+			return b
+		},
+	} {
+		r := archive.NewReader(bytes.NewReader(corrupt(append([]byte{}, b...))))
+		var err error
+		for err == nil {
+			_, err = r.Next()
+		}
+		require.NotEqual(t, io.EOF, err, name)
+		_, err = r.Accumulator()
+		require.Error(t, err, name)
+	}
+}
+
+func TestReader_EntrySizes(t *testing.T) {
+	version := []byte{0x65, 0x32, 0, 0, 0, 0, 0, 0}
+
+	// a compressed header claiming to be 4GiB must be rejected before it is read
+	b := append(append([]byte{}, version...), 0x03, 0, 0xff, 0xff, 0xff, 0xff, 0, 0)
+	_, err := archive.NewReader(bytes.NewReader(b)).Next()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "more than the maximum")
+
+	// as must a small compressed header that decompresses to far more than any header
+	buf := bytes.Buffer{}
+	w := snappy.NewBufferedWriter(&buf)
+	_, err = w.Write(make([]byte, 2<<20))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	header := []byte{0x03, 0, 0, 0, 0, 0, 0, 0}
+	binary.LittleEndian.PutUint32(header[2:6], uint32(buf.Len()))
+	b = append(append(append([]byte{}, version...), header...), buf.Bytes()...)
+	_, err = archive.NewReader(bytes.NewReader(b)).Next()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "decompresses to more than")
+}
+
+func TestWriter_Errors(t *testing.T) {
+	fixtures := fixtures(t, 3)
+	w := archive.NewWriter(io.Discard)
+
+	_, err := w.Finalize()
+	require.Error(t, err)
+
+	// the header must hash to the block hash
+	f := fixtures[0]
+	f.block.GasUsed = eth.QuantityFromInt64(1)
+	require.Error(t, w.Add(&f.block, f.uncles, f.receipts))
+
+	f = fixtures[0]
+	f.block.TotalDifficulty = eth.Quantity{}
+	require.Error(t, w.Add(&f.block, f.uncles, f.receipts))
+
+	f = fixtures[0]
+	require.Error(t, w.Add(&f.block, f.uncles, f.receipts[1:]))
+
+	f = fixtures[1]
+	require.Error(t, w.Add(&f.block, nil, f.receipts))
+
+	require.NoError(t, w.Add(&fixtures[0].block, fixtures[0].uncles, fixtures[0].receipts))
+	require.Error(t, w.Add(&fixtures[2].block, fixtures[2].uncles, fixtures[2].receipts))
+
+	_, err = w.Finalize()
+	require.NoError(t, err)
+	_, err = w.Finalize()
+	require.Error(t, err)
+	require.Error(t, w.Add(&fixtures[1].block, fixtures[1].uncles, fixtures[1].receipts))
+}
+
+func TestExport(t *testing.T) {
+	fixtures := fixtures(t, 2)
+	_, expected := write(t, fixtures)
+
+	results := map[string]interface{}{}
+	for _, f := range fixtures {
+		results["eth_getBlockByNumber"+f.block.Number.String()] = f.block
+		for i := range f.uncles {
+			results["eth_getUncleByBlockHashAndIndex"+f.block.Hash.String()] = f.uncles[i]
+		}
+		for i := range f.receipts {
+			results["eth_getTransactionReceipt"+f.block.Transactions[i].Hash.String()] = f.receipts[i]
+		}
+	}
+
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		var params []interface{}
+		b, err := json.Marshal(r.Params)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(b, &params))
+
+		result, err := json.Marshal(results[r.Method+params[0].(string)])
+		require.NoError(t, err)
+		return nodetest.Result(r, string(result)), nil
+	})
+	client := nodetest.NewClient(t, requester, nil)
+
+	buf := bytes.Buffer{}
+	w := archive.NewWriter(&buf)
+	require.NoError(t, archive.Export(context.Background(), client, w, fixtures[0].block.Number.UInt64(), 2))
+	root, err := w.Finalize()
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	r := archive.NewReader(&buf)
+	for range fixtures {
+		_, err := r.Next()
+		require.NoError(t, err)
+	}
+	_, err = r.Next()
+	require.Equal(t, io.EOF, err)
+
+	// unknown blocks fail the export
+	require.Error(t, archive.Export(context.Background(), client, archive.NewWriter(io.Discard), 100, 1))
+}
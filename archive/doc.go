@@ -0,0 +1,6 @@
+// Package archive reads and writes era1 archives of pre-merge blocks and receipts.
+//
+// Era1 archives are e2store files, a sequence of entries each made of a type, a length and data.  Blocks are stored
+// as snappy framed RLP encodings of their header, body and receipts, followed by their total difficulty.  The
+// archive ends with an accumulator of the block hashes and total difficulties, and an index of the blocks.
+package archive
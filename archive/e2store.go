@@ -0,0 +1,118 @@
+package archive
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+// e2store entry types used by era1 archives, stored little endian in the entry header
+const (
+	typeVersion            uint16 = 0x3265
+	typeCompressedHeader   uint16 = 0x03
+	typeCompressedBody     uint16 = 0x04
+	typeCompressedReceipts uint16 = 0x05
+	typeTotalDifficulty    uint16 = 0x06
+	typeAccumulator        uint16 = 0x07
+	typeBlockIndex         uint16 = 0x3266
+)
+
+// headerSize is the size of e2store entry headers: a 2 byte type, a 4 byte length and 2 reserved bytes
+const headerSize = 8
+
+// Maximum sizes of the (decompressed) block entries.  They are far above what any block allowed by a realistic
+// gas limit needs, but keep a corrupt length field or a decompression bomb from exhausting memory.
+const (
+	maxHeaderSize   = 1 << 20
+	maxBodySize     = 1 << 26
+	maxReceiptsSize = 1 << 26
+)
+
+// maxEntrySize returns the largest entry of type typ that is read into memory, compressed entries are
+// limited to the maximum size of their decompressed data
+func maxEntrySize(typ uint16) int {
+	switch typ {
+	case typeCompressedHeader:
+		return maxHeaderSize
+	case typeCompressedBody:
+		return maxBodySize
+	case typeCompressedReceipts:
+		return maxReceiptsSize
+	case typeTotalDifficulty, typeAccumulator:
+		return 32
+	case typeBlockIndex:
+		return 16 + 8*MaxBlocks
+	default:
+		return 0
+	}
+}
+
+// entryHeader is the header of an e2store entry
+type entryHeader struct {
+	typ    uint16
+	length uint32
+}
+
+// readHeader reads an entry header, returning io.EOF if r ends before it
+func readHeader(r io.Reader) (entryHeader, error) {
+	b := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return entryHeader{}, err
+	}
+
+	if b[6] != 0 || b[7] != 0 {
+		return entryHeader{}, errors.New("reserved bytes of entry header must be zero")
+	}
+
+	return entryHeader{
+		typ:    binary.LittleEndian.Uint16(b[0:2]),
+		length: binary.LittleEndian.Uint32(b[2:6]),
+	}, nil
+}
+
+// writeEntry writes an entry of type typ with data, returning the number of bytes written
+func writeEntry(w io.Writer, typ uint16, data []byte) (int64, error) {
+	if uint64(len(data)) > uint64(^uint32(0)) {
+		return 0, errors.Errorf("entry of %d bytes is too large", len(data))
+	}
+
+	b := make([]byte, headerSize, headerSize+len(data))
+	binary.LittleEndian.PutUint16(b[0:2], typ)
+	binary.LittleEndian.PutUint32(b[2:6], uint32(len(data)))
+	b = append(b, data...)
+
+	n, err := w.Write(b)
+	return int64(n), err
+}
+
+// decompress returns the data of a snappy framed entry, which must not decompress to more than limit bytes
+func decompress(data []byte, limit int) ([]byte, error) {
+	decompressed, err := io.ReadAll(io.LimitReader(snappy.NewReader(bytes.NewReader(data)), int64(limit)+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress entry")
+	}
+
+	if len(decompressed) > limit {
+		return nil, errors.Errorf("entry decompresses to more than %d bytes", limit)
+	}
+
+	return decompressed, nil
+}
+
+// compress returns the snappy framed data of an entry
+func compress(data []byte) ([]byte, error) {
+	buf := bytes.Buffer{}
+	w := snappy.NewBufferedWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
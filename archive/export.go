@@ -0,0 +1,45 @@
+package archive
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/node"
+)
+
+// Export adds count blocks starting at start to w, fetching them along with their uncles and receipts from client.
+// The node must report the total difficulty of blocks, which is the case for pre-merge blocks.
+func Export(ctx context.Context, client node.Client, w *Writer, start, count uint64) error {
+	for number := start; number < start+count; number++ {
+		block, err := client.BlockByNumber(ctx, number, true)
+		if err != nil {
+			return errors.Wrapf(err, "could not fetch block %d", number)
+		}
+
+		uncles := make([]eth.Uncle, len(block.Uncles))
+		for i := range block.Uncles {
+			uncle, err := client.UncleByBlockHashAndIndex(ctx, block.Hash.String(), uint64(i))
+			if err != nil {
+				return errors.Wrapf(err, "could not fetch uncle %d of block %d", i, number)
+			}
+			uncles[i] = *uncle
+		}
+
+		receipts := make([]eth.TransactionReceipt, len(block.Transactions))
+		for i := range block.Transactions {
+			receipt, err := client.TransactionReceipt(ctx, block.Transactions[i].Hash.String())
+			if err != nil {
+				return errors.Wrapf(err, "could not fetch receipt %d of block %d", i, number)
+			}
+			receipts[i] = *receipt
+		}
+
+		if err := w.Add(block, uncles, receipts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,379 @@
+package archive
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/rlp"
+)
+
+// Entry is a block of an era1 archive along with its receipts
+type Entry struct {
+	// Block has its transactions populated and its total difficulty and size set
+	Block    eth.Block
+	Receipts []eth.TransactionReceipt
+}
+
+// Reader iterates the blocks of an era1 archive, which is laid out as:
+//
+//	Version | (CompressedHeader | CompressedBody | CompressedReceipts | TotalDifficulty)* | other entries* |
+//	Accumulator | BlockIndex
+//
+// The block index and the accumulator are verified once all blocks have been read.
+type Reader struct {
+	r      io.Reader
+	offset int64
+	err    error
+
+	start       uint64
+	offsets     []int64
+	records     []headerRecord
+	accumulator []byte
+}
+
+// NewReader returns a Reader of the era1 archive read from r
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: r}
+}
+
+// Next returns the next block of the archive, or io.EOF once all of them have been read and the archive verified
+func (r *Reader) Next() (*Entry, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	entry, err := r.next()
+	if err != nil {
+		r.err = err
+	}
+
+	return entry, err
+}
+
+// Accumulator returns the accumulator root of the archive once Next returned io.EOF
+func (r *Reader) Accumulator() (*eth.Hash, error) {
+	if r.err != io.EOF {
+		return nil, errors.New("archive has not been read")
+	}
+
+	hash := eth.Hash("0x" + hex.EncodeToString(r.accumulator))
+	return &hash, nil
+}
+
+func (r *Reader) next() (*Entry, error) {
+	if r.offset == 0 {
+		h, err := r.readHeader()
+		if err != nil {
+			return nil, errors.Wrap(unexpected(err), "could not read version")
+		}
+		if h.typ != typeVersion || h.length != 0 {
+			return nil, errors.New("archive must start with an empty version entry")
+		}
+	}
+
+	for {
+		offset := r.offset
+		h, err := r.readHeader()
+		if err != nil {
+			return nil, errors.Wrap(unexpected(err), "could not read entry")
+		}
+
+		switch h.typ {
+		case typeCompressedHeader:
+			if r.accumulator != nil {
+				return nil, errors.New("unexpected block after accumulator")
+			}
+			return r.readBlock(offset, h)
+		case typeAccumulator:
+			if r.accumulator != nil || h.length != 32 {
+				return nil, errors.New("invalid accumulator")
+			}
+			if r.accumulator, err = r.readData(h); err != nil {
+				return nil, err
+			}
+		case typeBlockIndex:
+			data, err := r.readData(h)
+			if err != nil {
+				return nil, err
+			}
+			if err := r.verify(offset, data); err != nil {
+				return nil, err
+			}
+			if _, err := io.ReadFull(r.r, make([]byte, 1)); err != io.EOF {
+				return nil, errors.New("unexpected data after block index")
+			}
+			return nil, io.EOF
+		case typeVersion, typeCompressedBody, typeCompressedReceipts, typeTotalDifficulty:
+			return nil, errors.Errorf("unexpected entry of type 0x%04x at offset %d", h.typ, offset)
+		default:
+			// other entries are allowed and ignored
+			if _, err := io.CopyN(io.Discard, r.r, int64(h.length)); err != nil {
+				return nil, errors.Wrap(unexpected(err), "could not skip entry")
+			}
+			r.offset += int64(h.length)
+		}
+	}
+}
+
+// readBlock reads the block tuple starting with the compressed header h, found at offset
+func (r *Reader) readBlock(offset int64, h entryHeader) (*Entry, error) {
+	if len(r.records) == MaxBlocks {
+		return nil, errors.Errorf("archive has more than %d blocks", MaxBlocks)
+	}
+
+	header, err := r.readCompressed(h)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read header")
+	}
+
+	body, err := r.readEntry(typeCompressedBody, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read body")
+	}
+
+	receipts, err := r.readEntry(typeCompressedReceipts, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read receipts")
+	}
+
+	td, err := r.readEntry(typeTotalDifficulty, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read total difficulty")
+	}
+	if len(td) != 32 {
+		return nil, errors.New("total difficulty must be 32 bytes")
+	}
+
+	entry, err := decodeBlock(header, body, receipts, td)
+	if err != nil {
+		return nil, err
+	}
+
+	number := entry.Block.Number.UInt64()
+	if len(r.records) == 0 {
+		r.start = number
+	} else if number != r.start+uint64(len(r.records)) {
+		return nil, errors.Errorf("expected block %d but found %d", r.start+uint64(len(r.records)), number)
+	}
+
+	r.offsets = append(r.offsets, offset)
+	r.records = append(r.records, headerRecord{
+		hash:            entry.Block.Hash.Bytes(),
+		totalDifficulty: entry.Block.TotalDifficulty.Big(),
+	})
+	return entry, nil
+}
+
+// verify checks the block index found at offset and the accumulator against the blocks read
+func (r *Reader) verify(offset int64, index []byte) error {
+	if r.accumulator == nil {
+		return errors.New("missing accumulator")
+	}
+
+	if len(index) < 16 {
+		return errors.New("block index is too short")
+	}
+
+	count := binary.LittleEndian.Uint64(index[len(index)-8:])
+	if count != uint64(len(r.records)) || uint64(len(index)) != 16+8*count {
+		return errors.Errorf("block index of %d blocks does not match the %d blocks of the archive", count, len(r.records))
+	}
+
+	if start := binary.LittleEndian.Uint64(index[0:8]); count > 0 && start != r.start {
+		return errors.Errorf("block index starts at block %d instead of %d", start, r.start)
+	}
+
+	for i, expected := range r.offsets {
+		relative := int64(binary.LittleEndian.Uint64(index[8+8*i:]))
+		if offset+relative != expected {
+			return errors.Errorf("block index offset of block %d is %d instead of %d", r.start+uint64(i), offset+relative, expected)
+		}
+	}
+
+	root, err := accumulator(r.records)
+	if err != nil {
+		return err
+	}
+
+	if root.String() != "0x"+hex.EncodeToString(r.accumulator) {
+		return errors.Errorf("accumulator 0x%x does not match the computed %s", r.accumulator, root.String())
+	}
+
+	return nil
+}
+
+func (r *Reader) readHeader() (entryHeader, error) {
+	h, err := readHeader(r.r)
+	if err != nil {
+		return h, err
+	}
+
+	r.offset += headerSize
+	return h, nil
+}
+
+func (r *Reader) readData(h entryHeader) ([]byte, error) {
+	if max := maxEntrySize(h.typ); uint64(h.length) > uint64(max) {
+		return nil, errors.Errorf("entry of type 0x%04x is %d bytes, more than the maximum of %d", h.typ, h.length, max)
+	}
+
+	data := make([]byte, h.length)
+	n, err := io.ReadFull(r.r, data)
+	r.offset += int64(n)
+	if err != nil {
+		return nil, unexpected(err)
+	}
+
+	return data, nil
+}
+
+func (r *Reader) readCompressed(h entryHeader) ([]byte, error) {
+	data, err := r.readData(h)
+	if err != nil {
+		return nil, err
+	}
+
+	return decompress(data, maxEntrySize(h.typ))
+}
+
+// readEntry reads the next entry, which must be of type typ
+func (r *Reader) readEntry(typ uint16, compressed bool) ([]byte, error) {
+	h, err := r.readHeader()
+	if err != nil {
+		return nil, unexpected(err)
+	}
+
+	if h.typ != typ {
+		return nil, errors.Errorf("unexpected entry of type 0x%04x", h.typ)
+	}
+
+	if compressed {
+		return r.readCompressed(h)
+	}
+
+	return r.readData(h)
+}
+
+// decodeBlock decodes the RLP encoded header, body and receipts of a block and its little endian total difficulty
+func decodeBlock(header, body, receipts, td []byte) (*Entry, error) {
+	h, err := rlp.Decode(header)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode header")
+	}
+
+	b, err := rlp.Decode(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode body")
+	}
+	if len(b.List) != 2 {
+		return nil, errors.New("body must be a list of transactions and uncles")
+	}
+
+	raw := rlp.Item{List: []rlp.Item{*h, b.List[0], b.List[1]}}
+	entry := Entry{}
+	if err := entry.Block.UnmarshalRLP(raw); err != nil {
+		return nil, errors.Wrap(err, "could not decode block")
+	}
+
+	be := make([]byte, len(td))
+	for i := range td {
+		be[len(td)-1-i] = td[i]
+	}
+	entry.Block.TotalDifficulty = eth.QuantityFromBigInt(new(big.Int).SetBytes(be))
+	entry.Block.Size = eth.QuantityFromInt64(int64(len(raw.Encode())))
+
+	if err := rlp.Unmarshal(receipts, &entry.Receipts); err != nil {
+		return nil, errors.Wrap(err, "could not decode receipts")
+	}
+
+	if err := deriveReceipts(&entry.Block, entry.Receipts); err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// deriveReceipts populates the fields of receipts that aren't part of their consensus encoding
+func deriveReceipts(block *eth.Block, receipts []eth.TransactionReceipt) error {
+	if len(receipts) != len(block.Transactions) {
+		return errors.Errorf("block has %d transactions but %d receipts", len(block.Transactions), len(receipts))
+	}
+
+	logIndex := int64(0)
+	cumulative := uint64(0)
+	for i := range receipts {
+		tx := &block.Transactions[i].Transaction
+		receipt := &receipts[i]
+
+		if receipt.TransactionType() != tx.TransactionType() {
+			return errors.Errorf("receipt %d has type %d but its transaction %d", i, receipt.TransactionType(), tx.TransactionType())
+		}
+
+		used := receipt.CumulativeGasUsed.UInt64()
+		if used < cumulative {
+			return errors.Errorf("cumulative gas used of receipt %d is decreasing", i)
+		}
+
+		receipt.TransactionHash = tx.Hash
+		receipt.TransactionIndex = eth.QuantityFromInt64(int64(i))
+		receipt.BlockHash = *block.Hash
+		receipt.BlockNumber = *block.Number
+		receipt.From = tx.From
+		receipt.To = tx.To
+		receipt.GasUsed = eth.QuantityFromUInt64(used - cumulative)
+		receipt.EffectiveGasPrice = tx.EffectiveGasPrice(block.BaseFeePerGas)
+		if tx.To == nil {
+			receipt.ContractAddress = createAddress(tx.From, tx.Nonce)
+		}
+		cumulative = used
+
+		for j := range receipt.Logs {
+			log := &receipt.Logs[j]
+			index := eth.QuantityFromInt64(logIndex)
+			log.LogIndex = &index
+			log.TxIndex = &receipt.TransactionIndex
+			log.TxHash = &receipt.TransactionHash
+			log.BlockHash = &receipt.BlockHash
+			log.BlockNumber = &receipt.BlockNumber
+			logIndex++
+		}
+	}
+
+	return nil
+}
+
+// createAddress returns the address of the contract created by from with nonce, keccak256(rlp([from, nonce]))[12:]
+func createAddress(from eth.Address, nonce eth.Quantity) *eth.Address {
+	encoded, err := rlp.Marshal([]interface{}{from, nonce})
+	if err != nil {
+		return nil
+	}
+
+	address, err := eth.NewAddress("0x" + hex.EncodeToString(keccak(encoded)[12:]))
+	if err != nil {
+		return nil
+	}
+
+	return address
+}
+
+func keccak(b []byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(b)
+	return hash.Sum(nil)
+}
+
+// unexpected turns io.EOF into io.ErrUnexpectedEOF, since more input was expected
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
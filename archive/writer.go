@@ -0,0 +1,220 @@
+package archive
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/rlp"
+)
+
+// Writer writes blocks to an era1 archive, which must be finalized once all blocks have been added
+type Writer struct {
+	w      io.Writer
+	offset int64
+
+	start     uint64
+	offsets   []int64
+	records   []headerRecord
+	finalized bool
+}
+
+// NewWriter returns a Writer of an era1 archive to w
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Add writes block, which must directly follow the previously added block, have its transactions populated and
+// its total difficulty set.  The headers of its uncles and the receipts of its transactions are required too.
+func (w *Writer) Add(block *eth.Block, uncles []eth.Uncle, receipts []eth.TransactionReceipt) error {
+	if w.finalized {
+		return errors.New("archive is finalized")
+	}
+
+	if block.Number == nil || block.Hash == nil {
+		return errors.New("block requires number and hash")
+	}
+
+	number := block.Number.UInt64()
+	switch {
+	case len(w.records) == MaxBlocks:
+		return errors.Errorf("archive cannot have more than %d blocks", MaxBlocks)
+	case len(w.records) > 0 && number != w.start+uint64(len(w.records)):
+		return errors.Errorf("expected block %d but received %d", w.start+uint64(len(w.records)), number)
+	case block.TotalDifficulty.Big().Sign() == 0:
+		return errors.Errorf("block %d is missing its total difficulty", number)
+	}
+
+	td, err := uint256(block.TotalDifficulty.Big())
+	if err != nil {
+		return errors.Wrap(err, "invalid total difficulty")
+	}
+
+	header, err := block.HeaderRawRepresentation()
+	if err != nil {
+		return errors.Wrapf(err, "could not encode header of block %d", number)
+	}
+	if hash := keccak(header.Bytes()); !bytes.Equal(hash, block.Hash.Bytes()) {
+		return errors.Errorf("header of block %d hashes to 0x%x instead of %s", number, hash, block.Hash.String())
+	}
+
+	body, err := encodeBody(block, uncles)
+	if err != nil {
+		return errors.Wrapf(err, "could not encode body of block %d", number)
+	}
+
+	if len(receipts) != len(block.Transactions) {
+		return errors.Errorf("block %d has %d transactions but received %d receipts", number, len(block.Transactions), len(receipts))
+	}
+	encodedReceipts, err := rlp.Marshal(receipts)
+	if err != nil {
+		return errors.Wrapf(err, "could not encode receipts of block %d", number)
+	}
+
+	if len(w.records) == 0 {
+		if _, err := w.write(typeVersion, nil); err != nil {
+			return err
+		}
+		w.start = number
+	}
+
+	offset := w.offset
+	for _, entry := range []struct {
+		typ  uint16
+		data []byte
+	}{
+		{typeCompressedHeader, header.Bytes()},
+		{typeCompressedBody, body},
+		{typeCompressedReceipts, encodedReceipts},
+	} {
+		compressed, err := compress(entry.data)
+		if err != nil {
+			return errors.Wrap(err, "could not compress entry")
+		}
+		if _, err := w.write(entry.typ, compressed); err != nil {
+			return err
+		}
+	}
+	if _, err := w.write(typeTotalDifficulty, td); err != nil {
+		return err
+	}
+
+	w.offsets = append(w.offsets, offset)
+	w.records = append(w.records, headerRecord{hash: block.Hash.Bytes(), totalDifficulty: block.TotalDifficulty.Big()})
+	return nil
+}
+
+// Finalize writes the accumulator and the block index, and returns the accumulator root
+func (w *Writer) Finalize() (*eth.Hash, error) {
+	if w.finalized {
+		return nil, errors.New("archive is finalized")
+	}
+
+	if len(w.records) == 0 {
+		return nil, errors.New("archive has no blocks")
+	}
+
+	root, err := accumulator(w.records)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := w.write(typeAccumulator, root.Bytes()); err != nil {
+		return nil, err
+	}
+
+	// offsets are relative to the start of the block index entry
+	count := len(w.offsets)
+	index := make([]byte, 16+8*count)
+	binary.LittleEndian.PutUint64(index[0:8], w.start)
+	for i, offset := range w.offsets {
+		binary.LittleEndian.PutUint64(index[8+8*i:], uint64(offset-w.offset))
+	}
+	binary.LittleEndian.PutUint64(index[8+8*count:], uint64(count))
+
+	if _, err := w.write(typeBlockIndex, index); err != nil {
+		return nil, err
+	}
+
+	w.finalized = true
+	return root, nil
+}
+
+func (w *Writer) write(typ uint16, data []byte) (int64, error) {
+	n, err := writeEntry(w.w, typ, data)
+	w.offset += n
+	if err != nil {
+		return n, errors.Wrap(err, "could not write entry")
+	}
+
+	return n, nil
+}
+
+// encodeBody returns the RLP encoding of the transactions and uncles of block
+func encodeBody(block *eth.Block, uncles []eth.Uncle) ([]byte, error) {
+	if len(uncles) != len(block.Uncles) {
+		return nil, errors.Errorf("block has %d uncles but received %d", len(block.Uncles), len(uncles))
+	}
+
+	buf := bytes.Buffer{}
+	w := rlp.NewWriter(&buf)
+	w.StartList()
+
+	w.StartList()
+	for i := range block.Transactions {
+		if !block.Transactions[i].Populated {
+			return nil, errors.Errorf("transaction %d is not populated", i)
+		}
+
+		raw, err := block.Transactions[i].RawRepresentation()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not encode transaction %d", i)
+		}
+
+		// legacy transactions are RLP lists, typed transactions are opaque strings
+		if block.Transactions[i].TransactionType() == eth.TransactionTypeLegacy {
+			err = w.WriteRaw(raw.Bytes())
+		} else {
+			err = w.WriteString(raw.Bytes())
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := w.EndList(); err != nil {
+		return nil, err
+	}
+
+	w.StartList()
+	for i := range uncles {
+		raw, err := uncles[i].RawRepresentation()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not encode uncle %d", i)
+		}
+		if hash := keccak(raw.Bytes()); !bytes.Equal(hash, block.Uncles[i].Bytes()) {
+			return nil, errors.Errorf("uncle %d hashes to 0x%x instead of %s", i, hash, block.Uncles[i].String())
+		}
+		if err := w.WriteRaw(raw.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.EndList(); err != nil {
+		return nil, err
+	}
+
+	if err := w.EndList(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Filename returns the conventional name of the era1 archive of an epoch, <network>-<epoch>-<short root>.era1, the
+// short root being the first 4 bytes of the accumulator root.
+func Filename(network string, epoch uint64, root eth.Hash) string {
+	return fmt.Sprintf("%s-%05d-%s.era1", network, epoch, root.String()[2:10])
+}
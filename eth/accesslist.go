@@ -35,16 +35,41 @@ func (a *AccessList) RLP() rlp.Value {
 	return val
 }
 
+// MarshalRLP implements rlp.Marshaler.
+func (a AccessList) MarshalRLP() (rlp.Item, error) {
+	return a.RLP().Item()
+}
+
+// UnmarshalRLP implements rlp.Unmarshaler.
+func (a *AccessList) UnmarshalRLP(item rlp.Item) error {
+	accessList, err := newAccessListFromItem(item)
+	if err != nil {
+		return err
+	}
+
+	*a = accessList
+	return nil
+}
+
 // NewAccessListFromRLP decodes an RLP list into an AccessList, or returns an error.
 // The RLP format of AccessLists is defined in EIP-2930, each entry is a tuple of an address and a list of storage slots.
 func NewAccessListFromRLP(v rlp.Value) (AccessList, error) {
+	item, err := v.Item()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid access list")
+	}
+
+	return newAccessListFromItem(item)
+}
+
+func newAccessListFromItem(v rlp.Item) (AccessList, error) {
 	accessList := make(AccessList, len(v.List))
 	for j, accessRLP := range v.List {
 		l := len(accessRLP.List)
 		if l == 0 || l > 2 {
 			return nil, errors.Errorf("invalid access list entry %d", j)
 		}
-		address, err := NewAddress(accessRLP.List[0].String)
+		address, err := NewAddress(accessRLP.List[0].Value().String)
 		if err != nil {
 			return nil, errors.Wrapf(err, "invalid access list entry address %d", j)
 		}
@@ -53,7 +78,7 @@ func NewAccessListFromRLP(v rlp.Value) (AccessList, error) {
 			// 2nd item is the storage keys
 			accessList[j].StorageKeys = make([]Data32, len(accessRLP.List[1].List))
 			for k, key := range accessRLP.List[1].List {
-				d, err := NewData32(key.String)
+				d, err := NewData32(key.Value().String)
 				if err != nil {
 					return nil, errors.Wrapf(err, "invalid access list entry %d storage key %d", j, k)
 				}
@@ -70,6 +70,26 @@ func (a *Address) RLP() rlp.Value {
 	}
 }
 
+// MarshalRLP implements rlp.Marshaler, encoding the Address as a 20 byte RLP string.
+func (a Address) MarshalRLP() (rlp.Item, error) {
+	return dataItem(string(a))
+}
+
+// UnmarshalRLP implements rlp.Unmarshaler.
+func (a *Address) UnmarshalRLP(item rlp.Item) error {
+	if item.IsList() {
+		return errors.New("cannot decode RLP list to Address")
+	}
+
+	parsed, err := NewAddress("0x" + hex.EncodeToString(item.Bytes))
+	if err != nil {
+		return err
+	}
+
+	*a = *parsed
+	return nil
+}
+
 /*
 ToChecksumAddress converts a string to the proper EIP55 casing.
 
@@ -28,6 +28,10 @@ type Block struct {
 	// EIP-1559 BaseFeePerGas
 	BaseFeePerGas *Quantity `json:"baseFeePerGas,omitempty"`
 
+	// EIP-4844 blob gas fields
+	BlobGasUsed   *Quantity `json:"blobGasUsed,omitempty"`
+	ExcessBlobGas *Quantity `json:"excessBlobGas,omitempty"`
+
 	// Ethhash POW Fields
 	Nonce   *Data8 `json:"nonce"`
 	MixHash *Data  `json:"mixHash"`
@@ -106,6 +110,10 @@ func (b Block) MarshalJSON() ([]byte, error) {
 			// EIP-1559 BaseFeePerGas
 			BaseFeePerGas *Quantity `json:"baseFeePerGas,omitempty"`
 
+			// EIP-4844 blob gas fields
+			BlobGasUsed   *Quantity `json:"blobGasUsed,omitempty"`
+			ExcessBlobGas *Quantity `json:"excessBlobGas,omitempty"`
+
 			Nonce   *Data8 `json:"nonce"`
 			MixHash *Data  `json:"mixHash"`
 		}
@@ -130,6 +138,8 @@ func (b Block) MarshalJSON() ([]byte, error) {
 			Transactions:     b.Transactions,
 			Uncles:           b.Uncles,
 			BaseFeePerGas:    b.BaseFeePerGas,
+			BlobGasUsed:      b.BlobGasUsed,
+			ExcessBlobGas:    b.ExcessBlobGas,
 			Nonce:            b.Nonce,
 			MixHash:          b.MixHash,
 		}
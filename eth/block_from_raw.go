@@ -1,6 +1,9 @@
 package eth
 
 import (
+	"encoding/hex"
+	"strings"
+
 	"github.com/pkg/errors"
 
 	"github.com/INFURA/go-ethlibs/rlp"
@@ -8,12 +11,52 @@ import (
 
 // FromRaw populates Block fields from the RLP-encoded raw block input string.
 func (b *Block) FromRaw(input string) error {
-	// Decode the input string as an rlp.Value
-	decoded, err := rlp.From(input)
+	return b.fromRaw(input, false)
+}
+
+// FromRawStrict is like FromRaw but rejects blocks that aren't canonical RLP, see Transaction.FromRawStrict.
+// Offsets of errors within transactions are relative to the transaction.
+func (b *Block) FromRawStrict(input string) error {
+	return b.fromRaw(input, true)
+}
+
+func (b *Block) fromRaw(input string, strict bool) error {
+	if !strings.HasPrefix(input, "0x") {
+		return errors.New("raw input must start with 0x")
+	}
+
+	raw, err := hex.DecodeString(input[2:])
+	if err != nil {
+		return errors.Wrap(err, "could not decode hex input")
+	}
+
+	if strict {
+		// validate the block up to its transactions first, so that errors have offsets into the block
+		var block struct {
+			Header       blockHeader
+			Transactions []rlp.Item
+			Uncles       []blockHeader
+		}
+		if err := rlp.UnmarshalStrict(raw, &block); err != nil {
+			return errors.Wrap(err, "could not RLP decode raw input")
+		}
+	}
+
+	// Decode the input bytes as an rlp.Item
+	decoded, err := rlp.Decode(raw)
 	if err != nil {
 		return errors.Wrap(err, "could not RLP decode raw input")
 	}
 
+	return b.fromItem(*decoded, strict)
+}
+
+// UnmarshalRLP implements rlp.Unmarshaler, populating Block fields like FromRaw.
+func (b *Block) UnmarshalRLP(item rlp.Item) error {
+	return b.fromItem(item, false)
+}
+
+func (b *Block) fromItem(decoded rlp.Item, strict bool) error {
 	// decoded should be 3 lists: header, transactions, uncles
 	switch len(decoded.List) {
 	case 0:
@@ -26,20 +69,18 @@ func (b *Block) FromRaw(input string) error {
 	}
 
 	// compute block header hash
-	h, err := decoded.List[0].Hash()
+	hash, err := NewHash("0x" + hex.EncodeToString(decoded.List[0].Hash()))
 	if err != nil {
 		return errors.Wrap(err, "could not compute RLP hash")
 	}
-	hash, err := NewHash(h)
 
-	header, txs, uncles := decoded.List[0].List, decoded.List[1].List, decoded.List[2].List
-	// header should be 15 items for legacy blocks, 16 for EIP-1559 blocks
-	switch len(header) {
-	case 15, 16:
-	default:
-		return errors.Errorf("unexpected decoded header list size %d", len(header))
+	var header blockHeader
+	if err := rlp.UnmarshalItem(decoded.List[0], &header); err != nil {
+		return errors.Wrap(err, "could not decode header")
 	}
 
+	txs, uncles := decoded.List[1].List, decoded.List[2].List
+
 	transactions := make([]TxOrHash, len(txs))
 	for i, txRlp := range txs {
 		index := QuantityFromInt64(int64(i))
@@ -48,17 +89,14 @@ func (b *Block) FromRaw(input string) error {
 			Index:     &index,
 		}
 		// Each transaction in the txs RLP list is either an opaque binary blob (an EIP-2718 tx) or itself an RLP list
-		// (a legacy pre-2718 transaction).  2718 transactions can be passed to Transaction.FromRaw as is, legacy
-		// transactions need to be converted to raw blobs via rlp.Value.Encode first.
-		rawTx := txRlp.String
+		// (a legacy pre-2718 transaction).  2718 transactions can be decoded as is, legacy transactions need to be
+		// converted to raw blobs via rlp.Item.Encode first.
+		rawTx := txRlp.Bytes
 		if txRlp.IsList() {
-			rawTx, err = txRlp.Encode()
-			if err != nil {
-				return errors.Wrap(err, "could not re-encode transaction")
-			}
+			rawTx = txRlp.Encode()
 		}
-		if err := tx.FromRaw(rawTx); err != nil {
-			return errors.Wrap(err, "could not decode transaction")
+		if err := tx.fromRaw(rawTx, strict); err != nil {
+			return errors.Wrapf(err, "could not decode transaction %d", i)
 		}
 
 		transactions[i] = TxOrHash{
@@ -69,146 +107,124 @@ func (b *Block) FromRaw(input string) error {
 
 	uncleHashes := make([]Hash, len(uncles))
 	for i, u := range uncles {
-		h, err := u.Hash()
-		if err != nil {
-			return errors.Wrap(err, "could not hash uncle RLP data")
-		}
-		if hh, err := NewHash(h); err == nil {
+		if hh, err := NewHash("0x" + hex.EncodeToString(u.Hash())); err == nil {
 			uncleHashes[i] = *hh
 		} else {
 			return errors.Wrap(err, "could not encode uncle to hash")
 		}
 	}
 
-	// ParentHash
-	if p, err := NewHash(header[0].String); err == nil {
-		b.ParentHash = *p
-	} else {
-		return errors.Wrap(err, "could not convert header field 0 to ParentHash")
-	}
-
-	// SHA3Uncles
-	if u, err := NewHash(header[1].String); err == nil {
-		b.SHA3Uncles = *u
-	} else {
-		return errors.Wrap(err, "could not convert header field 1 to SHA3Uncles")
-	}
-
-	// Miner
-	if m, err := NewAddress(header[2].String); err == nil {
-		b.Miner = *m
-	} else {
-		return errors.Wrap(err, "could not convert header field 2 to Miner")
-	}
-
-	// StateRoot
-	if s, err := NewData32(header[3].String); err == nil {
-		b.StateRoot = *s
-	} else {
-		return errors.Wrap(err, "could not convert header field 3 to StateRoot")
-	}
-
-	// TransactionsRoot
-	if t, err := NewData32(header[4].String); err == nil {
-		b.TransactionsRoot = *t
-	} else {
-		return errors.Wrap(err, "could not convert header field 4 to TransactionsRoot")
-	}
-
-	// ReceiptsRoot
-	if r, err := NewData32(header[5].String); err == nil {
-		b.ReceiptsRoot = *r
-	} else {
-		return errors.Wrap(err, "could not convert header field 5 to ReceiptsRoot")
-	}
-
-	// LogsBloom
-	if l, err := NewData256(header[6].String); err == nil {
-		b.LogsBloom = *l
-	} else {
-		return errors.Wrap(err, "could not convert header field 6 to LogsBloom")
-	}
-
-	// Difficulty
-	if q, err := NewQuantity(header[7].String); err == nil {
-		b.Difficulty = *q
-	} else {
-		return errors.Wrap(err, "could not convert header field 7 to Difficulty")
-	}
-
-	// Number has a special case where genesis block number is null instead of 0
-	if header[8].String == "0x" {
-		q := QuantityFromUInt64(0)
-		b.Number = &q
-	} else {
-		if q, err := NewQuantity(header[8].String); err == nil {
-			b.Number = q
-		} else {
+	b.ParentHash = header.ParentHash
+	b.SHA3Uncles = header.SHA3Uncles
+	b.Miner = header.Miner
+	b.StateRoot = header.StateRoot
+	b.TransactionsRoot = header.TransactionsRoot
+	b.ReceiptsRoot = header.ReceiptsRoot
+	b.LogsBloom = header.LogsBloom
+	b.Difficulty = header.Difficulty
+	b.Number = &header.Number
+	b.GasLimit = header.GasLimit
+	b.GasUsed = header.GasUsed
+	b.Timestamp = header.Timestamp
+	b.ExtraData = header.ExtraData
+	b.MixHash = &header.MixHash
+	b.Nonce = &header.Nonce
+	// the base fee is only part of EIP-1559 headers, and nil for legacy blocks
+	b.BaseFeePerGas = header.BaseFeePerGas
 
-			return errors.Wrap(err, "could not convert header field 8 to Number")
-		}
-	}
 	for i := range transactions {
 		transactions[i].Transaction.BlockNumber = b.Number
 	}
 
-	// GasLimit
-	if q, err := NewQuantity(header[9].String); err == nil {
-		b.GasLimit = *q
-	} else {
-		return errors.Wrap(err, "could not convert header field 9 to GasLimit")
-	}
-
-	// GasUsed, also has a sepcial case where genesis value can be null instead of 0
-	if header[10].String == "0x" {
-		b.GasUsed = QuantityFromUInt64(0)
-	} else {
-		if q, err := NewQuantity(header[10].String); err == nil {
-			b.GasUsed = *q
-		} else {
-			return errors.Wrap(err, "could not convert header field 10 to GasUsed")
-		}
-	}
-
-	// Timestamp
-	if q, err := NewQuantity(header[11].String); err == nil {
-		b.Timestamp = *q
-	} else {
-		return errors.Wrap(err, "could not convert header field 11 to Timestamp")
-	}
+	b.Hash = hash
+	b.Uncles = uncleHashes
+	b.Transactions = transactions
+	return nil
+}
 
-	// ExtraData
-	if d, err := NewData(header[12].String); err == nil {
-		b.ExtraData = *d
-	} else {
-		return errors.Wrap(err, "could not convert header field 12 to ExtraData")
-	}
+// HeaderRawRepresentation returns the RLP encoding of the block header, the keccak hash of which is the block hash.
+func (b *Block) HeaderRawRepresentation() (*Data, error) {
+	if b.Number == nil || b.MixHash == nil || b.Nonce == nil {
+		return nil, errors.New("block header requires number, mixHash and nonce")
+	}
+
+	header := blockHeader{
+		ParentHash:       b.ParentHash,
+		SHA3Uncles:       b.SHA3Uncles,
+		Miner:            b.Miner,
+		StateRoot:        b.StateRoot,
+		TransactionsRoot: b.TransactionsRoot,
+		ReceiptsRoot:     b.ReceiptsRoot,
+		LogsBloom:        b.LogsBloom,
+		Difficulty:       b.Difficulty,
+		Number:           *b.Number,
+		GasLimit:         b.GasLimit,
+		GasUsed:          b.GasUsed,
+		Timestamp:        b.Timestamp,
+		ExtraData:        b.ExtraData,
+		MixHash:          *b.MixHash,
+		Nonce:            *b.Nonce,
+		BaseFeePerGas:    b.BaseFeePerGas,
+	}
+
+	return header.rawRepresentation()
+}
 
-	// MixHash
-	if d, err := NewData(header[13].String); err == nil {
-		b.MixHash = d
-	} else {
-		return errors.Wrap(err, "could not convert header field 13 to MixHash")
-	}
+// RawRepresentation returns the RLP encoding of the uncle header, the keccak hash of which is the uncle hash.
+func (u *Uncle) RawRepresentation() (*Data, error) {
+	if u.Number == nil || u.MixHash == nil || u.Nonce == nil {
+		return nil, errors.New("uncle header requires number, mixHash and nonce")
+	}
+
+	header := blockHeader{
+		ParentHash:       u.ParentHash,
+		SHA3Uncles:       u.SHA3Uncles,
+		Miner:            u.Miner,
+		StateRoot:        u.StateRoot,
+		TransactionsRoot: u.TransactionsRoot,
+		ReceiptsRoot:     u.ReceiptsRoot,
+		LogsBloom:        u.LogsBloom,
+		Difficulty:       u.Difficulty,
+		Number:           *u.Number,
+		GasLimit:         u.GasLimit,
+		GasUsed:          u.GasUsed,
+		Timestamp:        u.Timestamp,
+		ExtraData:        u.ExtraData,
+		MixHash:          *u.MixHash,
+		Nonce:            *u.Nonce,
+	}
+
+	return header.rawRepresentation()
+}
 
-	// Nonce
-	if n, err := NewData8(header[14].String); err == nil {
-		b.Nonce = n
-	} else {
-		return errors.Wrap(err, "could not convert header field 14 to Nonce")
-	}
+// blockHeader is the RLP layout of block headers
+type blockHeader struct {
+	ParentHash       Hash
+	SHA3Uncles       Hash
+	Miner            Address
+	StateRoot        Data32
+	TransactionsRoot Data32
+	ReceiptsRoot     Data32
+	LogsBloom        Data256
+	Difficulty       Quantity
+	Number           Quantity
+	GasLimit         Quantity
+	GasUsed          Quantity
+	Timestamp        Quantity
+	ExtraData        Data
+	MixHash          Data
+	Nonce            Data8
+
+	// EIP-1559
+	BaseFeePerGas *Quantity `rlp:"optional"`
+}
 
-	// BaseFee (EIP-1559 enabled blocks)
-	if len(header) >= 16 {
-		q, err := NewQuantityFromRLP(header[15])
-		if err != nil {
-			return errors.Wrap(err, "could not convert header field 15 to BaseFeePerGas")
-		}
-		b.BaseFeePerGas = q
+func (h *blockHeader) rawRepresentation() (*Data, error) {
+	encoded, err := rlp.Marshal(h)
+	if err != nil {
+		return nil, err
 	}
 
-	b.Hash = hash
-	b.Uncles = uncleHashes
-	b.Transactions = transactions
-	return nil
+	raw := Data("0x" + hex.EncodeToString(encoded))
+	return &raw, nil
 }
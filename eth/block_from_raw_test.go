@@ -1,16 +1,19 @@
 package eth_test
 
 import (
+	"encoding/hex"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/rlp"
 )
 
 func TestBlock_FromRaw(t *testing.T) {
 	// simple block no uncle
-	input := "0xf913cff90215a012150305917c903204f0ea6bcde99c163782711243118cb5fadfeec77f5ce2fba01dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347945a0b54d5dc17e0aadc383d2db43b0a0d3e029c4ca0cbd1d7bf01807988d8a5ad9df3dfdb5f2fc3af72c268df5c6d4325ac4c825888a0c1ba37938ce5887b5b70efc371d993207b2bc05183f1a20dcff016b48ede53c0a0a3e28fd769b01c8d7e3bb1bfcf9386832c369b9bc06889541f887149cd25dcd5b90100800000300820148400021200280001a0458008083580801c00000688ca21002012028a1084008080c0300084210801001100000008081c0300a84800800e18201600000484ac12004800c02880005800406020490414102022002008508002001180c0491e0204483004008004100c8864830001c00109080002041c150400210000644000800001020100512a8080200010400c018003829520202000180080040e20444022240601091490400142013e041400a008c0010800220c00200043048c09024040002024000400000040001040624202081003412000101004602000c044008044004c0c3000000680020000450500022060888010001240180af68707df60d8014acb8393c55283989680839883af845e6fc9eb94737061726b706f6f6c2d6574682d636e2d687a32a006902382c3573b497e8705b2802b8fabfdd1a2cfd8691f4148f44454515bdf0288e3695db8087a93b8f911b3f8ad83073f9f851bf08eb000830222e09468d57c9a1c35f63e2c83ee8e49a64e9d70528d2580b844a9059cbb0000000000000000000000005310850866bbf6637223e222cf27db17cc0d7881000000000000000000000000000000000000000000000a968163f0a57b40000026a0ae6f192ba953388a12626b683770fcdf9aa414e63e3349db548fecad8351e2eea02b6b7a08fdb91def21b477d1e473109f15b9ff629665bb427004ad8a9d03fb47f8ad8308f40b851bf08eb000830222e094df574c24545e5ffecb9a659c229253d4111d87e180b844a9059cbb000000000000000000000000d72ae3c3a7c9819d1d6b411ebf561e660ebbb10800000000000000000000000000000000000000000000000000000b5e5c19670025a05bb59ef692702b02421eb05f92f74f1298eebd2e115fc3bb4489f2f4a38c7a19a06b5c19f4f5bd34a1e0efd0da80dec632151248aaa303bb2f7f8551b690cf53a9f86b0a85123f6c944482520894f8b67009f8d7bab795b15f08ccbf824ca7c12eb58734f3b61cf31bcc8025a046d86ac0d625690c62aa795a2373a3e41d398162df23adcd6a86bfdad3365871a03532229160981ebc80495061664a12960f9058988e65151b133436a039a3f634f86b348506942b576682520894777f415324d56e1d54fa832902d8797db7a4c57c871910540d9760008025a00b0df9605ec31ced5588825f8c2c33aeab9417553ce6dc2a38ed546a5f6256faa053bb9c79555e01c529f8283eb8f7dc9799ff72b687b3e80282e515629a5733eff8ad830173738503b9aca000830129b194dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb0000000000000000000000001d6cc8eefae3b3043e7d1503d6f0889df7496b2f0000000000000000000000000000000000000000000000000000000020bbacb126a0f15037623d1e09dddcc861594f56051805e1614e8350f82cf3b1027262fa254ea03e98a6c41e099649f7ee5470b9ee1104dbba833a4afbb15625623705bdf12f92f8ad830b59a685037e11d6008301d4c094543ff227f64aa17ea132bf9886cab5db55dcaddf80b844a9059cbb0000000000000000000000002db8c89cabe1735d41cdd4ed95ab3cb5afd8a60d0000000000000000000000000000000000000000000000196a79c202bf84900026a013329cc5e204895ed79ab0e74c86c26b723c223c88090a30899158cdc17bb1aaa046c70cc40b33e70e9aa899f4dc455dc57f0d39d49e5804f82c22f0355097f7a0f89080850218711a00830434b994bcf935d206ca32929e1b887a07ed240f0d8ccd22876a94d74f430000a48853b53e000000000000000000000000000000000000000000000000000000000003336125a0e73e5fa1991c0a34c219b9329c077cdb43d45dc159ccebd38e32ca4ccd33eddfa05dd531d5860ada18db7902378e3af68a27354bcdcbf934ca8fa1ca5a278aa7a8f901ae8301ce838501dce285008302035494fb80bfa19cae9e00f28b0f7e1023109deeb1048380b9014464887334000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000000093c55100000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000001496160eb6061f9c30a06fae400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001c1a5a519a6a610ebf2fa001600000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000025a036ba1645f9e307b233e522c7190b44cf9992e7a4818d22c8170fb7b84586bdf8a057e8897d8e79d69be730f4fc8113746b549453ce10fa455441a5164b8e542e53f87083090e8c8501dcd6500083015f9094de1b41d19898f26c100528b7e8ce506e3f06f0c488016345785d8a00008026a0d8c8322fb155cd6ccc8a1bca91f17991b0a6e473aa71923da29b6144d4ad7b5aa00e2869970daec601aebf07561bf42e3a0aa3d76af3c2210d56b2349a63d0fa5bf86c0a8501d0f5d70682520894be4e7e8e143fce9e60efca5fc901c3414894f9818841f6bbd999df90008025a0fb8b3e6dc9cfec01c0ccc209c08d0edc7e13a7e51760e88b27bcecff622869d7a00ba1a0f741fd8e4f57441fcf7322e48c98d8e83c3ff65b62a77f2b27126c3aa2f86d820cfb8501a13b860082520894e6c344e675aa9b5cbf6e2e3e164ad89ed90aed95870aa87bee5380008025a04c86b1f17aee531145cfde8514ffc8b1b9bd3d48ad835a66fecbbe990e1f0637a024179a13afef376cd9b86025f80f5b1211d8ad7cef0ab5d9c9f34b94cf10b093f86d8306093484b2d05e0082c35094e67b5a0fb559215b842f9e43195dfba4f56bb3a9878e1bc9bf0400008026a0f3359dce552ee26550d860c13a4f493c8b86f162dc88caa1a71af782e78de06aa0481b94e6e0eb7c762193d20c34d731a99140420bb2188e4b7697f8068ce82e22f902ea0984b2d05e008377fb3d94d737632cac4d039c9b0eecc94c12267407a271b580b90284885b48e70000000000000000000000000000000000000000000000000000000000000140000000000000000000000000000000000000000000000000000000000000018000000000000000000000000000000000000000000000000000000000000001c00000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000024000000000000000000000000000000000000000000000000006f05b59d3b2000000000000000000000000000000000000000000000000000001f161421c8e00000000000000000000000000000000000000000000000000000000000000015180000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000005434f5631390000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000005434f5631390000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000005636f76313900000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010000000000000000000000006fc46b64e3de0a4962d15a75129f39a9068c6f10000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000004be4e7267b6ae0000025a060227541f9adbb11ab3c226bc63a5eaad3b948399eaa4ed7ad1474f9cd11720ca0549d933d2093d283bb43be424bb78b0522a1bf82add9fbc90f0b1de65fe7c0c2f9010960847735940083022fc69497dec872013f6b5fb443861090ad93154287812680b8a4ddf7e1a700000000000000000000000000000000000000000000000000000000095b7a30000000000000000000000000000000000000000000000007f678338c1cede5d00000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000005e6fcd5e0000000000000000000000006b175474e89094c44da98b954eedeac495271d0f26a0a712bbfdbe3a5267d27fd9c875c57c7ca15605da03f13bd6b6cbaa1241f8da2ca06bc984efbafe82df17cf7183e2a0a6bb7acd5ca5d67144cb0722b318e464ce4af88826844cef998c831ab7d7940000000000b3f879cb30fe243b4dfee438691c0480a4a0712d68000000000000000000000000000000000000000000000000000000000000002f25a0b327eacdf13fbe51b1d9447c0a5f439d2d1798428a92d3b744aeb2cb35429c78a060ef0261174356ef2bff737ffe3d27e05a83b2bf2063b90dd9a89739dce45878f9028c82179784495d9bb3830409c1945aee1922f69ba5d51c2fe0b5d68f184069f7f85b80b90224ec83d3ba000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000001400000000000000000000000000000000000000000000000000000000000000038f78f6865696d64616c6c2d4f316859424402830fafd580a01c4fc52e92ead3b1c07de84b2ca79f7e3965f7d6b6dc07b7600531448b03e2e3000000000000000000000000000000000000000000000000000000000000000000000000000000415e248b667407ab6a3251ef2697ea849dd124294ac87f456f3047d04a30ee6a6f2dda5d339f2c6dae5c42ebb24001f5393820d2b38ed06d6b7f879316c8af3498010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000acf8aaf8649461ceb3e10ee836cd3c431951d57e54580bf58e00831dc200831dc2ffa0f66d140fb2d69b485abab176a5068fa7020055a9e12c459c946dffc505e16627a05abb9ed277ccc1d045e4afd8b8e97ed7547d619b590c8cedcf8a21a9cdbb3bd1845e6fc86eb8415b31a56dce912cf62682215c8d66c7f61141f79b8692daa0a6fb4bbe8ec6b92e4ac069cc956f6604a048edda01c6315531d1b444c8e866716a35fe936eba3066008000000000000000000000000000000000000000001ca05f28dbf648cd989f109b1f335c2f4f627583dc3915790c6247e73b89961d0c03a00a1315ab63c9cad83ea8ec8906f2119542b9a57b922ae7788305932fe0ddd3b0f8700c84495d9bb383033450947a78e3d47c9e20836aca681956d8a972c0c793898802c68af0bb140000846254a0ef25a0d7624b1428abd024ce47d34a01bb81a9c3993003b11d6ae4af22bbcc32900d17a01bda2fd01aeb14f95107b1a702a861839f2efa78b11fcb8414133261998ea139f8700d84495d9bb383033450947a78e3d47c9e20836aca681956d8a972c0c7938988016345785d8a00008401073bf525a05951a37c5d9568ab6767268ee294f866006a6797a2e025625760e784a25c535fa0090b3303b65d27ed417ee14ee933658f579493777c2fe64cb8f6f1a124a24e25f88a820d9d84495d9bb38303c84f9483320bc47f06d73ac63e3c3809703529547c1db080a418c9bd5b000000000000000000000000000000000000000000000000000000000000000025a08d7816a7df785c47fef6a7ad57aea1cd6ef06381f96dc55a22e6a88c21d31946a00312ca92365d903f962cb8b47b6e3b1413560e6b5b1a44176475da5c9c71e5a6f9012a82021984495d9bb382c787945e07b6f1b98a11f7e04e7ffa8707b63f1c17775380b8c471b773440000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000005e6fc899000000000000000000000000000000000000000000000000000000000000004106869708744d97f3268409b2d6b445632c497243dcdd621858601666f089ecd2248d3c06f5c38b107e81b0f208bdcb493802b0dd176183e53df4ee2242ecdfb1010000000000000000000000000000000000000000000000000000000000000026a0e40a038329ad3009f6b7814a41bb72d51ff2fe2d5fb302533a247c32d562c117a03b13569110c5469b4baa92b1e5360c346dfb2cb9d1debe04cbb85a50278d8ef2f86b3384481f228082520894883f96c3ebb7cbc548770250477f5dce9e96d1fe8802c68af0bb1400008026a0020a0cd74e55ca6f2ce7fdd79f446d4b1d1fcbc542ee119a3e416b4ddfafb913a071ce34e500a605539fe47628b77b26cf1dd57bc5d5efe62ee4a7799ce233c8e0c0"
+	input := mainnetBlock
 
 	block := eth.Block{}
 	err := block.FromRaw(input)
@@ -65,7 +68,7 @@ func TestBlock_FromRaw(t *testing.T) {
 }
 
 func TestBlock_FromRawWithUncle(t *testing.T) {
-	input := "0xf99faaf90214a00cf5de8aa4aed686f0fc8691e42f9934b7315bcc4181d46af788aeb696be9801a0d9271f8abe733d658d03352b55225b784aa3cbe0fb564100383cf01df63442e5946a7a43be33ba930fe58f34e07d0ad6ba7adb9b1fa0d4dffbbc7618cd621e89f41ed4a2b6ac79ec983d8bf874a35293b72cbe78efaaa0981d1a6271dc9fa2e449e43e22eff2a8adc35019c97e4540aeeefbae2c0fc022a0dfa6ac5f3291e892e16b78a7837d7140fb6ad7bf61103d39f797a1db21809848b901001000200052120a1c000a26520420581d31a81120b63100881006336845404c0814083505c6002802e00043061042093580c03c0214808001206255c2a02980146080224040438254c880084c012960002502000c022626540042db84224020501a2c0444020008898001420000a8482a2450186012a420000010321210008064170000034046504010058824900024b21250400881029000045a40140e3a14c80600280800082304540049c02c44ac001440001480118020002640201802102235d02003493004028890b181242034850881522580a80444403a0b50109cb044e210002013ea00400238008714006ad48fa54221880090100a011060a811c8548707c968618844278393c85b83981a998391b47d845e6ff2ac935050594520636f696e6f74726f6e2d65752d32a049a1c5aa347a922cc8fc9a6861e6a896cc844a558b0e89a54930f8a5d9e0b5e48844e6b80808e23ba0f99b7ff86c1d850ba43b7400825208944b9f2211c7c90657086be6b01caa3f76741a2768882caa6428c66ad4008026a08b261a5554e238293145be89903a50d5ac23f2df6ffa80c51d96583cb7b3a8c1a010f706fccd521f83e889a19dac8605ddf24c1bf7716d5fe92771ddd4516e660bf8a90785098bca5a0082cb3e946b175474e89094c44da98b954eedeac495271d0f80b844a9059cbb000000000000000000000000274b4df0b90e78dab39ad33993b92f4de49b83f30000000000000000000000000000000000000000000001969368974c05b0000026a078eb27e93e7274000ce3089ee4e7ba72b0c0941a9e90a264d54e087cc84bfdcfa035643bbc24811e73e5f2b20e7340449bf444a1b2be1dc830342f44ed923bf3ccf8ac82c47f8504a817c80083012be894bf2179859fc6d5bee9bf9158632dc51678a4100e80b844a9059cbb0000000000000000000000003f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be000000000000000000000000000000000000000000000c918d2fcd23ad8c700025a057b831df3f1c9af9339cddb2f8f33a7f66e42b9667118e253c1723f913027935a001613dd78a3ddfc6555249ab1b807e1c1ceb4cbe84063b6e04c310116ad47709f8ac8202268504a817c80083011dda94514910771af9ca656af840dff83e8264ecf986ca80b844a9059cbb0000000000000000000000003f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be0000000000000000000000000000000000000000000000a2a15d09519be0000025a037be7f2eb1186f19587939a0ff95c84ae4bfb036da3e69fb2233d8445184b435a001898587bf3a075750a833cb9ed757edf7ecea6d5c545a639cdf0372a4e024f2f8aa108504a817c80083011dda94514910771af9ca656af840dff83e8264ecf986ca80b844a9059cbb0000000000000000000000003f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be0000000000000000000000000000000000000000000000c79a03c3ed8c48000025a0ed00d87541bcdc30726acc68cb30b2beb78ae4391bda3584880856db72d25a77a075840b1da563244c4b5bf191a1c7f684beead7253acff81a04647e177b977952f8aa418504a817c80083011e0a94514910771af9ca656af840dff83e8264ecf986ca80b844a9059cbb0000000000000000000000003f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be00000000000000000000000000000000000000000000056b3f82fc2508b4e90025a03e9c0c3090c6754b2a767872b559d45ca5f802ee326f91fd1489eaba2f8870e9a05f220072a42bc9122f9698b4db818be6c9d44c1a1714684360655495765e92a2f871830b59f085037e11d60083015f9094dda505e6d190a6f42ed8b5dd741268956385b62a8905556f7fb51aaf00008025a05b1df45f04677373a6b9f2e50ed3e5ca53769c8d9a13a0f335aeced14895b657a0221dde550037b061642c47308eb1780137b212379ebeb2e73b50a643d9dc5030f86f8202378502cb41780082520894745194b6c16014b317c159f25541727d634b5a6289021d3bd55e803c0000801ca09f6d8abb7672c53fea3d06f30db537ed065054d1fc623e65358f43c513b798eea062bf513973034eb6c579efe1ecdd194ff795ef3811bd3aaa0cb952655a668607f8a9058502540be400830186a094dac17f958d2ee523a2206206994597c13d831ec780b844095ea7b300000000000000000000000057d9859eb33588e260f402b0d708a9a5417884480000000000000000000000000000000000000000000000000000000000000000259f16fb42cccd5c46171f8e3ad289c1eed96cc855222ff3bcc7ba7504b7d8caaaa0519c1669d06623ed897f8cce2417eb917bcb3d25d28c5c9fe215a9f140b5081cf902ae834a5ce5850218711a0083061a80942a0c0dbecc7e4d658f48e01e3fa353f44050c20880b90244ef3435880000000000000000000000000000000000000000000000000de0b6b3a763ffff000000000000000000000000000000000000000000000008df52de030e3ade0f000000000000000000000000000000000000000000000000000000000002e63000000000000000000000000000000000000000000000000000000000000006f90000000000000000000000000000000000000000000000000de0b6b3a763ffff00000000000000000000000000000000000000000000000000000000000010de00000000000000000000000000000000000000000000000000038d7ea4c68000000000000000000000000000000000000000000000000000000c8a842c0ea000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000086fadb80d8d2cff3c3680819e4da99c10232ba0f0000000000000000000000003f258a815d03e252d52743f4064d49247b3baf1b000000000000000000000000badffcb79efe57e954fd79621b29b0c2dc170171000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000001c8f7eb500be6ff1949bb8589fd3e1eae458a876d13c1b5757cc85261278188a903e0fb529cb75205386d0aaea4011523ec057c6355041c7e1151d107dc217cc323fa276167c76bf31cfab11799a6248cfd31ea818ccce3ee5d0c083a9548900fb377e78267e5764130d7ed2e15d50639c168660640ff662742ceb3638c1e747a826a0949543d5b2df05141553c66e3ec45efa70891960407481e090821a206172423da00b012a290c5196aeca7195e5825a11e61261e0a3b4c9304f204a179e036a0865f8ab822d068501dcd6500082ea6094dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb0000000000000000000000006f8f4203e51fec6d898f3a1d05dd79e5aa0c35fd000000000000000000000000000000000000000000000000000000000496ed4026a068d58cbe49c90b59ab1b636c420d41a05c5a9505b5048b575d8bd3f5edb02bf0a047d782429df1ca0491b3dceb90c90d484680b21354f301d07edbe16610d90ab0f86c808501dcd65000825208946622fe55b1c2648c65aa83224d8b48e92d97542988326f56faeb4108008026a054dd4873a12dde062f45ab43dd42bde3dbed4824fca3046d4380b441fc3fd22aa012a0474aff0770b8bee4cc99ab229c3d3ea185bd73484b89d8581db7c34fb168f86c808501dcd650008252089486ea7d2d6b623c04dd3dfb98329b1e65a3e02e2c88190d53c95f6dcc008025a0c60be058e189e5737083bcf86df123c7bfb0ffa34a978d4a46704a740f4a4799a0328a1997aba1fbdccd6c902ec01c774555e3b038cc0ef1842d65d31d6c00dc3ef904eb108501caf4ad00830c4f749411111254369792b2ca5d084ab5eea397ca8fa48b80b90484f88309d7000000000000000000000000d6ad7a6750a7593e092a9b218d66c0a814a3436e000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4800000000000000000000000000000000000000000000000000000000188f4160000000000000000000000000000000000000000000000000000000001bea2a98000000000000000000000000000000000000000000000000000000001c3259920000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000014000000000000000000000000000000000000000000000000000000000000001e0000000000000000000000000000000000000000000000000000000000000032000000000000000000000000000000000000000000000000000000000000003e0000000000000000000000000000000000000000000000000000000000000000400000000000000000000000011111254369792b2ca5d084ab5eea397ca8fa48b000000000000000000000000d6ad7a6750a7593e092a9b218d66c0a814a3436e00000000000000000000000011111254369792b2ca5d084ab5eea397ca8fa48b000000000000000000000000ab9d9e5b4b23d345474309ed9cef492378f967cf0000000000000000000000000000000000000000000000000000000000000110c9b27359000000000000000000000000d6ad7a6750a7593e092a9b218d66c0a814a3436e000000000000000000000000d6ad7a6750a7593e092a9b218d66c0a814a3436e2e1a7d4d00000000000000000000000000000000000000000000000000000000188f4160c9b27359000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48000000000000000000000000ab9d9e5b4b23d345474309ed9cef492378f967cf12210a29000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4800000000000000000000000068a17b587caf4f9329f0e372e3a78d23a46de6b5000000000000000000000000000000000000000000000000000000001c32599200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000500000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000044000000000000000000000000000000000000000000000000000000000000006800000000000000000000000000000000000000000000000000000000000000ac00000000000000000000000000000000000000000000000000000000000001100000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000025a0197243fd8ad57f0959b8ef1d07d434877dca56cb6f2b1598e7031a19ae739f21a02eb3e6823e4bb39c7ca3718bd42bfee8daad210c5c5122ca238a965c5754ae1cf8aa308501ad274800830927c09406af07097c9eeb7fd685c692751d5c66db49c21580b844095ea7b3000000000000000000000000d241472027a891614657d2bec001ab5efdaf619f000000000000000000000000000000000000000000000006856f14aba8e7472d1ba008451132726e651e2e993991722d1bde353802af0618863824a1fd4957d4fe09a004d620722d32e20f3c5c01fd3eefc709e1e15e2cafce267b609a3e56e7c1afbaf86b078501ad27480082520894292f04a44506c2fd49bac032e1ca148c35a478c8875117be7bb138008025a0edab3374f73e6af8f37263674514ccadef837998547b2fdc1332deb801231733a07591f92c4ad9b618d3d99da1c5f6e8e766450b5bfc66444156355b0d270c919df9010a318501ad274800830927c094d241472027a891614657d2bec001ab5efdaf619f80b8a45e75228f00000000000000000000000006af07097c9eeb7fd685c692751d5c66db49c215000000000000000000000000000000000000000000000006856f14aba8e7472d0000000000000000000000006b175474e89094c44da98b954eedeac495271d0f000000000000000000000000000000000000000000000006a1cc5f2e65adae8d0000000000000000000000000000000000000000000000000e1d136af2a1be351ca03d199621c712ead15cb5e7d2b1f5f3d71a794d3d2067d34e41f6b79a9a61aa20a0284576736c4f4513498a77dae17ec74fe1e3feec8eb6285d254ac1378d2b9bc6f9032e830bafd08501ad2748008301b7ec94798abda6cc246d0edba912092a2a3dbd3d11191b80b902c46488733400000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000140000000000000000000000000000000000000000000000000000000000093c85900000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000005c50ce0043b434ce60000000000000000000000000000000000000000000000004100c5be00b9ba1c00bc003b0ce0000000000000000000000000000000000000fe2200fe2643f33356000000fc520000000000000000000000000000000000003a003516e5e909000022ff00002b0000000000000000000000000000000000000200e4010600000000063eb82000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000540f4232bc6c2bb24000000000000000000000000000000000000000000000000d8004644004c55e4004d00c9f42300000000000000000000000000000000000005df0009f3c30cd0b400000003a9000000000000000000000000000000000000ca00cfec1e18f40000e80d0000dc000000000000000000000000000000000000fa002404050000000001024cdc000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000005000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000030000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000021ca094c82b13fb994b7adfaca378705054cfddf8e254615cdab5f2d191f1ccf84321a06529fa16e1b86b87d1854297eec9537db7b182ee39672b25a914512a36e7a345f8ad83010f4a8501ad27480083010771940f5d2fb29fb7d3cfee444a200298f468908cc94280b844a9059cbb00000000000000000000000004fe606fcdf16190202e1221a896c3c26b31b727000000000000000000000000000000000000000000000015b88f17d839db5c001ca022a7d2c99d2fab0fabc98189280556e28a17d814e5b57c24531ea54689354523a02c6000c448534f5f85d8d8fe2c861163cd823306ea1281801eaba0b99129630af86d098501ad27480082520894d065f749eacf07d9d36d8bf385ee2077e4a2baed89056bc75e2d631000008026a0f96064aa3640f354582998c834160e41b1d291bed9b49d6b23929a885d64c1d4a0343ebb02180ed188893648f9664fbab38365070ee41f864123835b20528bd6cef86c038501ad27480082520894d5fbda4c79f38920159fe5f22df9655fde292d478801c9d494d1fc6ff68025a08f391144be2d5029bfff9f426f21753768c7621dffa9c24df0672749e5fa797ea07f94f28a4e6c1a8348cba550910fd1686dc59d438917b4c485ee5cb6bf4c6122f901ee830190cb8501ad2748008302dd70940a1820f0ff7dc9fce0a4f0b589ee14ddae88233c80b9018439125215000000000000000000000000809d215bd132902b0101b1d76c507d5de55bf1b400000000000000000000000000000000000000000000000019119f3ab244000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d17000000000000000000000000000000000000000000000000000000000001f880000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004180b1832ddc2ba4b36b2b5e593e1fd6afa7d8097c31a0ebdff0a0e403fef1ae26239ea231b430aaaa2f545615b76e6be8b48b125484e51d67b866a810d4ac9b921b000000000000000000000000000000000000000000000000000000000000001ca042deda752d198ba1ed447cf4164aced1007ed1dabca5133b051d91b341c66263a005802a5323c17b1e92a0be4a80f3cb6e8bbf1bb60dfa37bd5fbc62119bac1f69f86c018501ad2748008252089488e917ae9a31590a7051da597a7e0cbb570a577188540d3d6a2634b0008025a00119f64b8a5ea800fe513626497293b456fcbec2b0611ddfee432b88dccf2355a0343aae3809b21b3582c48d9285c07c15ed3b2527a01f2262972a49e3a97e3788f8aa308501ad2748008301d8a8948e870d67f660d95d5be530380d0ec0bd388289e180b844a9059cbb000000000000000000000000dae375fefa1a185b433bbd1f907eb3bbe0d1992b00000000000000000000000000000000000000000000000000089b244b69380025a05edeecf749fa437c866a2c99c01bbaaf8e4a50e4cdad979cbe8b8ef4fba81e1da068004e354d9171a6e68cf9fc1aaab0be8a10d41b514887376b27c946b0ed659af86e8261618501ad274800830186a094ada280d554a0397733950fb358c48fdd6291b806871ab2da497cb000801ba06ef909defc72ad2f352dbd0e62f2dbcf8d4a49fa57e0200256b9617221088783a01c909ec5a6c22d588058867c945c9b5c06f5f67bc6efb5d0dda4477871916290f9030d822c458501a13b860183056ae894ae5fb390e5c4fa1962e39e98dbfb0ed8055ed7a980b902a46a7612020000000000000000000000006f400810b62df8e13fded51be75ff5393eaa841f000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001f608000000000000000000000000000000000000000000000000000000000000bf6c000000000000000000000000000000000000000000000000000000ad19d9182b0000000000000000000000001a5f9352af8af974bfc03399e3767df6370d82e40000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000022000000000000000000000000000000000000000000000000000000000000000a426c3d394000000000000000000000000000000000000000000000000000000000000000700000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000509864000000000000000000000000000000000000000000000001c912e80a892f2a080000000000000000000000000000000000000000000000000000000002009bf3000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041b1e6e428f41ce92ea3a4f4a642eebd7045cbe0c45214ac2933fac8ca94f1a9c17a9e8d727e950cdbd321ab0308eb28e2b12f9ae6451c9e752549d24b658e9cc01b0000000000000000000000000000000000000000000000000000000000000025a0f4d48c697b89ab79990c2d88f58c09ceaaa9b3c1cb350afb48cf28d7ae3b56f2a021991957d9bb68decae48369fb4990308d8f0fd17ba792dd30c7153e4cc1473ff901ee83011da18501a13b86008302b65494240cab009f620e14035c9b5c8c38be3bcf55af4480b901843912521500000000000000000000000065b0bf8ee4947edd2a500d74e50a3d757dc79de00000000000000000000000000000000000000000000000000ef79bdad78c600000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d100000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004157efcd10064f82ca84446b074c961b7fdc9aa6dcb76c1537e8fccf5869478d301b3af7cdf47abe05ee9b6fd975413bd86af23d1b8286376e4e7f373f0e1fbb241c000000000000000000000000000000000000000000000000000000000000001ca0043e59ab33c0ce2c6d6baf0a1d59a14b6a72d6952a19e16cc1fad48f6d3e066da00dbb5f3f35b15b4803a28fcf0bf5dbe40b18e3ea8515dd9e4152b3eb3ca51fa2f901ed826eb88501a13b86008302dd64943fda25f27211a138adf211f4c060f2149674be6d80b901843912521500000000000000000000000028fdc0dc4d73b6688cc0f631b6a0c23eb257e4e700000000000000000000000000000000000000000000000008e1bc9bf040000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d1100000000000000000000000000000000000000000000000000000000000005b80000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041fce8c372495a935fd5d3253a196fd522e7e4747c1a87bb482db93da31f6e851d6e8569e9901e4d6d379c5cf119614b7040eb6ec30c7bebfb6018f1503229d1a21c000000000000000000000000000000000000000000000000000000000000001ba0753709bd0b4a6eec4cba0b68888bb1c2fa0bbb771c24272162476340834f142fa06f485b114c48ec0ba1a5c7c0450ef5f4f5039d8b2f126bdd16196bd8db523ca3f8aa628501a13b860083026d3494a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4880b844a9059cbb000000000000000000000000764623eea762310a6305c0d1bc2cdb5b94353c050000000000000000000000000000000000000000000000000000009a6b14f86025a051aa1fcceb645ec154585fc6cb15ddaa33c090d080861aefa50a538864e9c678a0786a8ceb08adb519b54661bc2043b0c32361bd376bbd600e3199935459564012f901ed826eb98501a13b860083027bc8943fda25f27211a138adf211f4c060f2149674be6d80b901843912521500000000000000000000000006baa5f99c38bc96fafe43d4851701e45258dfa800000000000000000000000000000000000000000000000007087712a295700000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d1400000000000000000000000000000000000000000000000000000000000005b900000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000418440b0259eeefbe91eda3d053299602aec8c6269fae1eae5ba2925d1d2973f7175192e932cfcdf97bf4dedb6a6b33ca0d063a114d0739be52662a45508def2a11b000000000000000000000000000000000000000000000000000000000000001ca04aa9780002ba3f01ab275e413e6b2051d5f628903d3e4153c34013622764e549a0290261069eec2786836818b567ea542d0bead750ef020b0e0876e1a341f3a79ef901ed826eba8501a13b860083027bbc943fda25f27211a138adf211f4c060f2149674be6d80b9018439125215000000000000000000000000e869e83bc68b9b5cd72a1c62daac6acbb496546600000000000000000000000000000000000000000000000006f05b59d3b2000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d1800000000000000000000000000000000000000000000000000000000000005ba00000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000411b3f22d136b70d6c0d2cf78f859db916a8f4ad4b7405acb0da089291e0b0ae404c9de13fa66cff47c5e19d344ee480b34aa2d26825f229c9e080d9b8b7edd9081b000000000000000000000000000000000000000000000000000000000000001ba024160384b66b6a75b9e2adafbadab9bbacf98bb346102490f976b8a53a46bfa1a02c858c8358269e3d2b67b2caca148d847ee218f265300a9065f2324862b2fe15f9014c8201a18501a13b8600830927c094738aa22ec2f4ba7093e2300b3f1ff88dc6de9a9080b8e4e543a89d0000000000000000000000005c4a995ecb9ebf0391dcc1c41d2fd39bf9ea87730000000000000000000000000000000000000000000000000000000000000001000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb480000000000000000000000006b175474e89094c44da98b954eedeac495271d0f000000000000000000000000000000000000000000000000016345785d8a0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001ca04e125353928e402ee4e2acfc8ecfbcdf917103384aecabe1415997dc9431cd8da062decb7a15f6f0a7f29adf8497bfccea933dba8a2d3f85f73639ce44bcc5fde0f901ed826ebb8501a13b860083027bb8943fda25f27211a138adf211f4c060f2149674be6d80b90184391252150000000000000000000000001606a60d9f92d37b5f750811683240c4dddcce6e00000000000000000000000000000000000000000000000006f05b59d3b2000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d1a00000000000000000000000000000000000000000000000000000000000005bb000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004116ec97be7b52eb8f9ce32dd2be8b8b17a55f3f708dc0747085fbcd3809cfeda74ac0d242a829608bedab306eeafe2a1bc3009ba8d3da702176d51bf661ee43af1b000000000000000000000000000000000000000000000000000000000000001ca040963dc6e7e9178d6418fd7ec40749c85fa7124e4db9c7ccfdd6492b808d0b4ba04ea885de1ff27dbf6e5b49d4b5ff78f1fae34c4e08d038c001e80a50ce4e9b50f901ed826ebc8501a13b860083027bac943fda25f27211a138adf211f4c060f2149674be6d80b9018439125215000000000000000000000000035efb087a60412e2007d828b4ad6e75189e00b100000000000000000000000000000000000000000000000006f05b59d3b2000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d1e00000000000000000000000000000000000000000000000000000000000005bc00000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000414f0b8d47a5c6a520b551f9bb2b5a22dd353d2998a100a04049ff54c2dc07c09b41ae9a60fe8ece46df1043f953d3f29e99c59ff41f2161a47b907f25b2602ee91c000000000000000000000000000000000000000000000000000000000000001ba066e985a165bf5a4669414f4cbd258d63a16673bf16ed7c412e5695d168b0ecf5a02e905ec3ff65e8440b06de0606a1bac26c5af8f7c60855b69179f35860dd0a76f901ed826ebd8501a13b860083027bd0943fda25f27211a138adf211f4c060f2149674be6d80b9018439125215000000000000000000000000f46a707e36e82e8875d95db044d61d946decbfd700000000000000000000000000000000000000000000000005f766a7b9cd900000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d2200000000000000000000000000000000000000000000000000000000000005bd0000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041b572a6a493b4fdd9a6d507626c419d9404310156c9120e351bf9605a978a1393485759b5f4a3c6f5caad4ed7074dba47ef07b588abdccd3104b20d4ba55b1ad41c000000000000000000000000000000000000000000000000000000000000001ca067ee92962458c8ad972dee23024462fa85c00828ae87c321708b5bd85c362cfaa045499ee73114e396d650717d33116bebd9538c1fae159d8e375d31757f9f2848f901ed826ebe8501a13b860083027bc4943fda25f27211a138adf211f4c060f2149674be6d80b9018439125215000000000000000000000000e40afc776c18d8925199dcce78d0b06c9222b896000000000000000000000000000000000000000000000000058d15e17628000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d2600000000000000000000000000000000000000000000000000000000000005be0000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041303d5b10c749c48a9d44225cb5d91747023eb97e1adb55e73e598a3c87873f6c4a86c6b47b4feb06785e287b59bcd2114d5aad899fd0b903e9b31775daa6f27d1c000000000000000000000000000000000000000000000000000000000000001ca033e8a07a2e29159869f7df41920d306f2de33ad400039c3b52324735d78d6ee4a03acbf4ed12d71546f134c02141e29714293ab249ac1ed0c1d51fef7411b2d07cf901ee83011da28501a13b86008302b63c94aaf1349c9074af66f819ea42034fab02e335690e80b901843912521500000000000000000000000065b0bf8ee4947edd2a500d74e50a3d757dc79de00000000000000000000000000000000000000000000000008ac7230489e8000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d28000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000412586eabe42e225cddd48b3c59c8b6dc4b2679e97ba87c509641fb68b3544bd9e08a13f57d0b2acedcdc4e0f50c2602afb88dc7ff637dc3676a6499dca400c8971b000000000000000000000000000000000000000000000000000000000000001ba0317bbbdd9519de6803609e35d5cd44b490207ef615c656b7628503b30d5fe4f0a056594c96d5b494067904b9ad49c7767417a1c36d32baf14168f3f41ae384422df901ed826ebf8501a13b860083027bd0943fda25f27211a138adf211f4c060f2149674be6d80b90184391252150000000000000000000000006d0de6f2d59c12217b11f0d60c5b963ca1ebf1f400000000000000000000000000000000000000000000000002fe39243f10480000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d2900000000000000000000000000000000000000000000000000000000000005bf00000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000413df89ea440f704ea31309f12290399a49027eed6acf487472c3992ea7478c0b41ae797815085fcd70b3f2347343e8cdef10f4cab1dddad21d7ff2ba1373711ee1b000000000000000000000000000000000000000000000000000000000000001ba03d056a182cd76291805e89b28f6b138934005426ee744252400c05cb853546f8a06c9f3d52f2cdff358b969d5872abd85441211c1e7368bc6b3335845fce25e281f86d8219e085016b969d00825208948c9c5f3c395b4c51c315a618e6edc99e1ffefc4f870aa87bee5380008026a03f2fa4935726bbfda08aab1901bd8a8293a3b2969c82f9e5301014f0cd819e82a04fe8be58f8c5a7810e3c8b3db7636796f899f433419c5ca809834f04bdedaf52f86d8219e185016b969d008252089495831ab98c8859e6a532bad279e5d24be2760c5d870aa87bee5380008025a0dddb72a657028fbef10ac302b21dbfb023f0647f6378eee2ee91567fb34502a5a01b05df13f091a733a18efee35bb9d546291b30da4181c3e7171e477eecb1263df86d8219e285016b969d0082520894494aa496bd494d25172f2986459b9add11076882870aa87bee5380008026a06b247f01dc4aff33071a3c8426d397ee0c3f8b9fd363ee51bfed9567932754e9a0134e09f5572f76f281bb30a28381fdac77510c6de52a262db8ae86330f9d5484f86d8219e385016b969d0082520894d3ebb601e656e80cbd448080b2f732c185aa50c4870aa87bee5380008025a08f26ea1028f53e0d098110af8546d1f1db06013e1346cf73e2069dfe300f664aa04de06689b3fcfdc930bf0e2853761d2c7ea9b5401cfcfe4e7e377bf04e609838f8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650025a05eebe6f591cadb93d6321ecb2b0489a9fde288ddacf6fcbd9600cc92b038e540a02f2395e8e9719ed1781921f99446aac8c10f278c8fa10e206823d61ee5b9b161f8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650025a00c87437cddb039d3a57b6c0112d2a56694f00a77daf685cae0a15e31c391bba6a0397253655b3524f74cb17405618bdc35b5962174fa72676a167dcb7a66bc830ff8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650026a03c3adb38c12fd72c54064408b8204669b51657fbcec6d09e2df3f6af721f86dea034efb2cc02f95e8673784da149ded44021c1054f642136e551dd210a371fe9d0f8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650026a01d6a9f36bed8b9c0b06a5facea7aa227ea92886e1949cdf0b267bbb0d4f02f9aa027dd485ed579302aa102b44dc8889b46c46d41b3182538918683aff4470e2bc4f8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650025a0a4a143ddd7e4f1ae89bec89aa184f00fade35b48cbc618fe55d8d3750d6be196a05bb6019ce4afe996839d08a9d807b067243bd51e01d1c6417bca51622c6360d5f8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650025a045db4f2cc806c399c9949284c304c7b6c2d3ed9569157e8453057915dbb016c3a062b588775cb0c56773ae392fa37c1d816f58b76d381f04c31ff5e933f1569143f86d8219e485016b969d008252089463cbfc107ceec62a47808c8662ea466cb72e4272870aa87bee5380008026a0ff593b0bdabab6c4df62791c6dc42feb0f1197969355e0e5dac1ce1b2d7b94eea02f6366c5a9c63f17ad192b8c24daf47e940c388077262a8236f98c8656f0bc16f8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650026a007f014e898ffc8bc7bc83245ee3d83ffb4bfe850cb95325a53ed4ae141bf7d32a04416be4e529f4100f8bf8aa903030354c2516dc96e2cf95d37c72ff695c6da8ef8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650025a00d746d5aa2785e4747b1bdebfcbb8d904aa59bd3576d31d969e90e013d7a547ca06c809f9d406f9075feed7cc201768711dea07585d3c80b62503bbc3ca5912ca8f8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650025a05062ab7cf92c06218b08c2d413c62f77974581a3c30cadfbeb1e31ea6aaf2cf3a07922c4bd3602ceb1038e0b6920eae335406d6df432d7a9d8840713f1e15c0bc9f86d8219e585016b969d0082520894d4433887b8164f4d09b786a66dc1fc6302478cc4870aa87bee5380008026a094dcb1f1c094faa88c331676266180010a53ef725f3156f38fd4be8066d2a00ea04b5326d3567f7af9d75fbabf09c0b73c2341e69405fe5e99d713adb60f570730f86d8219e685016b969d0082520894bd0e30639f57e227c2027b607918998c3193a0fd870aa87bee5380008026a0d81f02d3355e87a19ec4f09fc62ec4e3237e0510d64ebabe1ba136d043f979ffa07564df2f66f34a61fb1b10a7d356e3f3dfe066941ea8d76671a009ef24fd3fa3f86d8219e785016b969d0082520894288ceb02e59e6331ae3ecf9bdc66813a1417979b870aa87bee5380008025a060de853bdb294b9626ebb032a7cd80b6e4a8c282d612672289812fa9b9f07100a07df62a243a188bd1d826b3e7f7c98375d323bf4eced611db3c51ccb5ff25eeecf86d8219e885016b969d00825208940a362742f877025b320ed80f04e9360eef9b5ad8870aa87bee5380008026a042a880fa0e3961971ef242e0b2f4bda8971f2bae003bd2aee021a7e85e69dafca031588866b1fa0d1a664f4e4ba14379e824e63787277104ed1593ddd89bd077a1f8ac823d86850169f3b6ba830186a094b6ed7644c69416d67b522e20bc294a9a9b405b3180b844a9059cbb000000000000000000000000db3a2fae6a1fc34af5d6bfd5e09d5dc9f83e4d8b000000000000000000000000000000000000000000000000000000003e95ba8025a03f197d12bb7745d7a21725611c56026c8c2255a6082dd04efff6521df637e963a05e613f9e66e214e07f5b24be338eaa168996041a9e08f23aacd102753b4742aff86c80850165a0bc0082520894b8f603bf1f54c4cc87a8f41a44b2e04504142b84881ae3a5498c0860008026a073f7033da0ceab4dc20cc4a9acb748d98153efc087f9524f81a91fecd74c2202a00cd128aaf31d574b294f5a3558f64ab720ef25155422390754697c2ebda6dcddf8ac8301a47184ee6b28008301082594a506758544a71943b5e8728d2df8ec9e72473a9a80b844045f785000000000000000000000000019587aac5a409af5f81bc09fbaec600f813ea018000000000000000000000000000000000000000000000013c9647e25a99400001ca0ab40a881c87094dde10b06475dc59cbb042c368574bc61f52bc6706d12b7372fa056beb6fcb5287b367709ed18ff0fb989f38d7b812567afe0f2b004c7d1ba66e8f8692b84c4b2010082520894ed2f6b6d2e85f6eb534a7950d5770c95a34ee45e8773900b2d4100008026a0c1bc6fef118a44925d9bcf3459a371b7ebd81e99ab3f965362f9f40b4f43a18b9f8f6c70c2c61d5c9deb40f1cf3736ef707c14adf44cffffd3dd4eef1b7b60d2f88f0284b8c63f00830145a194bcf935d206ca32929e1b887a07ed240f0d8ccd2287b1a2bc2ec50000a4f6838a72000000000000000000000000000000000000000000000000000000000000000225a0b68b2bbd2697212ad49e314f630363ceefe827862f4cdd274454f2f75ff9fccea050c6c4336c2968e8abfecd308bd523e3166bbd896817a5044044ccdb314a6e9af8728201bb84b2d05e008303d090948d12a197cb00d4747a1fe03395095ce2a5cc681988058d15e17628000084d0e30db025a06f15cc0403505031b74d12d71dfcedfdd4452b8d5eabb633f400fce218759145a03134524fa7d6257d5f47b9a169bbb94a2d03ff148e9d8322952484c59f427695f9035882039e84b2d05e00830493e08080b90304608060405234801561001057600080fd5b5060008054600160a060020a031916331790556102d2806100326000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416635367f7cc811461006357806368cdafe6146100785780638da5cb5b1461009c578063a27eccc1146100cd575b005b34801561006f57600080fd5b506100616100e2565b34801561008457600080fd5b50610061600160a060020a036004351660243561017d565b3480156100a857600080fd5b506100b1610288565b60408051600160a060020a039092168252519081900360200190f35b3480156100d957600080fd5b506100b1610297565b60008054600160a060020a031633146100fa57600080fd5b5060008054604051303192600160a060020a03909216916108fc841502918491818181858888f19350505050158015610137573d6000803e3d6000fd5b50600054604080518381529051600160a060020a03909216917f52dd0bb34607da290175a958db259372eab328201b51f87d642b4a2802a9463f9181900360200190a250565b600054600160a060020a0316331461019457600080fd5b6001805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03848116919091179182905560008054604080517fa9059cbb00000000000000000000000000000000000000000000000000000000815291841660048301526024820186905251939092169263a9059cbb92604480820193929182900301818387803b15801561022557600080fd5b505af1158015610239573d6000803e3d6000fd5b5050600054604080518581529051600160a060020a03928316945091861692507fd0ed88a3f042c6bbb1e3ea406079b5f2b4b198afccaa535d837f4c63abbc4de6919081900360200190a35050565b600054600160a060020a031681565b600154600160a060020a0316815600a165627a7a72305820c02d9c0d29792aa32be1602f23d2486590410db1797631ff5ab6351354941cde002926a031c6e79534e6262df63adb0add890f574366ec83e8faf39510549cc17edabe56a0512cf1d64eca15736d394f660a212f53188e50130237d6c63418f8778eca300df88b8304e74d84773594008309ae7f948a91c9a16cd62693649d80afa85a09dbbdcb850880a4ae8123ba00000000000000000000000000000000000000000000000000000004c670de501ba03b7f0fa0c116822ee04116ddc12d528bdce161bef7cbd87d2ee721a1462ba71da049be54a3d983f66aa1ab744b58fd71094e37640073ff086c67ac6f607eaed3fff86b8304e74e847735940083034cd3948a91c9a16cd62693649d80afa85a09dbbdcb85088084de1b24301ba0526d14def5208890a6a37e09cf44b6a0d368331cfd429d7948357562212127b9a07f16c6024ac4349b728b3fee2aced4a1cc3594920b75a8fa61fe72e03070a721f9018c8201e384773594008303d1e994e2f5349616fda0c35f6c66a14b6256e30dac066e80b901241239ec8c00000000000000000000000092baffdd6cfb11a4e57a58ffec4833b4d1abd25d000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000c00000000000000000000000000000000000000000000000000000000000000002000000000000000000000000ab3627317c43d394eb171170f818153b976d28a3000000000000000000000000ab3627317c43d394eb171170f818153b976d28a30000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000006b000000000000000000000000000000000000000000000000000000000000006c26a08268f87541eed5d7b913953df356ba2232c96fe487bbbc8888eb0b87deb52b09a0597a3006fec3ff1e895ee7b609ae9cf96628d35aac1492a31b6fe460545da541f86b30847735940082520894fe43908ec614978d7bf3f2d4b21464a95ae1a40688027f7d0bdb920000801ca0be2fb7805d7ee9bcf98733d66fe6f6a79bc433ca25557d0411d85cab0c1e0ceca0603ad626758c279c53fa7ed905ebfb63241bb410b8fa286a0d5d426bf13a3878f8a830847735940082fd9294a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4880b844a9059cbb000000000000000000000000320a8a22ce8dbbc5ede7704d26464079655cd0090000000000000000000000000000000000000000000000000000000102aa6a1025a093bddd4b4c2cdb4f2f9349521186a6bd494995bc2e843476f5abc39dd6f996bba076544fb860b76d816ed52c78cbca5ecda91cb11cbaa1aed8b0cd85a8c4f0bd7df86a24847735940082520894b9984d04f9e86eece91def5b83d454039768966f879c51c4521e00008025a031d55be4870fc3daf0effb7b16378a3de5d330c7ddfda2637dbb3f947102e6b7a047ec513cf84ee00c2eea56c4ae62a8e0e343436aa114a64ebbaed1d4d1c6bc01f8ad831fa7fd850ba43b740083032918948e870d67f660d95d5be530380d0ec0bd388289e180b844a9059cbb000000000000000000000000f77b83e4c5a93d667148b8781a536422395107e9000000000000000000000000000000000000000000000cb492cd929b8c83000025a00f6770b741428b70cdd9913f9b65ebe2165f5ee5934a9f37c9e0cbb81b774b06a057d429e334b63611b6275d1c995987194ce9438617e7768dd44441f3ca3bbdb6f8ad831fa7fe850ba43b74008303291894a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4880b844a9059cbb0000000000000000000000009bc2f223026c252c8ef5f7f33f00f4bee21434b80000000000000000000000000000000000000000000000000000000129c1739e25a002b182ede8fac748d12a54485f948310125a1e76f6933fffea214216eed1f3a3a006d3d2f06f729d403b8af4c1384523b56d7ce3e4cbc312bef181852d38e9b422f8ad833afb43850ba43b74008303291894a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4880b844a9059cbb0000000000000000000000002781a3ee67dde2f231171efcd8563dba1feee98a00000000000000000000000000000000000000000000000000000000891c225d25a05e99d9b3c2c0d853b76bada7fda8e800de41bce6f891b46e35bcb5ff103696f9a0712ff767d0ac708c797b26b64c11b4e22cf8eea45c26b2460a1ade968c31c509f8ad833afb44850ba43b74008303291894514910771af9ca656af840dff83e8264ecf986ca80b844a9059cbb000000000000000000000000bbfd389d4896337acd27b50c8a7b000e489f1689000000000000000000000000000000000000000000000000cc00e41db63e000025a0edd8ac181fcf8348f21a2901f3cad680d85c6899c06c4da4affc6909f7ac4edba057ca0d43aade351ffd5cf79529626f08550e1be3425a88ca60b58a5989c37d5cf8ad831f4ac8850ba43b74008303291894dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb0000000000000000000000008ae769cd430be7d4b4910189c5ef224116c9a7ed00000000000000000000000000000000000000000000000000000000772c453026a0fd8aaaf6d5d8dee761f10d9887b9cc847c04803e061b655b5b84604c3aa48a5ea01fac20020ea3dbce78fdfbf9f7e93ddbabf158392e15ccba76bf1296ab9d3fbaf8ad831e754c850ba43b74008303291894dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb00000000000000000000000094fe3ad91dacba8ec4b82f56ff7c122181f1535d0000000000000000000000000000000000000000000000000000000007a1728525a0d1549ecfb8e335d7747cb305a1e5b562589d254b278e53c725f37e9c1733aa8aa07e0feaa8e8900bd80831e516a12de30d4676413aa98797440c2bc493a564e830f8ad833afb45850ba43b74008303291894dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb0000000000000000000000007f083b58169fa3b13a9568d52d7196f5288eb88500000000000000000000000000000000000000000000000000000000002477f025a00984e461c32207fa1eca1edd9d012dd178b5d7e5e816ff0c7c7570d41ef1864ba03cfd47f38be0d5d0b6bf1f706aa97612ad0c8c8737930a1e676ae1ad2594883ef8ad831f4ac9850ba43b74008303291894dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb0000000000000000000000009f05c49c1e2104a14f4cbaefe49ce2292b7613b400000000000000000000000000000000000000000000000000000000595ee03025a0294548e0a265e20eca3e6bd20604f5ae29f4829f5a04bc20b51c1896507f93f9a0157a492d4773cf3da424072546cdf3f64919676debf7edcfdbcd1c440f90f061f8ad831f4aca850ba43b74008303291894dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000b39bc4d994dbb1876c677af840103d8d874ec5430000000000000000000000000000000000000000000000000000000264516c9825a05807b302947d534af5d68918aaa9e4ebd6cccf3fc8a933c45bd67e964d243d42a03227031eab6997896bd9bf8e5ec614218384cef3e9fa6ad92c8e6a5a788cc48df8ad831e754d850ba43b74008303291894dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb0000000000000000000000001a0be281f492099318deaf4f2e49caba10834e37000000000000000000000000000000000000000000000000000000024be7883125a0dec4ef359cb1bf68e9bb8b5d56c5b34e339d82c861ba23f19d58f2f1c7c4609da02aafab5bf86d4760ce8cee07abd78f0ee503411ff37f897203666502fce48235f8ac8307d4708507ea8ed40082fde89468e54af74b22acaccffa04ccaad13be16ed14eac80b844a9059cbb0000000000000000000000003a99e90d1d47b944ffb6c20e521de9f06246282e0000000000000000000000000000000000000000000000000000000f31cc93001ba052c7abca10b1f6dab12975ca17e3931fc71ec1ffde686cc37bb4423f439b372da0321591a997dd37bd7bbca4a8c62058bf7db0b6ff06e401399d70b227b9643c35f8ad830b31d48506fc23ac008301388094d7cc16500d0b0ac3d0ba156a584865a43b0b005080b844a9059cbb000000000000000000000000eff75da5ee8c37ff24fce9fda5cde61ff6f6824900000000000000000000000000000000000000000000000000000001b19fb0c025a07072b484c76f3c1158f434f69635f59deea9265be355fe9e2956077429d4f87ba062e35cdf76067d8a2a1ee7346b24185e24d9b0f7d9e52de90f214e4d53cbf1b0f86d818f8504a817c80082a410943f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be8802cd4b93cb4162a08026a0c8d3a641766b72f1139166168929e655c8cc207280b1a8bae0eb87d38ac003c3a07f809a1c22c5a65ebbd71a8be1adc8dc475a5b6805b7cc68e3f351e7e338d83cf8ac8234398504a817c80083011df294514910771af9ca656af840dff83e8264ecf986ca80b844a9059cbb0000000000000000000000003f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be00000000000000000000000000000000000000000000022262392b2b5a1c000025a0801e17a60f4f6877633f8a9eeb0789e6b4b76a3cdc235fb3955d32645943c7ada01c9f5c94f0fc38361eb816a4060d57000f0b900b5c7d140a3ea676b1defa4cb8f86b0d8504a817c8008252089445f29db1a9648d7d5243bfdf9d2f4f64e4e547da872386f26fc100008026a01df835c7876ca5c1310ed6107a2263594f8c59182f837b7cc9a7f76e99e8b293a001dc3bec59bb7bb4cf51aba9285e0e745772d4f0b923dab13cfa941e49caad78f86d820a5c8504a817c80082520894fcce195de7d146ef5ac37b09d0790049f26c6fbd87d529ae9e8600008025a0aab3cbf560d0d85196102c7c8a7af96b642ee67051919e12e98b7395edb95ce2a066b044c472ad4ff329f78ddd3cd7bccb84b6c5a881974f25891fff9ae1346954f902ae834a5ce6850218711a0083061a80942a0c0dbecc7e4d658f48e01e3fa353f44050c20880b90244ef34358800000000000000000000000000000000000000000000000004607b590e38b32d0000000000000000000000000000000000000000000000029ecb11f3a85f026c000000000000000000000000000000000000000000000000000000000002e630000000000000000000000000000000000000000000000000000000000000139900000000000000000000000000000000000000000000000004607b590e38b32d00000000000000000000000000000000000000000000000000000000000010df00000000000000000000000000000000000000000000000000038d7ea4c680000000000000000000000000000000000000000000000000000018571b2c4a8fd9000000000000000000000000000000000000000000000000000000000000000000000000000000000000000086fadb80d8d2cff3c3680819e4da99c10232ba0f000000000000000000000000c8ea00bd1a4bfe6b0da6fed8fdea3ee45af753f5000000000000000000000000badffcb79efe57e954fd79621b29b0c2dc170171000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000001b6ca37fbd90912b886b8f2e8d169af3dab5c5e7a9bf18d2c0e6d62c837b25c0c876def7b03c60d20e41ba417c2512df3026720359c648f7ec794fe702c0d6dc66df67927d75a043a33f6b0ef002896533c5efd8ac18b934f19fa13d32d0833bae5c105ea8c7bff24437eb93bffd6ad17e1da3f918fce73022939990ca13c579d226a090a154f204c200ff6de51c5b4d71bb6992e97451f653ea7167c5b5a2a7ba9fa3a0067bef3b5fb452f6fe18196c1ac80bb4e505565663dbf57052239d6aae027230f86c81b4850218711a008252089402581edb801626e76cf41b56a9891082bb1a02e487b105264347831a8026a036ef829da3f492c1e0e683881328448a3b8ce38cf67b815ac61750734eca71f6a037672d15a7bd5022227a403673aa8240889660d34f0d17167204c7e499cf546bf8728204d18501f8a471918288b894fa52274dd61e1643d2205169732f29114bc240b38801746a24053c3dc884f765417625a0562a6a07b5df76d2f518014af484cc4c93d86735cca3516f1456ed0243f12f49a02e43d79e52784b8a878f1e567ed582cd1bc5c22b8330f6029f871a5c2d513099f871808501f8a471918288b894fa52274dd61e1643d2205169732f29114bc240b38902b5e2a1949f0157c884f765417625a0050cb41ee90311d1c58dd933564cfb44d86d63bd49beebdcfce716ba0ab66bc7a04303d38e6ce3250a39e51bf0a6098ac7616fbe0d7f5f8aadccbbd6e6807042a9f86d8286998501dcd6500082520894b205228e68afb9788b83f094661ca86b7b8c927987051dac207a00008026a0a3a9994ac14e5eaf332f8c960cc57d563c8d386a43811a7c1ddbb959f97f97f3a06a164f2ae43678b39c51c4f0fdc0ef3c00650bae18c843d208623324b425d43cf86e82bf1b8501dcd65000825208944d0d53edffac1800512b02fea63934f0049d2cb48803782dace9d900008025a027aaa4a713037bb9c53ed65f7ea316ecffc0ca3bb6a69c20dabd3d03fd70976ba030eb8a4878650a2f3b94b2740eb3ef4235f861397eef1137d282221ba738ec6ff86e8270248501dcd6500082520894d167e55fdf2215020fb4cb3135dc6a2a4502086088048bd6d0c15afc008026a08417390839dfb02f35b3b02b2bd076cd256f18d1e1647c0c936178ffcb228efaa04985538e3ea7396d2e09bc4ba802c324faa059afdbf47c4cab817ca1a1df0588f8aa0b8501bf08eb00830186a094d26114cd6ee289accf82350c8d8487fedb8a0c0780b844a9059cbb00000000000000000000000095c703e331d21e9de9bfc27b837c68e7fe0a5bf0000000000000000000000000000000000000000000000024143d408c7be5700026a043cabbb9fa92685719d34bae160a2ef1dd5f8cb26b8d201e3011316c5f636be8a07f77d2bd610013a861fa6516da66839f31889763eefa3136770a66a9c7e4f33df88a82aa6a8501b6d1c6ed82a3e19461935cbdd02287b511119ddb11aeb42f1593b7ef80a44f9559b100000000000000000000000000000000000000000000000000000170e54b31711ca038332fee77f696449439dae0ce481274f11c8458286c04858bfa789b2190ea37a0071eac2f390ebaa24776447f67d1f783866bfe4bdc56bf2ed9ca5008a7aacb69f901bf83017dfe8501738b244a830b4db7948018280076d7fa2caa1147e441352e8a89e1ddbe80b9015501020509051ca4695f1ca4695f51d8847151d88471000000000000000000000000077d52b047735976dfda76fef74d4d988ac25196000000000000000000000000960b236a07cf122663c4303350609a66a7b288c000000000000000000000000000000000000000000000003486ac93e888ada433000000000000000000000000000000000000000000000034987c57fa701a000000000000000000000000000000000000000000000000000011cfb8659da627000100000000000000000000000994c18ed0c328f38d2c451b2a2e1ceb1ae6a812000000000000000000000000960b236a07cf122663c4303350609a66a7b288c00000000000000000000000000000000000000000000000097045d60ed801df920000000000000000000000000000000000000000000000095e656c9b448700000000000000000000000000000000000000000000000000121c6b7ddb5f2a00001ba09e3d8ff3bebb518edd777ce7e8fc04948b9fefc5973e46cbb7dfd63fd6db59c3a07c062d8d4a34bd2608f5e82e96395b220c722dabc281468261e664ad84f99403f9018d82099985017386906a8314acf894498aa5830da490c85dc4d92b410aab86edb26c1780b90124d9d6333e00000000000000000000000000000000000000000000000019c976ac4bad800000000000000000000000000000000000000000000000001a348bba197dd20000000000000000000000000000000000000000000000000034941eed24ed2a0000000000000000000000000000000000000000000000000000000dabd95bb66c7800000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000003000000000000000000000000960b236a07cf122663c4303350609a66a7b288c0000000000000000000000000077d52b047735976dfda76fef74d4d988ac251960000000000000000000000000994c18ed0c328f38d2c451b2a2e1ceb1ae6a8121ba0c024ee4175a21924661b40d1b32e381238a7b088e0b0db9dd99bf5d5e67ae01ca057e55a55d9a8f968a847aae8c3c1565322c04b5ea56216d6477d2eacd6165ceaf86d8219e985016b969d0082520894be8575daa52aee91cb7a90bfc4847761d29ed2b4870aa87bee5380008026a01b4aa6f8b34a84bdf118192dfc5cbf98d4865b198f327aa6b9fda0668a752ec4a02b656bcc9068aa1c4f49594c9fb2a640439bd9f0c1f8db143b25cefa136940def86d8219ea85016b969d00825208944e5b999319940dbb319bce6b8125ff18461d0144870aa87bee5380008026a0777d5bed10aa782ad354632de6fa7f030d8d3fb5a50f52bc53dfc6fee38a0d6aa07119044682a6e2f74ac4f627a2220b7853aa2d48cc4b4f0bbe779678fd845bb7f88b82347585012a05f2008303598994794e6e91555438afc3ccf1c5076a74f42133d08d80a440e58ee50000000000000000000000000000000000000000000000000000000000028ef826a09085f605d566e00141c1933ca5db0174cc968f11aeb8d9d7680a6cbfa3a0dabda07036d3578ecf267bff4dcafb7eba8431b469367a3e009598d6b12635dedde1f8f86b7784ee6b280083013880949fcafcca8aec0367abb35fbd161c241f7b79891b87f1ab77b43380008026a0f138f816f8dca25ea137b822f1b73c8ddc3e8fcb70efbdcbca9dd2e569650bd4a06e77be8628f75c0d1e09b4d29f82938f6e448f7aa86927809a81053070d26032f8a96484832156008301388094572328202d37b031b490f6659d8549e7bd71232480b844a9059cbb0000000000000000000000009a3e92157d290dcda6f94c8d4c882a04d2f2095300000000000000000000000000000000000000000000000000000000009834d81ca0fb9b7625019c6226f4c04b1a29baed836259136d2a133b012605ed80bdd75527a019411523a5bd73a4b4f3055050c1130f082bab9b099e181356b7153c3baa57e5f86e826a9284832156008252089484e5aea8421a18c294eea09b0e68a47acffda5dd89018dc9404c572580008025a002419d94671d6ee3c2e1c0e64b4209833b147c1a0d98704f6e3a051059f91bf3a03711211d81ad20ec92e8bf686326d43f235508b2d88f52eeb14c7d6c57278f86f86c818b84773594008303345094dd292f008c4eb276baa517b5ec541c00345b4e1f87b1a2bc2ec500008026a034bd633565b8a15dc7f836b86e05294a240f6a0c7bf29ff7dec5344d78de1f09a055091f85bca660409520aab6a7cb5586746e506202a9c29164add9861ac22977f86b82094c8507c0d5ad00830845b09411ecbf984888c6ad172538c5cfb7de3832e46db68084f05fe81a1ba0dfaf85606f0a8c5d3de43ddc7b23aff096138ec1db64fbf03ee329a4b1c7edf7a0682132153dcaa8a07be956e8b524321b36ae012145936a3039ee81d2f851365af9048a830114028505efeb1f0083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ca0a888a34d353c4d7c9dde8bf37cc689fe5c0a48f8d74d2812f430a8490b5ad5d5a016adc7253714a9116d60fc679206c841bf459e4c989aa25edd0dfd4555eca161f9048a830114038505efeb1f0083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ca05e6abc8b3fa9c8763ce5de4ad3acb3bd98d7b9ec2d47bae1fb5ddfe0860f8845a015e6a78a2fd5099b3ee765777a9d2d8839b01f887b07b84d0d0c1a8da8ed3c8af9048a830114048511d9e35b8083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ca0c562f1cd6d65a782dee2eed4d3e6a426ba77d5aa9a2f24689d7b4679e67cb06ca06d529d20138ccd55a2336c91d81f09c5b49a5ca7f59cb7c410f316bb4f3c2a51f9048a83011405850fd51da80083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ca0fe0d1f737e8835eb9c297f43d9be4851231b5975c573a2a004d3a1a5386e1891a05392269cf49a9ae4e1a0452446d87b3eae236c3c927dd6e96faccb770fbd8202f9048a830114068505efeb1f0083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ca0ef7c5a55320b9a2b3d7857bb00635d62267b272fdead3888066e0f51694b70bea0161ef3f4b0356afd00e79cdb2777ddb7e83673f8322ee1bdc974ba9a7fba6d1cf9048a83011407850fd51da80083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ba0cee38bcdbd585394ebc57b280613076667ce34a005a1563dd11b659158d61daba012985fd6e197db21b746e3d26d8751308fee19924fbab97daeb337a7af5d874ff9048a8301140885103a71990083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ba0f5b79bb81e42a4602b07e54402195ceaace5375c6e332a0da8ae5b3d324bfc8da001259c25021a02951d996d04bea28c98e4cd9d4ac92df03b34b572f9e51ebf62f9048a8301140985103a71990083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ca0cc2c175435b92d2b09ac11788266d03177ce053e8d79a5870bd5dbd8c05ff77ea0142a0d172a702b42b29d07384a3f89d662d9a15dc7e30332534935ebfbdf9e50f9048a8301140a85103a71990083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ba064ee58d7c3de459577ba30d3d99690936a0a6b8e7921872e163957be9d1f9d8ca053452be17c130f0baed869e7bb9e7a016dced8c9b0c3fbaa9036f24f96d76d64f86f8204a68504a817c800830186a094d66de061d230bcb47220b7a0dd7a9e0e46ec12c88819798bfa3c4dbc008025a029da55c5b3edf5342b5a8846759db401c5fa5fe316433c3f1bb6a0c5667ffa47a0542cfa028ea20480b167ad2a49d38b689473a7b570e8740a8ce56c6f4147d6cff86c0b8504a817c80082a410943f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be883a52b78874bd28388025a0e94d1080bfd9bfa8aff3e3033345ade515444a9263f8e2c105328f28c9bb18b4a032da6f636a3a0b6738d6937240ce49f18311c2c99a02f8f1861ab2e53c9687acf86d0a85028fa6ae008301425494cf511fe654544687a98bd9dbb8659bc9801319888829a2241af62c00008026a01a6603f14e0bc48aa3d0ccf698cf79b4473a0e8b91d30810b228974ebd6f87d3a0427e012117f7bdc2686198e1c022b3da4c17119252f74462839f5246dc01d3cef901338201e7850269a224ed830249f094d1ceeeeee83f8bcf3bedad437202b6154e9f54058806f05b59d3b20000b8c45e83b46300000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000093c922d7a48be25dd08c227d109ed4489a686b9095e20fb96b2b56473b4e384661eff2ba93d0820e8e029bb3ca9650e442ce2d5ccab98963288be314306cc626b1126591cbea0e04bab4f06886560d577e9f48af9efa1fa9a141046d2f3b0eb65253b225a085e9fcfeb7d96726c0db4cd19405f534f72a6f718b3c2ee3f0c2bf8d473f441a9f32c6963921fc3f3a51aef23aa46eeb842d929a89b87a5677671674981e9bd3f8ac831aa24e85022e075aed82fde894d1ceeeeee83f8bcf3bedad437202b6154e9f540580b844ca722cdc69ddfdde1bbf4f6a50bc136ffc7789dc7d0f47617cebcda5ccb7d98494ef975a0cf5de8aa4aed686f0fc8691e42f9934b7315bcc4181d46af788aeb696be98011ba0ad82c4924d5734ae9a86b5f94df2958aa7437239ad64c1a6ef927ff7b69849bba029e31d9b4e1d5ed91dab9b67e8396ecaaa0d611c66e5dbdeff7891f271bdbd0af902ae834a5ce7850218711a0083061a80942a0c0dbecc7e4d658f48e01e3fa353f44050c20880b90244ef34358800000000000000000000000000000000000000000000000002a30408b817cea0000000000000000000000000000000000000000000000005e1516e80e0b6000000000000000000000000000000000000000000000000000000000000000186a00000000000000000000000000000000000000000000000000000000014ec359200000000000000000000000000000000000000000000000002a30408b817cea00000000000000000000000000000000000000000000000000000000003023a5500000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000023b6d03d366b5f00000000000000000000000000000000000000000000000000000000000000000000000000000000000000008a854288a5976036a725879164ca3e91d30c6a1b000000000000000000000000e706b6071a5459fa34f7c85d938edd1912a4cf56000000000000000000000000cfca3d48d935c824bc195cd54e28d829ce96634b000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000001cb20c8e20beca4642ec65722f854163ed0a0b047f9798edfdcd4b16d97a657b3d2826857c79f25b9858a356da29d6c9218893e443733468105d7f201e94907305927cbfdc176109f32e72dda29735ec4aedbac981c3cba164393eba7e2e7577915710c6d8a01968a358bc6a552029d0add9df99689535bcbc478c81ad78c99faf26a0778c2c39be7125b18fbce6ab87e425893ec016864a8abdc807e9fdf2ff7ae81ea03447bd3b372d5cc721bd721bd3b1304df9d08b029a830f224a0c2738d74a1ad4f902ae834a5ce8850218711a0083061a80942a0c0dbecc7e4d658f48e01e3fa353f44050c20880b90244ef3435880000000000000000000000000000000000000000000000004563918244f3ffff0000000000000000000000000000000000000000000000298a51f5f4cde77a9a000000000000000000000000000000000000000000000000000000000002e6300000000000000000000000000000000000000000000000000000016eec6553c600000000000000000000000000000000000000000000000002fb139fa79ed4d300000000000000000000000000000000000000000000000000000000000010e000000000000000000000000000000000000000000000000000038d7ea4c68000000000000000000000000000000000000000000000000000002069a05a3934ad000000000000000000000000000000000000000000000000000000000000000000000000000000000000000086fadb80d8d2cff3c3680819e4da99c10232ba0f0000000000000000000000008a28fcd950407c324dc49e44bcd475043ffa9fa4000000000000000000000000badffcb79efe57e954fd79621b29b0c2dc170171000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000001cd6d0f95c49415619585880e7632012be9b381d63472f50ae057f28bfef8c0baf42e0b6a8c292a5dc60d9a92ff42129267e9a81446cf4c1729bbe24483404903f507e700ec0bd89c36db3cf919db7e6a7765704c303af18c9aa86b755a11ad4382208e31a72a7b3dd0c73a04a050270f1898a10c5813b968d70c228a933795c2d25a08e6e311fe804f781c274868a1b1975e462c8b03e3f996e1913214e75dee26787a04db3e4eaa86b753f0335ab49691429ef820b66f5e16d643971dd9d95497979f0f8ac8209ad850218711a008301077a94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000fd9e4123104d107f0f8f60316b169f6e82af2bf4000000000000000000000000000000000000000000000000000000001924a18526a0bf85ad2d702982698455e3e967b5f1d62d4bfe6cff4702f73cc07b875cab0833a0395d3c9bd0814d72bcac87bd371efdac4e5adafefdb5fea56a971604fa4f2549f8a9808501dcd6500082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb0000000000000000000000006f5b5668159d546b4a59b66691117b4d4f91621a000000000000000000000000000000000000000000000000000000000098968026a0ce1032f7125d2e5e06b7663477d5b8aea69398903b91f2365c08413faa875766a0268d66241b57e450d6a93d412dc215e7b1f8c3a02eb1c653c30ec2064d5826caf8aa028501dcd6500083030d4094a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4880b844a9059cbb000000000000000000000000ea3f4d89133a5e8d922175f87f798631bf4f74a5000000000000000000000000000000000000000000000000000000003593794325a0553c3341c7050acec2129fbd4671119c1453f313aab4dde1e7b7baba77767ebfa00ff7cec6f0f81940fadd73c359b3b97d560928847efb103321eb51934b87456ff86b038501dcd6500082520894ea3f4d89133a5e8d922175f87f798631bf4f74a58705af3107a400008025a0a3d6a0db5c9ef52962f15c15d1b8d551751b18d6f1e45a9b0eb36535efe3e64da0347028d0ed832de8c19042a170c8f7b58bc992374344e2143d5a2505eec38292f86c808501dcd6500082520894121f2d296a461f7ee517a997c35982250c3acae68822b1c8c1227a00008025a02bb40b16a9b186b95a333a4c9b7d9b22803eb9bbd96ba3f25a038e31915111efa020cd19fcdb2d02e17025bab78a1103ecd383ab3252008eb0f02bae4ff2c947b9f86d808501dcd6500083015f9094973c3dd9f6082cfc3316cb517fbbc67c4f48c50588028cb8ea015bd0008025a06955b5ff01f54e97f81a06190a497ea0df988b422f0c006e15747dc442e78641a025385a5eff69aeb7d84d20408cc966659ad3959231a01cb1c43ba13835a6a056f86c808501dcd6500082520894c6dcd772131d95d61d9c53c52d162e2d0d43c063880de0b6b3a76400008025a086d688e42fdbee0a914672749898990bb9c027470996410a9050067e75d7a15ca004a25306e497831396725400540f1e64d4863d6024e87cf6b865c3d42503369ef870830101eb8501dcd6500083015f909446b31fab6cb08ce1c42e219a42286d478ead4e088855908c86b16680008026a0c2345fbfbe1f12a5f9815e9a5dfd529ee3cc008e62912099bca674f9e2edc322a02c3b2342b9c324d92f35676de8d199e83b1a12379463b0102c523201581621a5f88b8302afa18501b6d1c6ed82a3e19461935cbdd02287b511119ddb11aeb42f1593b7ef80a44f9559b100000000000000000000000000000000000000000000000000000170e54acf241ba0954b79b03e747214c205e224c18046a950c36086bbb36ee838e9c2bb772dc975a0488ca400752a1bf8412c77ac20d4bd69d5f672ec6ae298287865f767f716e069f901ed826ec08501a13b860083027bd0943fda25f27211a138adf211f4c060f2149674be6d80b9018439125215000000000000000000000000584a222ed4e1aac5495aedf8310165ad1e63d04d00000000000000000000000000000000000000000000000002df69673c81800000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d2c00000000000000000000000000000000000000000000000000000000000005c000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000416aa20e2abc336d823819cfcf1f6167707ca0e2307a5b1c31829614d76d61bc2c7a95e729e868c4b5ed8a646dc8edcb2fc71d2d1975e00152aed7b45c08a215031b000000000000000000000000000000000000000000000000000000000000001ba067be3a0c5662c4744637eb18395c5d3ad47b7a316d5ed1a6c49607a421951f96a050955870333823330eeec0c2653321f142d6e39bfc4d0f1c5090c0cb2316b053f901ed826ec18501a13b86008302dd6c943fda25f27211a138adf211f4c060f2149674be6d80b90184391252150000000000000000000000003418beb1f372325bd4a6cb9d7869cd5d39b070cc00000000000000000000000000000000000000000000000002386f26fc10000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d3100000000000000000000000000000000000000000000000000000000000005c100000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000414337c46ccc0f1aa08f4a15ac051c56384457c8800f03b169507885b3ee1595a877ef8b9aae1e4e966d906938aa3d8e81a258d19af75239789a14865eceb3d1781b000000000000000000000000000000000000000000000000000000000000001ca01c71e5075c67e5ddd6f0896c2970c0769c6f852b87b4215ca041379914de7014a01730e521c809699701d8bc4448faf4b12334dd6fa460d0005ab45494e7e23beff901ed826ec28501a13b860083027bd0943fda25f27211a138adf211f4c060f2149674be6d80b9018439125215000000000000000000000000abb64756f84699a49f8bf548944c184d4f774fae0000000000000000000000000000000000000000000000000238e45e32ae600000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d3500000000000000000000000000000000000000000000000000000000000005c20000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041750ccbf56526353544f205baf1493b998d1db669eb7b25b47d07eec28cd5adda585180076e41dd12caaee366a15d426108e0f40cf6515d1874e4aaf315c26bec1b000000000000000000000000000000000000000000000000000000000000001ba0775f5c4ca89cf403b022698de49b985740dcd069d90e8a1b3f8376b906a0d3c4a034703c07c5913512c72a487390f69bb69e771dcf69caf7e88579f58fa9b5e782f86d8219eb85016b969d00825208942079ab404ba8ef4c56ffda468f9b8d511b8d3ff6870aa87bee5380008025a00b4e5247677969302172f893ca3bce264ff1b386994ceb90e30d0c70ba89e22da047ca2af652e0df168f6a844d5c8953f1b593f248638678bb95d25e79282c0a9ff86d8219ec85016b969d00825208942555b0ce3fc35da3dd73028288fe02087a0ee144870aa87bee5380008026a05c521ffdaa29de3f6ec18bb187e0e4326aae1409521f52e1da7858751d80a28da00f2887d27677ad71f17db0a389c2cbc96537fdb5acd5e69952d4b9c98c703a8bf86a1484b2d05e00825208941e52671bbb6f861ecb3efce11b858b76db00f1328781a3762fdaa000801ca032a466b7d65c11971da29c0de5a2b77b961dfd94b5221ab9d76ce858ced0a106a01f5421e2f7f11f1cb0c8da7b0479bbcf86f45dbec05bc5e908dfe703115b7d78f86b8304e74f847735940083034cd3948a91c9a16cd62693649d80afa85a09dbbdcb85088084de1b24301ca06e860e92d2e1a2b1bdb19f19cefb7bed5cd26042893401fa1ab6794b839ee657a016db56c40c97372c623e9a5fb605ca80a73c9616480060098b1dec248fa102b9f9020ef9020ba00bb817be7ecbf0971933151b15ee7d0c465d5e390d4ff88891ab2fcba1ca31b2a01dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d4934794652d38d814bcdf3e0f750a0faf272ee96c1f67eda0ea805f031138bcaeb8c912289782015496964d495186bce3fbeb7725f766dce9a0da213801190b3740cba490a45a4406dd9499f1225013b5eb7071da8ab4a1aa07a067f2632c71b6b5ad3b5b1cef1c226c10958688927856905ceb4850607be214fcb9010000061041d04b1a29000a04120000011d21a01100165100080006312804480a0802093514e6000040800003000022293c90003800508e8401200a108220299014c08022400622001048800808032040a00040001c032a264480424d0002003040180004c40200001100001200002808030480384018c46110100230161000822009208142420400410000000a000024b21250000803029004045840540a32100c0654a8000001220516104d8004c58c800400600480018000002240a018120044f092300349f004028080e001250020c40802422580880050512a0b50108c3000c8108420020a000880302082300002d044044224880090100a010020209008408707ca61adbdfbd68393c85a83982d758398265c845e6ff2978a706f6f6c696e2e636f6da04e3ea29f4695ce9fb7d3bcdf36b1ab341a4dc3b1c943e2d3d60e69b3c971f44788fd7d62a0126507d9"
+	input := mainnetBlockWithUncle
 	block := eth.Block{}
 	err := block.FromRaw(input)
 	require.NoError(t, err)
@@ -75,6 +78,88 @@ func TestBlock_FromRawWithUncle(t *testing.T) {
 	require.Equal(t, "0x0cf5de8aa4aed686f0fc8691e42f9934b7315bcc4181d46af788aeb696be9801", block.ParentHash.String())
 }
 
+func TestBlock_FromRawStrict(t *testing.T) {
+	for _, input := range []string{mainnetBlock, mainnetBlockWithUncle} {
+		block := eth.Block{}
+		require.NoError(t, block.FromRawStrict(input))
+		expected := eth.Block{}
+		require.NoError(t, expected.FromRaw(input))
+		require.Equal(t, expected, block)
+	}
+
+	// the block number with a leading zero byte is accepted by FromRaw only
+	b, err := hex.DecodeString(mainnetBlock[2:])
+	require.NoError(t, err)
+	item, err := rlp.Decode(b)
+	require.NoError(t, err)
+	number := &item.List[0].List[8]
+	number.Bytes = append([]byte{0x00}, number.Bytes...)
+	nonCanonical := item.Encode()
+	input := "0x" + hex.EncodeToString(nonCanonical)
+
+	block := eth.Block{}
+	require.NoError(t, block.FromRaw(input))
+	require.Equal(t, uint64(9684306), block.Number.UInt64())
+
+	err = block.FromRawStrict(input)
+	require.Error(t, err)
+	nc, ok := errors.Cause(err).(*rlp.NonCanonicalError)
+	require.True(t, ok, err.Error())
+	require.Equal(t, rlp.RuleIntegerLeadingZero, nc.Rule)
+	require.Equal(t, []byte{0x84, 0x00}, nonCanonical[nc.Offset:nc.Offset+2])
+}
+
+func TestBlock_HeaderRawRepresentation(t *testing.T) {
+	for _, input := range []string{mainnetBlock, mainnetBlockWithUncle} {
+		block := eth.Block{}
+		require.NoError(t, block.FromRaw(input))
+
+		raw, err := block.HeaderRawRepresentation()
+		require.NoError(t, err)
+		header, err := rlp.Decode(raw.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, block.Hash.Bytes(), header.Hash())
+
+		// the block decodes the same from an rlp.Item
+		b, err := hex.DecodeString(input[2:])
+		require.NoError(t, err)
+		decoded := eth.Block{}
+		require.NoError(t, rlp.Unmarshal(b, &decoded))
+		require.Equal(t, block, decoded)
+	}
+
+	_, err := (&eth.Block{}).HeaderRawRepresentation()
+	require.Error(t, err)
+}
+
+func TestBlock_HeaderRawRepresentation_ZeroBaseFee(t *testing.T) {
+	block := eth.Block{}
+	require.NoError(t, block.FromRaw(mainnetBlock))
+	require.Nil(t, block.BaseFeePerGas)
+
+	// a London header with a zero base fee still encodes it
+	block.BaseFeePerGas = &eth.Quantity{}
+	raw, err := block.HeaderRawRepresentation()
+	require.NoError(t, err)
+	header, err := rlp.Decode(raw.Bytes())
+	require.NoError(t, err)
+	require.Len(t, header.List, 16)
+
+	b, err := rlp.Marshal([]interface{}{header, []interface{}{}, []interface{}{}})
+	require.NoError(t, err)
+	decoded := eth.Block{}
+	require.NoError(t, decoded.FromRaw("0x"+hex.EncodeToString(b)))
+	require.NotNil(t, decoded.BaseFeePerGas)
+	require.Equal(t, "0x0", decoded.BaseFeePerGas.String())
+	require.Equal(t, header.Hash(), decoded.Hash.Bytes())
+
+	raw, err = decoded.HeaderRawRepresentation()
+	require.NoError(t, err)
+	reencoded, err := rlp.Decode(raw.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, header.Hash(), reencoded.Hash())
+}
+
 func TestBlock_FromRaw_EIP2930(t *testing.T) {
 	// from: https://github.com/ethereum/tests/pull/774/files#diff-cac327f0b02e9dd20969a4c67b03fc2ed9fa6acbc8f446cd33eb5f42defe932aR342
 	raw := `0xf90d8ff901faa096ac1b3e915bd001d4d376ce2dbd58a4f9b509c2d76c5497e8c139e21b146382a01dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347948888f1f195afa192cfee860698584c030f4c9db1a0b925e41b8e6fa7125c4069ae207154bee1428899001a64b8d0481cf3ac92538ba0a84557edb533df722059565f40ccf352a0c3dd11934edbeb183161870c6f913ba04668fa49741587e589d605362546a9692767bb11779a846dd170e4fd62a41e0bb90100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008302000001832fefd88309304f8454c9906942a00000000000000000000000000000000000000000000000000000000000000000880000000000000000f90b8ef8a580018307a12094cccccccccccccccccccccccccccccccccccccccc80b8441a8451e6000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000010001ba04567290fa11724f00229e0ce2349dc28536ef988fa2cb57dcfe54576e0c316fba07928f94f58329ca04c6bc69905353d4763b1f17be3e8c3b4387016fbd7228e0db8e301f8e00101018307a12094cccccccccccccccccccccccccccccccccccccccc80b8441a8451e600000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccce1a0000000000000000000000000000000000000000000000000000000000000100001a0b71416e5476a8260406890715ddb9da18fb50ca92d248d4cf686dec725432ab8a06845cb1afbd983b87a8bdd87febab82e354386a35ac140a8e28ff77ae735eb3ff8a502018307a12094cccccccccccccccccccccccccccccccccccccccc80b8441a8451e6000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000000000000000000000000000000010001ba05b5870e375488507dff081c9bfe77435b7859d9c20b77c772959d515501e19c5a06abec2c2fdb3fff9bbe2a34c43162734dc2bcec39960e8baef5c277563453e07f8a503018307a12094cccccccccccccccccccccccccccccccccccccccd80b8441a8451e6000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000010001ca0f5f275c0826c59086d0c92d7c18bf3d0692f46d0a90b2fb08ae2c1ebc81130f1a0744fe24f012ab11dc67e5746a09f4d68622a5a466173045dfb84cac65490a5c5b8e301f8e00104018307a12094cccccccccccccccccccccccccccccccccccccccd80b8441a8451e600000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccde1a0000000000000000000000000000000000000000000000000000000000000100001a053379eefc1df12b6023838bb2c9167a355429abddf1aff874811d9bd2ab0fdeaa02615c47a2f30ba411fb69365bc037988ac2a7e4adb8b828fda5edb3c69e6a72af8a505018307a12094cccccccccccccccccccccccccccccccccccccccd80b8441a8451e6000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000000000000000000000000000000010001ba0be3de14d31bb7aaa2e985b8cd1631133a0c2c6fc5d2c1f4a90c8274bdcf3f619a050ca5c6909abb83fcf3fdfd6eea363ce38c25a83a9f65d77fe3e7e9a2d0b9f85b8e301f8e00106018307a12094cccccccccccccccccccccccccccccccccccccccd80b8441a8451e600000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccde1a0000000000000000000000000000000000000000000000000000000000000100080a0cd634a8f7342eea1cf6e504cb3a1f19701589be89e13cf153d77975c3be08d53a0186872642b07e076c95e6099b505be0a7c3a5c9bba3c6e716cc13efde86464bcb8e301f8e00107018307a12094cccccccccccccccccccccccccccccccccccccccd80b8441a8451e600000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000001000f838f794fccccccccccccccccccccccccccccccccccccccde1a0000000000000000000000000000000000000000000000000000000000000100080a0890553652792898fcc3fae23cdc145ae95509ac98f570705f711fc8b084b37d4a023e3468186d5d7e026beff1691d87af2fd82f64111f21e426f0648794f5a578bb8e301f8e00108018307a12094cccccccccccccccccccccccccccccccccccccccd80b84462ac2e9300000000000000000000000000000000000000000000000000000000000000050000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccde1a0000000000000000000000000000000000000000000000000000000000000100001a055caaa704de57dbd5658d4c4edaa7803d0fae81cc269489d4f3d7340ca707a04a043f0cf469823a0e77cd9b784d88c1c99afbf99ef5d8584320cf3304dc439b9cdb8e301f8e00109018307a12094cccccccccccccccccccccccccccccccccccccccd80b8441a8451e600000000000000000000000000000000000000000000000000000000000000050000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccde1a0000000000000000000000000000000000000000000000000000000000000100180a0e712707b085ca0dfa530ec63604815f1d44f7075f01f359614b5472924401751a02466db4f42b1e9923983ddc85341f1985e478f796baea2594b5e748117fef56fb8e301f8e0010a018307a12094cccccccccccccccccccccccccccccccccccccccc80b8441a8451e600000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccce1a0000000000000000000000000000000000000000000000000000000000000100001a058c583d6bdccf3cc1741e8bfbc9770d79d57eb34ddde07c88bd6a6a16f599f42a06eed5c876a6dd55dc8cbec7c9f2d01d4dd84ce38f56265fa6e20bef6824c4d71b8e301f8e0010b018307a12094cccccccccccccccccccccccccccccccccccccccc80b8441a8451e600000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000001000f838f794fccccccccccccccccccccccccccccccccccccccce1a0000000000000000000000000000000000000000000000000000000000000100001a0e7d97ba7ba5dd3295ac2633ff5213fe4f9c64856369dc7490264be04a8379630a06095f7d241d71cbabff508d3d82b7bc5638e06476264eff74a2b72d3aa0810bdb8e301f8e0010c018307a12094cccccccccccccccccccccccccccccccccccccccc80b8441a8451e600000000000000000000000000000000000000000000000000000000000000050000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccce1a0000000000000000000000000000000000000000000000000000000000000100001a051a2060ab24346f7a8f938076a0521a8dc337e3393f2120e5e10c1b8e0ee43f0a025e055b9d424144549c6ecea89596007457b772247f2fd783d9d7838dbe2edddb8e301f8e0010d018307a12094cccccccccccccccccccccccccccccccccccccccc80b8441a8451e600000000000000000000000000000000000000000000000000000000000000050000000000000000000000000000000000000000000000000000000000001000f838f794cccccccccccccccccccccccccccccccccccccccce1a0000000000000000000000000000000000000000000000000000000000000100101a04c4700cb2f9b3f36770cbcf5233315508e72361c94c99e93df8274e5fe56d7b1a045cf63a2455721c18df66576071e1a3a9a2646841ad5058b76bfb52b9d78d51dc0`
@@ -171,3 +256,22 @@ func TestBlock_FromRaw_BaikalGenesis(t *testing.T) {
 	require.Len(t, block.Transactions, 0)
 	require.Equal(t, uint64(0), block.Number.UInt64())
 }
+
+func BenchmarkBlock_FromRaw(b *testing.B) {
+	for name, input := range map[string]string{"mainnet": mainnetBlock, "mainnet with uncle": mainnetBlockWithUncle} {
+		b.Run(name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				block := eth.Block{}
+				if err := block.FromRaw(input); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// mainnet blocks 9684306 and 9685083
+var (
+	mainnetBlock          = "0xf913cff90215a012150305917c903204f0ea6bcde99c163782711243118cb5fadfeec77f5ce2fba01dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347945a0b54d5dc17e0aadc383d2db43b0a0d3e029c4ca0cbd1d7bf01807988d8a5ad9df3dfdb5f2fc3af72c268df5c6d4325ac4c825888a0c1ba37938ce5887b5b70efc371d993207b2bc05183f1a20dcff016b48ede53c0a0a3e28fd769b01c8d7e3bb1bfcf9386832c369b9bc06889541f887149cd25dcd5b90100800000300820148400021200280001a0458008083580801c00000688ca21002012028a1084008080c0300084210801001100000008081c0300a84800800e18201600000484ac12004800c02880005800406020490414102022002008508002001180c0491e0204483004008004100c8864830001c00109080002041c150400210000644000800001020100512a8080200010400c018003829520202000180080040e20444022240601091490400142013e041400a008c0010800220c00200043048c09024040002024000400000040001040624202081003412000101004602000c044008044004c0c3000000680020000450500022060888010001240180af68707df60d8014acb8393c55283989680839883af845e6fc9eb94737061726b706f6f6c2d6574682d636e2d687a32a006902382c3573b497e8705b2802b8fabfdd1a2cfd8691f4148f44454515bdf0288e3695db8087a93b8f911b3f8ad83073f9f851bf08eb000830222e09468d57c9a1c35f63e2c83ee8e49a64e9d70528d2580b844a9059cbb0000000000000000000000005310850866bbf6637223e222cf27db17cc0d7881000000000000000000000000000000000000000000000a968163f0a57b40000026a0ae6f192ba953388a12626b683770fcdf9aa414e63e3349db548fecad8351e2eea02b6b7a08fdb91def21b477d1e473109f15b9ff629665bb427004ad8a9d03fb47f8ad8308f40b851bf08eb000830222e094df574c24545e5ffecb9a659c229253d4111d87e180b844a9059cbb000000000000000000000000d72ae3c3a7c9819d1d6b411ebf561e660ebbb10800000000000000000000000000000000000000000000000000000b5e5c19670025a05bb59ef692702b02421eb05f92f74f1298eebd2e115fc3bb4489f2f4a38c7a19a06b5c19f4f5bd34a1e0efd0da80dec632151248aaa303bb2f7f8551b690cf53a9f86b0a85123f6c944482520894f8b67009f8d7bab795b15f08ccbf824ca7c12eb58734f3b61cf31bcc8025a046d86ac0d625690c62aa795a2373a3e41d398162df23adcd6a86bfdad3365871a03532229160981ebc80495061664a12960f9058988e65151b133436a039a3f634f86b348506942b576682520894777f415324d56e1d54fa832902d8797db7a4c57c871910540d9760008025a00b0df9605ec31ced5588825f8c2c33aeab9417553ce6dc2a38ed546a5f6256faa053bb9c79555e01c529f8283eb8f7dc9799ff72b687b3e80282e515629a5733eff8ad830173738503b9aca000830129b194dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb0000000000000000000000001d6cc8eefae3b3043e7d1503d6f0889df7496b2f0000000000000000000000000000000000000000000000000000000020bbacb126a0f15037623d1e09dddcc861594f56051805e1614e8350f82cf3b1027262fa254ea03e98a6c41e099649f7ee5470b9ee1104dbba833a4afbb15625623705bdf12f92f8ad830b59a685037e11d6008301d4c094543ff227f64aa17ea132bf9886cab5db55dcaddf80b844a9059cbb0000000000000000000000002db8c89cabe1735d41cdd4ed95ab3cb5afd8a60d0000000000000000000000000000000000000000000000196a79c202bf84900026a013329cc5e204895ed79ab0e74c86c26b723c223c88090a30899158cdc17bb1aaa046c70cc40b33e70e9aa899f4dc455dc57f0d39d49e5804f82c22f0355097f7a0f89080850218711a00830434b994bcf935d206ca32929e1b887a07ed240f0d8ccd22876a94d74f430000a48853b53e000000000000000000000000000000000000000000000000000000000003336125a0e73e5fa1991c0a34c219b9329c077cdb43d45dc159ccebd38e32ca4ccd33eddfa05dd531d5860ada18db7902378e3af68a27354bcdcbf934ca8fa1ca5a278aa7a8f901ae8301ce838501dce285008302035494fb80bfa19cae9e00f28b0f7e1023109deeb1048380b9014464887334000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000000093c55100000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000001496160eb6061f9c30a06fae400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001c1a5a519a6a610ebf2fa001600000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000025a036ba1645f9e307b233e522c7190b44cf9992e7a4818d22c8170fb7b84586bdf8a057e8897d8e79d69be730f4fc8113746b549453ce10fa455441a5164b8e542e53f87083090e8c8501dcd6500083015f9094de1b41d19898f26c100528b7e8ce506e3f06f0c488016345785d8a00008026a0d8c8322fb155cd6ccc8a1bca91f17991b0a6e473aa71923da29b6144d4ad7b5aa00e2869970daec601aebf07561bf42e3a0aa3d76af3c2210d56b2349a63d0fa5bf86c0a8501d0f5d70682520894be4e7e8e143fce9e60efca5fc901c3414894f9818841f6bbd999df90008025a0fb8b3e6dc9cfec01c0ccc209c08d0edc7e13a7e51760e88b27bcecff622869d7a00ba1a0f741fd8e4f57441fcf7322e48c98d8e83c3ff65b62a77f2b27126c3aa2f86d820cfb8501a13b860082520894e6c344e675aa9b5cbf6e2e3e164ad89ed90aed95870aa87bee5380008025a04c86b1f17aee531145cfde8514ffc8b1b9bd3d48ad835a66fecbbe990e1f0637a024179a13afef376cd9b86025f80f5b1211d8ad7cef0ab5d9c9f34b94cf10b093f86d8306093484b2d05e0082c35094e67b5a0fb559215b842f9e43195dfba4f56bb3a9878e1bc9bf0400008026a0f3359dce552ee26550d860c13a4f493c8b86f162dc88caa1a71af782e78de06aa0481b94e6e0eb7c762193d20c34d731a99140420bb2188e4b7697f8068ce82e22f902ea0984b2d05e008377fb3d94d737632cac4d039c9b0eecc94c12267407a271b580b90284885b48e70000000000000000000000000000000000000000000000000000000000000140000000000000000000000000000000000000000000000000000000000000018000000000000000000000000000000000000000000000000000000000000001c00000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000024000000000000000000000000000000000000000000000000006f05b59d3b2000000000000000000000000000000000000000000000000000001f161421c8e00000000000000000000000000000000000000000000000000000000000000015180000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000005434f5631390000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000005434f5631390000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000005636f76313900000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010000000000000000000000006fc46b64e3de0a4962d15a75129f39a9068c6f10000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000004be4e7267b6ae0000025a060227541f9adbb11ab3c226bc63a5eaad3b948399eaa4ed7ad1474f9cd11720ca0549d933d2093d283bb43be424bb78b0522a1bf82add9fbc90f0b1de65fe7c0c2f9010960847735940083022fc69497dec872013f6b5fb443861090ad93154287812680b8a4ddf7e1a700000000000000000000000000000000000000000000000000000000095b7a30000000000000000000000000000000000000000000000007f678338c1cede5d00000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000005e6fcd5e0000000000000000000000006b175474e89094c44da98b954eedeac495271d0f26a0a712bbfdbe3a5267d27fd9c875c57c7ca15605da03f13bd6b6cbaa1241f8da2ca06bc984efbafe82df17cf7183e2a0a6bb7acd5ca5d67144cb0722b318e464ce4af88826844cef998c831ab7d7940000000000b3f879cb30fe243b4dfee438691c0480a4a0712d68000000000000000000000000000000000000000000000000000000000000002f25a0b327eacdf13fbe51b1d9447c0a5f439d2d1798428a92d3b744aeb2cb35429c78a060ef0261174356ef2bff737ffe3d27e05a83b2bf2063b90dd9a89739dce45878f9028c82179784495d9bb3830409c1945aee1922f69ba5d51c2fe0b5d68f184069f7f85b80b90224ec83d3ba000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000001400000000000000000000000000000000000000000000000000000000000000038f78f6865696d64616c6c2d4f316859424402830fafd580a01c4fc52e92ead3b1c07de84b2ca79f7e3965f7d6b6dc07b7600531448b03e2e3000000000000000000000000000000000000000000000000000000000000000000000000000000415e248b667407ab6a3251ef2697ea849dd124294ac87f456f3047d04a30ee6a6f2dda5d339f2c6dae5c42ebb24001f5393820d2b38ed06d6b7f879316c8af3498010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000acf8aaf8649461ceb3e10ee836cd3c431951d57e54580bf58e00831dc200831dc2ffa0f66d140fb2d69b485abab176a5068fa7020055a9e12c459c946dffc505e16627a05abb9ed277ccc1d045e4afd8b8e97ed7547d619b590c8cedcf8a21a9cdbb3bd1845e6fc86eb8415b31a56dce912cf62682215c8d66c7f61141f79b8692daa0a6fb4bbe8ec6b92e4ac069cc956f6604a048edda01c6315531d1b444c8e866716a35fe936eba3066008000000000000000000000000000000000000000001ca05f28dbf648cd989f109b1f335c2f4f627583dc3915790c6247e73b89961d0c03a00a1315ab63c9cad83ea8ec8906f2119542b9a57b922ae7788305932fe0ddd3b0f8700c84495d9bb383033450947a78e3d47c9e20836aca681956d8a972c0c793898802c68af0bb140000846254a0ef25a0d7624b1428abd024ce47d34a01bb81a9c3993003b11d6ae4af22bbcc32900d17a01bda2fd01aeb14f95107b1a702a861839f2efa78b11fcb8414133261998ea139f8700d84495d9bb383033450947a78e3d47c9e20836aca681956d8a972c0c7938988016345785d8a00008401073bf525a05951a37c5d9568ab6767268ee294f866006a6797a2e025625760e784a25c535fa0090b3303b65d27ed417ee14ee933658f579493777c2fe64cb8f6f1a124a24e25f88a820d9d84495d9bb38303c84f9483320bc47f06d73ac63e3c3809703529547c1db080a418c9bd5b000000000000000000000000000000000000000000000000000000000000000025a08d7816a7df785c47fef6a7ad57aea1cd6ef06381f96dc55a22e6a88c21d31946a00312ca92365d903f962cb8b47b6e3b1413560e6b5b1a44176475da5c9c71e5a6f9012a82021984495d9bb382c787945e07b6f1b98a11f7e04e7ffa8707b63f1c17775380b8c471b773440000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000005e6fc899000000000000000000000000000000000000000000000000000000000000004106869708744d97f3268409b2d6b445632c497243dcdd621858601666f089ecd2248d3c06f5c38b107e81b0f208bdcb493802b0dd176183e53df4ee2242ecdfb1010000000000000000000000000000000000000000000000000000000000000026a0e40a038329ad3009f6b7814a41bb72d51ff2fe2d5fb302533a247c32d562c117a03b13569110c5469b4baa92b1e5360c346dfb2cb9d1debe04cbb85a50278d8ef2f86b3384481f228082520894883f96c3ebb7cbc548770250477f5dce9e96d1fe8802c68af0bb1400008026a0020a0cd74e55ca6f2ce7fdd79f446d4b1d1fcbc542ee119a3e416b4ddfafb913a071ce34e500a605539fe47628b77b26cf1dd57bc5d5efe62ee4a7799ce233c8e0c0"
+	mainnetBlockWithUncle = "0xf99faaf90214a00cf5de8aa4aed686f0fc8691e42f9934b7315bcc4181d46af788aeb696be9801a0d9271f8abe733d658d03352b55225b784aa3cbe0fb564100383cf01df63442e5946a7a43be33ba930fe58f34e07d0ad6ba7adb9b1fa0d4dffbbc7618cd621e89f41ed4a2b6ac79ec983d8bf874a35293b72cbe78efaaa0981d1a6271dc9fa2e449e43e22eff2a8adc35019c97e4540aeeefbae2c0fc022a0dfa6ac5f3291e892e16b78a7837d7140fb6ad7bf61103d39f797a1db21809848b901001000200052120a1c000a26520420581d31a81120b63100881006336845404c0814083505c6002802e00043061042093580c03c0214808001206255c2a02980146080224040438254c880084c012960002502000c022626540042db84224020501a2c0444020008898001420000a8482a2450186012a420000010321210008064170000034046504010058824900024b21250400881029000045a40140e3a14c80600280800082304540049c02c44ac001440001480118020002640201802102235d02003493004028890b181242034850881522580a80444403a0b50109cb044e210002013ea00400238008714006ad48fa54221880090100a011060a811c8548707c968618844278393c85b83981a998391b47d845e6ff2ac935050594520636f696e6f74726f6e2d65752d32a049a1c5aa347a922cc8fc9a6861e6a896cc844a558b0e89a54930f8a5d9e0b5e48844e6b80808e23ba0f99b7ff86c1d850ba43b7400825208944b9f2211c7c90657086be6b01caa3f76741a2768882caa6428c66ad4008026a08b261a5554e238293145be89903a50d5ac23f2df6ffa80c51d96583cb7b3a8c1a010f706fccd521f83e889a19dac8605ddf24c1bf7716d5fe92771ddd4516e660bf8a90785098bca5a0082cb3e946b175474e89094c44da98b954eedeac495271d0f80b844a9059cbb000000000000000000000000274b4df0b90e78dab39ad33993b92f4de49b83f30000000000000000000000000000000000000000000001969368974c05b0000026a078eb27e93e7274000ce3089ee4e7ba72b0c0941a9e90a264d54e087cc84bfdcfa035643bbc24811e73e5f2b20e7340449bf444a1b2be1dc830342f44ed923bf3ccf8ac82c47f8504a817c80083012be894bf2179859fc6d5bee9bf9158632dc51678a4100e80b844a9059cbb0000000000000000000000003f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be000000000000000000000000000000000000000000000c918d2fcd23ad8c700025a057b831df3f1c9af9339cddb2f8f33a7f66e42b9667118e253c1723f913027935a001613dd78a3ddfc6555249ab1b807e1c1ceb4cbe84063b6e04c310116ad47709f8ac8202268504a817c80083011dda94514910771af9ca656af840dff83e8264ecf986ca80b844a9059cbb0000000000000000000000003f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be0000000000000000000000000000000000000000000000a2a15d09519be0000025a037be7f2eb1186f19587939a0ff95c84ae4bfb036da3e69fb2233d8445184b435a001898587bf3a075750a833cb9ed757edf7ecea6d5c545a639cdf0372a4e024f2f8aa108504a817c80083011dda94514910771af9ca656af840dff83e8264ecf986ca80b844a9059cbb0000000000000000000000003f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be0000000000000000000000000000000000000000000000c79a03c3ed8c48000025a0ed00d87541bcdc30726acc68cb30b2beb78ae4391bda3584880856db72d25a77a075840b1da563244c4b5bf191a1c7f684beead7253acff81a04647e177b977952f8aa418504a817c80083011e0a94514910771af9ca656af840dff83e8264ecf986ca80b844a9059cbb0000000000000000000000003f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be00000000000000000000000000000000000000000000056b3f82fc2508b4e90025a03e9c0c3090c6754b2a767872b559d45ca5f802ee326f91fd1489eaba2f8870e9a05f220072a42bc9122f9698b4db818be6c9d44c1a1714684360655495765e92a2f871830b59f085037e11d60083015f9094dda505e6d190a6f42ed8b5dd741268956385b62a8905556f7fb51aaf00008025a05b1df45f04677373a6b9f2e50ed3e5ca53769c8d9a13a0f335aeced14895b657a0221dde550037b061642c47308eb1780137b212379ebeb2e73b50a643d9dc5030f86f8202378502cb41780082520894745194b6c16014b317c159f25541727d634b5a6289021d3bd55e803c0000801ca09f6d8abb7672c53fea3d06f30db537ed065054d1fc623e65358f43c513b798eea062bf513973034eb6c579efe1ecdd194ff795ef3811bd3aaa0cb952655a668607f8a9058502540be400830186a094dac17f958d2ee523a2206206994597c13d831ec780b844095ea7b300000000000000000000000057d9859eb33588e260f402b0d708a9a5417884480000000000000000000000000000000000000000000000000000000000000000259f16fb42cccd5c46171f8e3ad289c1eed96cc855222ff3bcc7ba7504b7d8caaaa0519c1669d06623ed897f8cce2417eb917bcb3d25d28c5c9fe215a9f140b5081cf902ae834a5ce5850218711a0083061a80942a0c0dbecc7e4d658f48e01e3fa353f44050c20880b90244ef3435880000000000000000000000000000000000000000000000000de0b6b3a763ffff000000000000000000000000000000000000000000000008df52de030e3ade0f000000000000000000000000000000000000000000000000000000000002e63000000000000000000000000000000000000000000000000000000000000006f90000000000000000000000000000000000000000000000000de0b6b3a763ffff00000000000000000000000000000000000000000000000000000000000010de00000000000000000000000000000000000000000000000000038d7ea4c68000000000000000000000000000000000000000000000000000000c8a842c0ea000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000086fadb80d8d2cff3c3680819e4da99c10232ba0f0000000000000000000000003f258a815d03e252d52743f4064d49247b3baf1b000000000000000000000000badffcb79efe57e954fd79621b29b0c2dc170171000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000001c8f7eb500be6ff1949bb8589fd3e1eae458a876d13c1b5757cc85261278188a903e0fb529cb75205386d0aaea4011523ec057c6355041c7e1151d107dc217cc323fa276167c76bf31cfab11799a6248cfd31ea818ccce3ee5d0c083a9548900fb377e78267e5764130d7ed2e15d50639c168660640ff662742ceb3638c1e747a826a0949543d5b2df05141553c66e3ec45efa70891960407481e090821a206172423da00b012a290c5196aeca7195e5825a11e61261e0a3b4c9304f204a179e036a0865f8ab822d068501dcd6500082ea6094dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb0000000000000000000000006f8f4203e51fec6d898f3a1d05dd79e5aa0c35fd000000000000000000000000000000000000000000000000000000000496ed4026a068d58cbe49c90b59ab1b636c420d41a05c5a9505b5048b575d8bd3f5edb02bf0a047d782429df1ca0491b3dceb90c90d484680b21354f301d07edbe16610d90ab0f86c808501dcd65000825208946622fe55b1c2648c65aa83224d8b48e92d97542988326f56faeb4108008026a054dd4873a12dde062f45ab43dd42bde3dbed4824fca3046d4380b441fc3fd22aa012a0474aff0770b8bee4cc99ab229c3d3ea185bd73484b89d8581db7c34fb168f86c808501dcd650008252089486ea7d2d6b623c04dd3dfb98329b1e65a3e02e2c88190d53c95f6dcc008025a0c60be058e189e5737083bcf86df123c7bfb0ffa34a978d4a46704a740f4a4799a0328a1997aba1fbdccd6c902ec01c774555e3b038cc0ef1842d65d31d6c00dc3ef904eb108501caf4ad00830c4f749411111254369792b2ca5d084ab5eea397ca8fa48b80b90484f88309d7000000000000000000000000d6ad7a6750a7593e092a9b218d66c0a814a3436e000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4800000000000000000000000000000000000000000000000000000000188f4160000000000000000000000000000000000000000000000000000000001bea2a98000000000000000000000000000000000000000000000000000000001c3259920000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000014000000000000000000000000000000000000000000000000000000000000001e0000000000000000000000000000000000000000000000000000000000000032000000000000000000000000000000000000000000000000000000000000003e0000000000000000000000000000000000000000000000000000000000000000400000000000000000000000011111254369792b2ca5d084ab5eea397ca8fa48b000000000000000000000000d6ad7a6750a7593e092a9b218d66c0a814a3436e00000000000000000000000011111254369792b2ca5d084ab5eea397ca8fa48b000000000000000000000000ab9d9e5b4b23d345474309ed9cef492378f967cf0000000000000000000000000000000000000000000000000000000000000110c9b27359000000000000000000000000d6ad7a6750a7593e092a9b218d66c0a814a3436e000000000000000000000000d6ad7a6750a7593e092a9b218d66c0a814a3436e2e1a7d4d00000000000000000000000000000000000000000000000000000000188f4160c9b27359000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48000000000000000000000000ab9d9e5b4b23d345474309ed9cef492378f967cf12210a29000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4800000000000000000000000068a17b587caf4f9329f0e372e3a78d23a46de6b5000000000000000000000000000000000000000000000000000000001c32599200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000500000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000044000000000000000000000000000000000000000000000000000000000000006800000000000000000000000000000000000000000000000000000000000000ac00000000000000000000000000000000000000000000000000000000000001100000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000025a0197243fd8ad57f0959b8ef1d07d434877dca56cb6f2b1598e7031a19ae739f21a02eb3e6823e4bb39c7ca3718bd42bfee8daad210c5c5122ca238a965c5754ae1cf8aa308501ad274800830927c09406af07097c9eeb7fd685c692751d5c66db49c21580b844095ea7b3000000000000000000000000d241472027a891614657d2bec001ab5efdaf619f000000000000000000000000000000000000000000000006856f14aba8e7472d1ba008451132726e651e2e993991722d1bde353802af0618863824a1fd4957d4fe09a004d620722d32e20f3c5c01fd3eefc709e1e15e2cafce267b609a3e56e7c1afbaf86b078501ad27480082520894292f04a44506c2fd49bac032e1ca148c35a478c8875117be7bb138008025a0edab3374f73e6af8f37263674514ccadef837998547b2fdc1332deb801231733a07591f92c4ad9b618d3d99da1c5f6e8e766450b5bfc66444156355b0d270c919df9010a318501ad274800830927c094d241472027a891614657d2bec001ab5efdaf619f80b8a45e75228f00000000000000000000000006af07097c9eeb7fd685c692751d5c66db49c215000000000000000000000000000000000000000000000006856f14aba8e7472d0000000000000000000000006b175474e89094c44da98b954eedeac495271d0f000000000000000000000000000000000000000000000006a1cc5f2e65adae8d0000000000000000000000000000000000000000000000000e1d136af2a1be351ca03d199621c712ead15cb5e7d2b1f5f3d71a794d3d2067d34e41f6b79a9a61aa20a0284576736c4f4513498a77dae17ec74fe1e3feec8eb6285d254ac1378d2b9bc6f9032e830bafd08501ad2748008301b7ec94798abda6cc246d0edba912092a2a3dbd3d11191b80b902c46488733400000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000140000000000000000000000000000000000000000000000000000000000093c85900000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000005c50ce0043b434ce60000000000000000000000000000000000000000000000004100c5be00b9ba1c00bc003b0ce0000000000000000000000000000000000000fe2200fe2643f33356000000fc520000000000000000000000000000000000003a003516e5e909000022ff00002b0000000000000000000000000000000000000200e4010600000000063eb82000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000540f4232bc6c2bb24000000000000000000000000000000000000000000000000d8004644004c55e4004d00c9f42300000000000000000000000000000000000005df0009f3c30cd0b400000003a9000000000000000000000000000000000000ca00cfec1e18f40000e80d0000dc000000000000000000000000000000000000fa002404050000000001024cdc000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000005000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000030000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000021ca094c82b13fb994b7adfaca378705054cfddf8e254615cdab5f2d191f1ccf84321a06529fa16e1b86b87d1854297eec9537db7b182ee39672b25a914512a36e7a345f8ad83010f4a8501ad27480083010771940f5d2fb29fb7d3cfee444a200298f468908cc94280b844a9059cbb00000000000000000000000004fe606fcdf16190202e1221a896c3c26b31b727000000000000000000000000000000000000000000000015b88f17d839db5c001ca022a7d2c99d2fab0fabc98189280556e28a17d814e5b57c24531ea54689354523a02c6000c448534f5f85d8d8fe2c861163cd823306ea1281801eaba0b99129630af86d098501ad27480082520894d065f749eacf07d9d36d8bf385ee2077e4a2baed89056bc75e2d631000008026a0f96064aa3640f354582998c834160e41b1d291bed9b49d6b23929a885d64c1d4a0343ebb02180ed188893648f9664fbab38365070ee41f864123835b20528bd6cef86c038501ad27480082520894d5fbda4c79f38920159fe5f22df9655fde292d478801c9d494d1fc6ff68025a08f391144be2d5029bfff9f426f21753768c7621dffa9c24df0672749e5fa797ea07f94f28a4e6c1a8348cba550910fd1686dc59d438917b4c485ee5cb6bf4c6122f901ee830190cb8501ad2748008302dd70940a1820f0ff7dc9fce0a4f0b589ee14ddae88233c80b9018439125215000000000000000000000000809d215bd132902b0101b1d76c507d5de55bf1b400000000000000000000000000000000000000000000000019119f3ab244000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d17000000000000000000000000000000000000000000000000000000000001f880000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004180b1832ddc2ba4b36b2b5e593e1fd6afa7d8097c31a0ebdff0a0e403fef1ae26239ea231b430aaaa2f545615b76e6be8b48b125484e51d67b866a810d4ac9b921b000000000000000000000000000000000000000000000000000000000000001ca042deda752d198ba1ed447cf4164aced1007ed1dabca5133b051d91b341c66263a005802a5323c17b1e92a0be4a80f3cb6e8bbf1bb60dfa37bd5fbc62119bac1f69f86c018501ad2748008252089488e917ae9a31590a7051da597a7e0cbb570a577188540d3d6a2634b0008025a00119f64b8a5ea800fe513626497293b456fcbec2b0611ddfee432b88dccf2355a0343aae3809b21b3582c48d9285c07c15ed3b2527a01f2262972a49e3a97e3788f8aa308501ad2748008301d8a8948e870d67f660d95d5be530380d0ec0bd388289e180b844a9059cbb000000000000000000000000dae375fefa1a185b433bbd1f907eb3bbe0d1992b00000000000000000000000000000000000000000000000000089b244b69380025a05edeecf749fa437c866a2c99c01bbaaf8e4a50e4cdad979cbe8b8ef4fba81e1da068004e354d9171a6e68cf9fc1aaab0be8a10d41b514887376b27c946b0ed659af86e8261618501ad274800830186a094ada280d554a0397733950fb358c48fdd6291b806871ab2da497cb000801ba06ef909defc72ad2f352dbd0e62f2dbcf8d4a49fa57e0200256b9617221088783a01c909ec5a6c22d588058867c945c9b5c06f5f67bc6efb5d0dda4477871916290f9030d822c458501a13b860183056ae894ae5fb390e5c4fa1962e39e98dbfb0ed8055ed7a980b902a46a7612020000000000000000000000006f400810b62df8e13fded51be75ff5393eaa841f000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001f608000000000000000000000000000000000000000000000000000000000000bf6c000000000000000000000000000000000000000000000000000000ad19d9182b0000000000000000000000001a5f9352af8af974bfc03399e3767df6370d82e40000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000022000000000000000000000000000000000000000000000000000000000000000a426c3d394000000000000000000000000000000000000000000000000000000000000000700000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000509864000000000000000000000000000000000000000000000001c912e80a892f2a080000000000000000000000000000000000000000000000000000000002009bf3000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041b1e6e428f41ce92ea3a4f4a642eebd7045cbe0c45214ac2933fac8ca94f1a9c17a9e8d727e950cdbd321ab0308eb28e2b12f9ae6451c9e752549d24b658e9cc01b0000000000000000000000000000000000000000000000000000000000000025a0f4d48c697b89ab79990c2d88f58c09ceaaa9b3c1cb350afb48cf28d7ae3b56f2a021991957d9bb68decae48369fb4990308d8f0fd17ba792dd30c7153e4cc1473ff901ee83011da18501a13b86008302b65494240cab009f620e14035c9b5c8c38be3bcf55af4480b901843912521500000000000000000000000065b0bf8ee4947edd2a500d74e50a3d757dc79de00000000000000000000000000000000000000000000000000ef79bdad78c600000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d100000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004157efcd10064f82ca84446b074c961b7fdc9aa6dcb76c1537e8fccf5869478d301b3af7cdf47abe05ee9b6fd975413bd86af23d1b8286376e4e7f373f0e1fbb241c000000000000000000000000000000000000000000000000000000000000001ca0043e59ab33c0ce2c6d6baf0a1d59a14b6a72d6952a19e16cc1fad48f6d3e066da00dbb5f3f35b15b4803a28fcf0bf5dbe40b18e3ea8515dd9e4152b3eb3ca51fa2f901ed826eb88501a13b86008302dd64943fda25f27211a138adf211f4c060f2149674be6d80b901843912521500000000000000000000000028fdc0dc4d73b6688cc0f631b6a0c23eb257e4e700000000000000000000000000000000000000000000000008e1bc9bf040000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d1100000000000000000000000000000000000000000000000000000000000005b80000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041fce8c372495a935fd5d3253a196fd522e7e4747c1a87bb482db93da31f6e851d6e8569e9901e4d6d379c5cf119614b7040eb6ec30c7bebfb6018f1503229d1a21c000000000000000000000000000000000000000000000000000000000000001ba0753709bd0b4a6eec4cba0b68888bb1c2fa0bbb771c24272162476340834f142fa06f485b114c48ec0ba1a5c7c0450ef5f4f5039d8b2f126bdd16196bd8db523ca3f8aa628501a13b860083026d3494a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4880b844a9059cbb000000000000000000000000764623eea762310a6305c0d1bc2cdb5b94353c050000000000000000000000000000000000000000000000000000009a6b14f86025a051aa1fcceb645ec154585fc6cb15ddaa33c090d080861aefa50a538864e9c678a0786a8ceb08adb519b54661bc2043b0c32361bd376bbd600e3199935459564012f901ed826eb98501a13b860083027bc8943fda25f27211a138adf211f4c060f2149674be6d80b901843912521500000000000000000000000006baa5f99c38bc96fafe43d4851701e45258dfa800000000000000000000000000000000000000000000000007087712a295700000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d1400000000000000000000000000000000000000000000000000000000000005b900000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000418440b0259eeefbe91eda3d053299602aec8c6269fae1eae5ba2925d1d2973f7175192e932cfcdf97bf4dedb6a6b33ca0d063a114d0739be52662a45508def2a11b000000000000000000000000000000000000000000000000000000000000001ca04aa9780002ba3f01ab275e413e6b2051d5f628903d3e4153c34013622764e549a0290261069eec2786836818b567ea542d0bead750ef020b0e0876e1a341f3a79ef901ed826eba8501a13b860083027bbc943fda25f27211a138adf211f4c060f2149674be6d80b9018439125215000000000000000000000000e869e83bc68b9b5cd72a1c62daac6acbb496546600000000000000000000000000000000000000000000000006f05b59d3b2000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d1800000000000000000000000000000000000000000000000000000000000005ba00000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000411b3f22d136b70d6c0d2cf78f859db916a8f4ad4b7405acb0da089291e0b0ae404c9de13fa66cff47c5e19d344ee480b34aa2d26825f229c9e080d9b8b7edd9081b000000000000000000000000000000000000000000000000000000000000001ba024160384b66b6a75b9e2adafbadab9bbacf98bb346102490f976b8a53a46bfa1a02c858c8358269e3d2b67b2caca148d847ee218f265300a9065f2324862b2fe15f9014c8201a18501a13b8600830927c094738aa22ec2f4ba7093e2300b3f1ff88dc6de9a9080b8e4e543a89d0000000000000000000000005c4a995ecb9ebf0391dcc1c41d2fd39bf9ea87730000000000000000000000000000000000000000000000000000000000000001000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb480000000000000000000000006b175474e89094c44da98b954eedeac495271d0f000000000000000000000000000000000000000000000000016345785d8a0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001ca04e125353928e402ee4e2acfc8ecfbcdf917103384aecabe1415997dc9431cd8da062decb7a15f6f0a7f29adf8497bfccea933dba8a2d3f85f73639ce44bcc5fde0f901ed826ebb8501a13b860083027bb8943fda25f27211a138adf211f4c060f2149674be6d80b90184391252150000000000000000000000001606a60d9f92d37b5f750811683240c4dddcce6e00000000000000000000000000000000000000000000000006f05b59d3b2000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d1a00000000000000000000000000000000000000000000000000000000000005bb000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004116ec97be7b52eb8f9ce32dd2be8b8b17a55f3f708dc0747085fbcd3809cfeda74ac0d242a829608bedab306eeafe2a1bc3009ba8d3da702176d51bf661ee43af1b000000000000000000000000000000000000000000000000000000000000001ca040963dc6e7e9178d6418fd7ec40749c85fa7124e4db9c7ccfdd6492b808d0b4ba04ea885de1ff27dbf6e5b49d4b5ff78f1fae34c4e08d038c001e80a50ce4e9b50f901ed826ebc8501a13b860083027bac943fda25f27211a138adf211f4c060f2149674be6d80b9018439125215000000000000000000000000035efb087a60412e2007d828b4ad6e75189e00b100000000000000000000000000000000000000000000000006f05b59d3b2000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d1e00000000000000000000000000000000000000000000000000000000000005bc00000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000414f0b8d47a5c6a520b551f9bb2b5a22dd353d2998a100a04049ff54c2dc07c09b41ae9a60fe8ece46df1043f953d3f29e99c59ff41f2161a47b907f25b2602ee91c000000000000000000000000000000000000000000000000000000000000001ba066e985a165bf5a4669414f4cbd258d63a16673bf16ed7c412e5695d168b0ecf5a02e905ec3ff65e8440b06de0606a1bac26c5af8f7c60855b69179f35860dd0a76f901ed826ebd8501a13b860083027bd0943fda25f27211a138adf211f4c060f2149674be6d80b9018439125215000000000000000000000000f46a707e36e82e8875d95db044d61d946decbfd700000000000000000000000000000000000000000000000005f766a7b9cd900000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d2200000000000000000000000000000000000000000000000000000000000005bd0000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041b572a6a493b4fdd9a6d507626c419d9404310156c9120e351bf9605a978a1393485759b5f4a3c6f5caad4ed7074dba47ef07b588abdccd3104b20d4ba55b1ad41c000000000000000000000000000000000000000000000000000000000000001ca067ee92962458c8ad972dee23024462fa85c00828ae87c321708b5bd85c362cfaa045499ee73114e396d650717d33116bebd9538c1fae159d8e375d31757f9f2848f901ed826ebe8501a13b860083027bc4943fda25f27211a138adf211f4c060f2149674be6d80b9018439125215000000000000000000000000e40afc776c18d8925199dcce78d0b06c9222b896000000000000000000000000000000000000000000000000058d15e17628000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d2600000000000000000000000000000000000000000000000000000000000005be0000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041303d5b10c749c48a9d44225cb5d91747023eb97e1adb55e73e598a3c87873f6c4a86c6b47b4feb06785e287b59bcd2114d5aad899fd0b903e9b31775daa6f27d1c000000000000000000000000000000000000000000000000000000000000001ca033e8a07a2e29159869f7df41920d306f2de33ad400039c3b52324735d78d6ee4a03acbf4ed12d71546f134c02141e29714293ab249ac1ed0c1d51fef7411b2d07cf901ee83011da28501a13b86008302b63c94aaf1349c9074af66f819ea42034fab02e335690e80b901843912521500000000000000000000000065b0bf8ee4947edd2a500d74e50a3d757dc79de00000000000000000000000000000000000000000000000008ac7230489e8000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d28000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000412586eabe42e225cddd48b3c59c8b6dc4b2679e97ba87c509641fb68b3544bd9e08a13f57d0b2acedcdc4e0f50c2602afb88dc7ff637dc3676a6499dca400c8971b000000000000000000000000000000000000000000000000000000000000001ba0317bbbdd9519de6803609e35d5cd44b490207ef615c656b7628503b30d5fe4f0a056594c96d5b494067904b9ad49c7767417a1c36d32baf14168f3f41ae384422df901ed826ebf8501a13b860083027bd0943fda25f27211a138adf211f4c060f2149674be6d80b90184391252150000000000000000000000006d0de6f2d59c12217b11f0d60c5b963ca1ebf1f400000000000000000000000000000000000000000000000002fe39243f10480000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d2900000000000000000000000000000000000000000000000000000000000005bf00000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000413df89ea440f704ea31309f12290399a49027eed6acf487472c3992ea7478c0b41ae797815085fcd70b3f2347343e8cdef10f4cab1dddad21d7ff2ba1373711ee1b000000000000000000000000000000000000000000000000000000000000001ba03d056a182cd76291805e89b28f6b138934005426ee744252400c05cb853546f8a06c9f3d52f2cdff358b969d5872abd85441211c1e7368bc6b3335845fce25e281f86d8219e085016b969d00825208948c9c5f3c395b4c51c315a618e6edc99e1ffefc4f870aa87bee5380008026a03f2fa4935726bbfda08aab1901bd8a8293a3b2969c82f9e5301014f0cd819e82a04fe8be58f8c5a7810e3c8b3db7636796f899f433419c5ca809834f04bdedaf52f86d8219e185016b969d008252089495831ab98c8859e6a532bad279e5d24be2760c5d870aa87bee5380008025a0dddb72a657028fbef10ac302b21dbfb023f0647f6378eee2ee91567fb34502a5a01b05df13f091a733a18efee35bb9d546291b30da4181c3e7171e477eecb1263df86d8219e285016b969d0082520894494aa496bd494d25172f2986459b9add11076882870aa87bee5380008026a06b247f01dc4aff33071a3c8426d397ee0c3f8b9fd363ee51bfed9567932754e9a0134e09f5572f76f281bb30a28381fdac77510c6de52a262db8ae86330f9d5484f86d8219e385016b969d0082520894d3ebb601e656e80cbd448080b2f732c185aa50c4870aa87bee5380008025a08f26ea1028f53e0d098110af8546d1f1db06013e1346cf73e2069dfe300f664aa04de06689b3fcfdc930bf0e2853761d2c7ea9b5401cfcfe4e7e377bf04e609838f8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650025a05eebe6f591cadb93d6321ecb2b0489a9fde288ddacf6fcbd9600cc92b038e540a02f2395e8e9719ed1781921f99446aac8c10f278c8fa10e206823d61ee5b9b161f8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650025a00c87437cddb039d3a57b6c0112d2a56694f00a77daf685cae0a15e31c391bba6a0397253655b3524f74cb17405618bdc35b5962174fa72676a167dcb7a66bc830ff8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650026a03c3adb38c12fd72c54064408b8204669b51657fbcec6d09e2df3f6af721f86dea034efb2cc02f95e8673784da149ded44021c1054f642136e551dd210a371fe9d0f8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650026a01d6a9f36bed8b9c0b06a5facea7aa227ea92886e1949cdf0b267bbb0d4f02f9aa027dd485ed579302aa102b44dc8889b46c46d41b3182538918683aff4470e2bc4f8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650025a0a4a143ddd7e4f1ae89bec89aa184f00fade35b48cbc618fe55d8d3750d6be196a05bb6019ce4afe996839d08a9d807b067243bd51e01d1c6417bca51622c6360d5f8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650025a045db4f2cc806c399c9949284c304c7b6c2d3ed9569157e8453057915dbb016c3a062b588775cb0c56773ae392fa37c1d816f58b76d381f04c31ff5e933f1569143f86d8219e485016b969d008252089463cbfc107ceec62a47808c8662ea466cb72e4272870aa87bee5380008026a0ff593b0bdabab6c4df62791c6dc42feb0f1197969355e0e5dac1ce1b2d7b94eea02f6366c5a9c63f17ad192b8c24daf47e940c388077262a8236f98c8656f0bc16f8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650026a007f014e898ffc8bc7bc83245ee3d83ffb4bfe850cb95325a53ed4ae141bf7d32a04416be4e529f4100f8bf8aa903030354c2516dc96e2cf95d37c72ff695c6da8ef8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650025a00d746d5aa2785e4747b1bdebfcbb8d904aa59bd3576d31d969e90e013d7a547ca06c809f9d406f9075feed7cc201768711dea07585d3c80b62503bbc3ca5912ca8f8a98085016b969d0082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000d101c062421d453d6af990be4aeecb28c378921f000000000000000000000000000000000000000000000000000000001dcd650025a05062ab7cf92c06218b08c2d413c62f77974581a3c30cadfbeb1e31ea6aaf2cf3a07922c4bd3602ceb1038e0b6920eae335406d6df432d7a9d8840713f1e15c0bc9f86d8219e585016b969d0082520894d4433887b8164f4d09b786a66dc1fc6302478cc4870aa87bee5380008026a094dcb1f1c094faa88c331676266180010a53ef725f3156f38fd4be8066d2a00ea04b5326d3567f7af9d75fbabf09c0b73c2341e69405fe5e99d713adb60f570730f86d8219e685016b969d0082520894bd0e30639f57e227c2027b607918998c3193a0fd870aa87bee5380008026a0d81f02d3355e87a19ec4f09fc62ec4e3237e0510d64ebabe1ba136d043f979ffa07564df2f66f34a61fb1b10a7d356e3f3dfe066941ea8d76671a009ef24fd3fa3f86d8219e785016b969d0082520894288ceb02e59e6331ae3ecf9bdc66813a1417979b870aa87bee5380008025a060de853bdb294b9626ebb032a7cd80b6e4a8c282d612672289812fa9b9f07100a07df62a243a188bd1d826b3e7f7c98375d323bf4eced611db3c51ccb5ff25eeecf86d8219e885016b969d00825208940a362742f877025b320ed80f04e9360eef9b5ad8870aa87bee5380008026a042a880fa0e3961971ef242e0b2f4bda8971f2bae003bd2aee021a7e85e69dafca031588866b1fa0d1a664f4e4ba14379e824e63787277104ed1593ddd89bd077a1f8ac823d86850169f3b6ba830186a094b6ed7644c69416d67b522e20bc294a9a9b405b3180b844a9059cbb000000000000000000000000db3a2fae6a1fc34af5d6bfd5e09d5dc9f83e4d8b000000000000000000000000000000000000000000000000000000003e95ba8025a03f197d12bb7745d7a21725611c56026c8c2255a6082dd04efff6521df637e963a05e613f9e66e214e07f5b24be338eaa168996041a9e08f23aacd102753b4742aff86c80850165a0bc0082520894b8f603bf1f54c4cc87a8f41a44b2e04504142b84881ae3a5498c0860008026a073f7033da0ceab4dc20cc4a9acb748d98153efc087f9524f81a91fecd74c2202a00cd128aaf31d574b294f5a3558f64ab720ef25155422390754697c2ebda6dcddf8ac8301a47184ee6b28008301082594a506758544a71943b5e8728d2df8ec9e72473a9a80b844045f785000000000000000000000000019587aac5a409af5f81bc09fbaec600f813ea018000000000000000000000000000000000000000000000013c9647e25a99400001ca0ab40a881c87094dde10b06475dc59cbb042c368574bc61f52bc6706d12b7372fa056beb6fcb5287b367709ed18ff0fb989f38d7b812567afe0f2b004c7d1ba66e8f8692b84c4b2010082520894ed2f6b6d2e85f6eb534a7950d5770c95a34ee45e8773900b2d4100008026a0c1bc6fef118a44925d9bcf3459a371b7ebd81e99ab3f965362f9f40b4f43a18b9f8f6c70c2c61d5c9deb40f1cf3736ef707c14adf44cffffd3dd4eef1b7b60d2f88f0284b8c63f00830145a194bcf935d206ca32929e1b887a07ed240f0d8ccd2287b1a2bc2ec50000a4f6838a72000000000000000000000000000000000000000000000000000000000000000225a0b68b2bbd2697212ad49e314f630363ceefe827862f4cdd274454f2f75ff9fccea050c6c4336c2968e8abfecd308bd523e3166bbd896817a5044044ccdb314a6e9af8728201bb84b2d05e008303d090948d12a197cb00d4747a1fe03395095ce2a5cc681988058d15e17628000084d0e30db025a06f15cc0403505031b74d12d71dfcedfdd4452b8d5eabb633f400fce218759145a03134524fa7d6257d5f47b9a169bbb94a2d03ff148e9d8322952484c59f427695f9035882039e84b2d05e00830493e08080b90304608060405234801561001057600080fd5b5060008054600160a060020a031916331790556102d2806100326000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416635367f7cc811461006357806368cdafe6146100785780638da5cb5b1461009c578063a27eccc1146100cd575b005b34801561006f57600080fd5b506100616100e2565b34801561008457600080fd5b50610061600160a060020a036004351660243561017d565b3480156100a857600080fd5b506100b1610288565b60408051600160a060020a039092168252519081900360200190f35b3480156100d957600080fd5b506100b1610297565b60008054600160a060020a031633146100fa57600080fd5b5060008054604051303192600160a060020a03909216916108fc841502918491818181858888f19350505050158015610137573d6000803e3d6000fd5b50600054604080518381529051600160a060020a03909216917f52dd0bb34607da290175a958db259372eab328201b51f87d642b4a2802a9463f9181900360200190a250565b600054600160a060020a0316331461019457600080fd5b6001805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03848116919091179182905560008054604080517fa9059cbb00000000000000000000000000000000000000000000000000000000815291841660048301526024820186905251939092169263a9059cbb92604480820193929182900301818387803b15801561022557600080fd5b505af1158015610239573d6000803e3d6000fd5b5050600054604080518581529051600160a060020a03928316945091861692507fd0ed88a3f042c6bbb1e3ea406079b5f2b4b198afccaa535d837f4c63abbc4de6919081900360200190a35050565b600054600160a060020a031681565b600154600160a060020a0316815600a165627a7a72305820c02d9c0d29792aa32be1602f23d2486590410db1797631ff5ab6351354941cde002926a031c6e79534e6262df63adb0add890f574366ec83e8faf39510549cc17edabe56a0512cf1d64eca15736d394f660a212f53188e50130237d6c63418f8778eca300df88b8304e74d84773594008309ae7f948a91c9a16cd62693649d80afa85a09dbbdcb850880a4ae8123ba00000000000000000000000000000000000000000000000000000004c670de501ba03b7f0fa0c116822ee04116ddc12d528bdce161bef7cbd87d2ee721a1462ba71da049be54a3d983f66aa1ab744b58fd71094e37640073ff086c67ac6f607eaed3fff86b8304e74e847735940083034cd3948a91c9a16cd62693649d80afa85a09dbbdcb85088084de1b24301ba0526d14def5208890a6a37e09cf44b6a0d368331cfd429d7948357562212127b9a07f16c6024ac4349b728b3fee2aced4a1cc3594920b75a8fa61fe72e03070a721f9018c8201e384773594008303d1e994e2f5349616fda0c35f6c66a14b6256e30dac066e80b901241239ec8c00000000000000000000000092baffdd6cfb11a4e57a58ffec4833b4d1abd25d000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000c00000000000000000000000000000000000000000000000000000000000000002000000000000000000000000ab3627317c43d394eb171170f818153b976d28a3000000000000000000000000ab3627317c43d394eb171170f818153b976d28a30000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000006b000000000000000000000000000000000000000000000000000000000000006c26a08268f87541eed5d7b913953df356ba2232c96fe487bbbc8888eb0b87deb52b09a0597a3006fec3ff1e895ee7b609ae9cf96628d35aac1492a31b6fe460545da541f86b30847735940082520894fe43908ec614978d7bf3f2d4b21464a95ae1a40688027f7d0bdb920000801ca0be2fb7805d7ee9bcf98733d66fe6f6a79bc433ca25557d0411d85cab0c1e0ceca0603ad626758c279c53fa7ed905ebfb63241bb410b8fa286a0d5d426bf13a3878f8a830847735940082fd9294a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4880b844a9059cbb000000000000000000000000320a8a22ce8dbbc5ede7704d26464079655cd0090000000000000000000000000000000000000000000000000000000102aa6a1025a093bddd4b4c2cdb4f2f9349521186a6bd494995bc2e843476f5abc39dd6f996bba076544fb860b76d816ed52c78cbca5ecda91cb11cbaa1aed8b0cd85a8c4f0bd7df86a24847735940082520894b9984d04f9e86eece91def5b83d454039768966f879c51c4521e00008025a031d55be4870fc3daf0effb7b16378a3de5d330c7ddfda2637dbb3f947102e6b7a047ec513cf84ee00c2eea56c4ae62a8e0e343436aa114a64ebbaed1d4d1c6bc01f8ad831fa7fd850ba43b740083032918948e870d67f660d95d5be530380d0ec0bd388289e180b844a9059cbb000000000000000000000000f77b83e4c5a93d667148b8781a536422395107e9000000000000000000000000000000000000000000000cb492cd929b8c83000025a00f6770b741428b70cdd9913f9b65ebe2165f5ee5934a9f37c9e0cbb81b774b06a057d429e334b63611b6275d1c995987194ce9438617e7768dd44441f3ca3bbdb6f8ad831fa7fe850ba43b74008303291894a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4880b844a9059cbb0000000000000000000000009bc2f223026c252c8ef5f7f33f00f4bee21434b80000000000000000000000000000000000000000000000000000000129c1739e25a002b182ede8fac748d12a54485f948310125a1e76f6933fffea214216eed1f3a3a006d3d2f06f729d403b8af4c1384523b56d7ce3e4cbc312bef181852d38e9b422f8ad833afb43850ba43b74008303291894a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4880b844a9059cbb0000000000000000000000002781a3ee67dde2f231171efcd8563dba1feee98a00000000000000000000000000000000000000000000000000000000891c225d25a05e99d9b3c2c0d853b76bada7fda8e800de41bce6f891b46e35bcb5ff103696f9a0712ff767d0ac708c797b26b64c11b4e22cf8eea45c26b2460a1ade968c31c509f8ad833afb44850ba43b74008303291894514910771af9ca656af840dff83e8264ecf986ca80b844a9059cbb000000000000000000000000bbfd389d4896337acd27b50c8a7b000e489f1689000000000000000000000000000000000000000000000000cc00e41db63e000025a0edd8ac181fcf8348f21a2901f3cad680d85c6899c06c4da4affc6909f7ac4edba057ca0d43aade351ffd5cf79529626f08550e1be3425a88ca60b58a5989c37d5cf8ad831f4ac8850ba43b74008303291894dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb0000000000000000000000008ae769cd430be7d4b4910189c5ef224116c9a7ed00000000000000000000000000000000000000000000000000000000772c453026a0fd8aaaf6d5d8dee761f10d9887b9cc847c04803e061b655b5b84604c3aa48a5ea01fac20020ea3dbce78fdfbf9f7e93ddbabf158392e15ccba76bf1296ab9d3fbaf8ad831e754c850ba43b74008303291894dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb00000000000000000000000094fe3ad91dacba8ec4b82f56ff7c122181f1535d0000000000000000000000000000000000000000000000000000000007a1728525a0d1549ecfb8e335d7747cb305a1e5b562589d254b278e53c725f37e9c1733aa8aa07e0feaa8e8900bd80831e516a12de30d4676413aa98797440c2bc493a564e830f8ad833afb45850ba43b74008303291894dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb0000000000000000000000007f083b58169fa3b13a9568d52d7196f5288eb88500000000000000000000000000000000000000000000000000000000002477f025a00984e461c32207fa1eca1edd9d012dd178b5d7e5e816ff0c7c7570d41ef1864ba03cfd47f38be0d5d0b6bf1f706aa97612ad0c8c8737930a1e676ae1ad2594883ef8ad831f4ac9850ba43b74008303291894dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb0000000000000000000000009f05c49c1e2104a14f4cbaefe49ce2292b7613b400000000000000000000000000000000000000000000000000000000595ee03025a0294548e0a265e20eca3e6bd20604f5ae29f4829f5a04bc20b51c1896507f93f9a0157a492d4773cf3da424072546cdf3f64919676debf7edcfdbcd1c440f90f061f8ad831f4aca850ba43b74008303291894dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000b39bc4d994dbb1876c677af840103d8d874ec5430000000000000000000000000000000000000000000000000000000264516c9825a05807b302947d534af5d68918aaa9e4ebd6cccf3fc8a933c45bd67e964d243d42a03227031eab6997896bd9bf8e5ec614218384cef3e9fa6ad92c8e6a5a788cc48df8ad831e754d850ba43b74008303291894dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb0000000000000000000000001a0be281f492099318deaf4f2e49caba10834e37000000000000000000000000000000000000000000000000000000024be7883125a0dec4ef359cb1bf68e9bb8b5d56c5b34e339d82c861ba23f19d58f2f1c7c4609da02aafab5bf86d4760ce8cee07abd78f0ee503411ff37f897203666502fce48235f8ac8307d4708507ea8ed40082fde89468e54af74b22acaccffa04ccaad13be16ed14eac80b844a9059cbb0000000000000000000000003a99e90d1d47b944ffb6c20e521de9f06246282e0000000000000000000000000000000000000000000000000000000f31cc93001ba052c7abca10b1f6dab12975ca17e3931fc71ec1ffde686cc37bb4423f439b372da0321591a997dd37bd7bbca4a8c62058bf7db0b6ff06e401399d70b227b9643c35f8ad830b31d48506fc23ac008301388094d7cc16500d0b0ac3d0ba156a584865a43b0b005080b844a9059cbb000000000000000000000000eff75da5ee8c37ff24fce9fda5cde61ff6f6824900000000000000000000000000000000000000000000000000000001b19fb0c025a07072b484c76f3c1158f434f69635f59deea9265be355fe9e2956077429d4f87ba062e35cdf76067d8a2a1ee7346b24185e24d9b0f7d9e52de90f214e4d53cbf1b0f86d818f8504a817c80082a410943f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be8802cd4b93cb4162a08026a0c8d3a641766b72f1139166168929e655c8cc207280b1a8bae0eb87d38ac003c3a07f809a1c22c5a65ebbd71a8be1adc8dc475a5b6805b7cc68e3f351e7e338d83cf8ac8234398504a817c80083011df294514910771af9ca656af840dff83e8264ecf986ca80b844a9059cbb0000000000000000000000003f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be00000000000000000000000000000000000000000000022262392b2b5a1c000025a0801e17a60f4f6877633f8a9eeb0789e6b4b76a3cdc235fb3955d32645943c7ada01c9f5c94f0fc38361eb816a4060d57000f0b900b5c7d140a3ea676b1defa4cb8f86b0d8504a817c8008252089445f29db1a9648d7d5243bfdf9d2f4f64e4e547da872386f26fc100008026a01df835c7876ca5c1310ed6107a2263594f8c59182f837b7cc9a7f76e99e8b293a001dc3bec59bb7bb4cf51aba9285e0e745772d4f0b923dab13cfa941e49caad78f86d820a5c8504a817c80082520894fcce195de7d146ef5ac37b09d0790049f26c6fbd87d529ae9e8600008025a0aab3cbf560d0d85196102c7c8a7af96b642ee67051919e12e98b7395edb95ce2a066b044c472ad4ff329f78ddd3cd7bccb84b6c5a881974f25891fff9ae1346954f902ae834a5ce6850218711a0083061a80942a0c0dbecc7e4d658f48e01e3fa353f44050c20880b90244ef34358800000000000000000000000000000000000000000000000004607b590e38b32d0000000000000000000000000000000000000000000000029ecb11f3a85f026c000000000000000000000000000000000000000000000000000000000002e630000000000000000000000000000000000000000000000000000000000000139900000000000000000000000000000000000000000000000004607b590e38b32d00000000000000000000000000000000000000000000000000000000000010df00000000000000000000000000000000000000000000000000038d7ea4c680000000000000000000000000000000000000000000000000000018571b2c4a8fd9000000000000000000000000000000000000000000000000000000000000000000000000000000000000000086fadb80d8d2cff3c3680819e4da99c10232ba0f000000000000000000000000c8ea00bd1a4bfe6b0da6fed8fdea3ee45af753f5000000000000000000000000badffcb79efe57e954fd79621b29b0c2dc170171000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000001b6ca37fbd90912b886b8f2e8d169af3dab5c5e7a9bf18d2c0e6d62c837b25c0c876def7b03c60d20e41ba417c2512df3026720359c648f7ec794fe702c0d6dc66df67927d75a043a33f6b0ef002896533c5efd8ac18b934f19fa13d32d0833bae5c105ea8c7bff24437eb93bffd6ad17e1da3f918fce73022939990ca13c579d226a090a154f204c200ff6de51c5b4d71bb6992e97451f653ea7167c5b5a2a7ba9fa3a0067bef3b5fb452f6fe18196c1ac80bb4e505565663dbf57052239d6aae027230f86c81b4850218711a008252089402581edb801626e76cf41b56a9891082bb1a02e487b105264347831a8026a036ef829da3f492c1e0e683881328448a3b8ce38cf67b815ac61750734eca71f6a037672d15a7bd5022227a403673aa8240889660d34f0d17167204c7e499cf546bf8728204d18501f8a471918288b894fa52274dd61e1643d2205169732f29114bc240b38801746a24053c3dc884f765417625a0562a6a07b5df76d2f518014af484cc4c93d86735cca3516f1456ed0243f12f49a02e43d79e52784b8a878f1e567ed582cd1bc5c22b8330f6029f871a5c2d513099f871808501f8a471918288b894fa52274dd61e1643d2205169732f29114bc240b38902b5e2a1949f0157c884f765417625a0050cb41ee90311d1c58dd933564cfb44d86d63bd49beebdcfce716ba0ab66bc7a04303d38e6ce3250a39e51bf0a6098ac7616fbe0d7f5f8aadccbbd6e6807042a9f86d8286998501dcd6500082520894b205228e68afb9788b83f094661ca86b7b8c927987051dac207a00008026a0a3a9994ac14e5eaf332f8c960cc57d563c8d386a43811a7c1ddbb959f97f97f3a06a164f2ae43678b39c51c4f0fdc0ef3c00650bae18c843d208623324b425d43cf86e82bf1b8501dcd65000825208944d0d53edffac1800512b02fea63934f0049d2cb48803782dace9d900008025a027aaa4a713037bb9c53ed65f7ea316ecffc0ca3bb6a69c20dabd3d03fd70976ba030eb8a4878650a2f3b94b2740eb3ef4235f861397eef1137d282221ba738ec6ff86e8270248501dcd6500082520894d167e55fdf2215020fb4cb3135dc6a2a4502086088048bd6d0c15afc008026a08417390839dfb02f35b3b02b2bd076cd256f18d1e1647c0c936178ffcb228efaa04985538e3ea7396d2e09bc4ba802c324faa059afdbf47c4cab817ca1a1df0588f8aa0b8501bf08eb00830186a094d26114cd6ee289accf82350c8d8487fedb8a0c0780b844a9059cbb00000000000000000000000095c703e331d21e9de9bfc27b837c68e7fe0a5bf0000000000000000000000000000000000000000000000024143d408c7be5700026a043cabbb9fa92685719d34bae160a2ef1dd5f8cb26b8d201e3011316c5f636be8a07f77d2bd610013a861fa6516da66839f31889763eefa3136770a66a9c7e4f33df88a82aa6a8501b6d1c6ed82a3e19461935cbdd02287b511119ddb11aeb42f1593b7ef80a44f9559b100000000000000000000000000000000000000000000000000000170e54b31711ca038332fee77f696449439dae0ce481274f11c8458286c04858bfa789b2190ea37a0071eac2f390ebaa24776447f67d1f783866bfe4bdc56bf2ed9ca5008a7aacb69f901bf83017dfe8501738b244a830b4db7948018280076d7fa2caa1147e441352e8a89e1ddbe80b9015501020509051ca4695f1ca4695f51d8847151d88471000000000000000000000000077d52b047735976dfda76fef74d4d988ac25196000000000000000000000000960b236a07cf122663c4303350609a66a7b288c000000000000000000000000000000000000000000000003486ac93e888ada433000000000000000000000000000000000000000000000034987c57fa701a000000000000000000000000000000000000000000000000000011cfb8659da627000100000000000000000000000994c18ed0c328f38d2c451b2a2e1ceb1ae6a812000000000000000000000000960b236a07cf122663c4303350609a66a7b288c00000000000000000000000000000000000000000000000097045d60ed801df920000000000000000000000000000000000000000000000095e656c9b448700000000000000000000000000000000000000000000000000121c6b7ddb5f2a00001ba09e3d8ff3bebb518edd777ce7e8fc04948b9fefc5973e46cbb7dfd63fd6db59c3a07c062d8d4a34bd2608f5e82e96395b220c722dabc281468261e664ad84f99403f9018d82099985017386906a8314acf894498aa5830da490c85dc4d92b410aab86edb26c1780b90124d9d6333e00000000000000000000000000000000000000000000000019c976ac4bad800000000000000000000000000000000000000000000000001a348bba197dd20000000000000000000000000000000000000000000000000034941eed24ed2a0000000000000000000000000000000000000000000000000000000dabd95bb66c7800000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000003000000000000000000000000960b236a07cf122663c4303350609a66a7b288c0000000000000000000000000077d52b047735976dfda76fef74d4d988ac251960000000000000000000000000994c18ed0c328f38d2c451b2a2e1ceb1ae6a8121ba0c024ee4175a21924661b40d1b32e381238a7b088e0b0db9dd99bf5d5e67ae01ca057e55a55d9a8f968a847aae8c3c1565322c04b5ea56216d6477d2eacd6165ceaf86d8219e985016b969d0082520894be8575daa52aee91cb7a90bfc4847761d29ed2b4870aa87bee5380008026a01b4aa6f8b34a84bdf118192dfc5cbf98d4865b198f327aa6b9fda0668a752ec4a02b656bcc9068aa1c4f49594c9fb2a640439bd9f0c1f8db143b25cefa136940def86d8219ea85016b969d00825208944e5b999319940dbb319bce6b8125ff18461d0144870aa87bee5380008026a0777d5bed10aa782ad354632de6fa7f030d8d3fb5a50f52bc53dfc6fee38a0d6aa07119044682a6e2f74ac4f627a2220b7853aa2d48cc4b4f0bbe779678fd845bb7f88b82347585012a05f2008303598994794e6e91555438afc3ccf1c5076a74f42133d08d80a440e58ee50000000000000000000000000000000000000000000000000000000000028ef826a09085f605d566e00141c1933ca5db0174cc968f11aeb8d9d7680a6cbfa3a0dabda07036d3578ecf267bff4dcafb7eba8431b469367a3e009598d6b12635dedde1f8f86b7784ee6b280083013880949fcafcca8aec0367abb35fbd161c241f7b79891b87f1ab77b43380008026a0f138f816f8dca25ea137b822f1b73c8ddc3e8fcb70efbdcbca9dd2e569650bd4a06e77be8628f75c0d1e09b4d29f82938f6e448f7aa86927809a81053070d26032f8a96484832156008301388094572328202d37b031b490f6659d8549e7bd71232480b844a9059cbb0000000000000000000000009a3e92157d290dcda6f94c8d4c882a04d2f2095300000000000000000000000000000000000000000000000000000000009834d81ca0fb9b7625019c6226f4c04b1a29baed836259136d2a133b012605ed80bdd75527a019411523a5bd73a4b4f3055050c1130f082bab9b099e181356b7153c3baa57e5f86e826a9284832156008252089484e5aea8421a18c294eea09b0e68a47acffda5dd89018dc9404c572580008025a002419d94671d6ee3c2e1c0e64b4209833b147c1a0d98704f6e3a051059f91bf3a03711211d81ad20ec92e8bf686326d43f235508b2d88f52eeb14c7d6c57278f86f86c818b84773594008303345094dd292f008c4eb276baa517b5ec541c00345b4e1f87b1a2bc2ec500008026a034bd633565b8a15dc7f836b86e05294a240f6a0c7bf29ff7dec5344d78de1f09a055091f85bca660409520aab6a7cb5586746e506202a9c29164add9861ac22977f86b82094c8507c0d5ad00830845b09411ecbf984888c6ad172538c5cfb7de3832e46db68084f05fe81a1ba0dfaf85606f0a8c5d3de43ddc7b23aff096138ec1db64fbf03ee329a4b1c7edf7a0682132153dcaa8a07be956e8b524321b36ae012145936a3039ee81d2f851365af9048a830114028505efeb1f0083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ca0a888a34d353c4d7c9dde8bf37cc689fe5c0a48f8d74d2812f430a8490b5ad5d5a016adc7253714a9116d60fc679206c841bf459e4c989aa25edd0dfd4555eca161f9048a830114038505efeb1f0083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ca05e6abc8b3fa9c8763ce5de4ad3acb3bd98d7b9ec2d47bae1fb5ddfe0860f8845a015e6a78a2fd5099b3ee765777a9d2d8839b01f887b07b84d0d0c1a8da8ed3c8af9048a830114048511d9e35b8083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ca0c562f1cd6d65a782dee2eed4d3e6a426ba77d5aa9a2f24689d7b4679e67cb06ca06d529d20138ccd55a2336c91d81f09c5b49a5ca7f59cb7c410f316bb4f3c2a51f9048a83011405850fd51da80083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ca0fe0d1f737e8835eb9c297f43d9be4851231b5975c573a2a004d3a1a5386e1891a05392269cf49a9ae4e1a0452446d87b3eae236c3c927dd6e96faccb770fbd8202f9048a830114068505efeb1f0083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ca0ef7c5a55320b9a2b3d7857bb00635d62267b272fdead3888066e0f51694b70bea0161ef3f4b0356afd00e79cdb2777ddb7e83673f8322ee1bdc974ba9a7fba6d1cf9048a83011407850fd51da80083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ba0cee38bcdbd585394ebc57b280613076667ce34a005a1563dd11b659158d61daba012985fd6e197db21b746e3d26d8751308fee19924fbab97daeb337a7af5d874ff9048a8301140885103a71990083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ba0f5b79bb81e42a4602b07e54402195ceaace5375c6e332a0da8ae5b3d324bfc8da001259c25021a02951d996d04bea28c98e4cd9d4ac92df03b34b572f9e51ebf62f9048a8301140985103a71990083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ca0cc2c175435b92d2b09ac11788266d03177ce053e8d79a5870bd5dbd8c05ff77ea0142a0d172a702b42b29d07384a3f89d662d9a15dc7e30332534935ebfbdf9e50f9048a8301140a85103a71990083061a808080b90434608060405234801561001057600080fd5b50604051602080610414833981016040525160008054600160a060020a03909216600160a060020a031992831633179092169190911790556103bd806100576000396000f3006080604052600436106100615763ffffffff7c01000000000000000000000000000000000000000000000000000000006000350416638568523a81146100d85780638da5cb5b146100fc578063b76ea9621461012d578063f2fde38b14610187575b60008054604051600160a060020a039091169134919081818185875af192505050156100d157600054604080513481529051600160a060020a039092169133917f5548c837ab068cf56a2c2479df0882a4922fd203edb7517321831d95078c5f62919081900360200190a36100d6565b600080fd5b005b3480156100e457600080fd5b506100d6600160a060020a03600435166024356101a8565b34801561010857600080fd5b50610111610244565b60408051600160a060020a039092168252519081900360200190f35b60408051602060046024803582810135601f81018590048502860185019096528585526100d6958335600160a060020a03169536956044949193909101919081908401838280828437509497506102539650505050505050565b34801561019357600080fd5b506100d6600160a060020a03600435166102f1565b600054600160a060020a031633146101bf57600080fd5b60008054604080517fa9059cbb000000000000000000000000000000000000000000000000000000008152600160a060020a0392831660048201526024810185905290519185169263a9059cbb9260448084019382900301818387803b15801561022857600080fd5b505af115801561023c573d6000803e3d6000fd5b505050505050565b600054600160a060020a031681565b600054600160a060020a0316331461026a57600080fd5b81600160a060020a0316348260405180828051906020019080838360005b838110156102a0578181015183820152602001610288565b50505050905090810190601f1680156102cd5780820380516001836020036101000a031916815260200191505b5091505060006040518083038185875af19250505015156102ed57600080fd5b5050565b600054600160a060020a0316331461030857600080fd5b61031181610314565b50565b600160a060020a038116151561032957600080fd5b60008054604051600160a060020a03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a36000805473ffffffffffffffffffffffffffffffffffffffff1916600160a060020a03929092169190911790555600a165627a7a723058208d28c5b3a73c2f4e576161d5d18dd2e7ede7e5e4d26553553c447043647eb2880029000000000000000000000000a910f92acdaf488fa6ef02174fb86208ad7722ba1ba064ee58d7c3de459577ba30d3d99690936a0a6b8e7921872e163957be9d1f9d8ca053452be17c130f0baed869e7bb9e7a016dced8c9b0c3fbaa9036f24f96d76d64f86f8204a68504a817c800830186a094d66de061d230bcb47220b7a0dd7a9e0e46ec12c88819798bfa3c4dbc008025a029da55c5b3edf5342b5a8846759db401c5fa5fe316433c3f1bb6a0c5667ffa47a0542cfa028ea20480b167ad2a49d38b689473a7b570e8740a8ce56c6f4147d6cff86c0b8504a817c80082a410943f5ce5fbfe3e9af3971dd833d26ba9b5c936f0be883a52b78874bd28388025a0e94d1080bfd9bfa8aff3e3033345ade515444a9263f8e2c105328f28c9bb18b4a032da6f636a3a0b6738d6937240ce49f18311c2c99a02f8f1861ab2e53c9687acf86d0a85028fa6ae008301425494cf511fe654544687a98bd9dbb8659bc9801319888829a2241af62c00008026a01a6603f14e0bc48aa3d0ccf698cf79b4473a0e8b91d30810b228974ebd6f87d3a0427e012117f7bdc2686198e1c022b3da4c17119252f74462839f5246dc01d3cef901338201e7850269a224ed830249f094d1ceeeeee83f8bcf3bedad437202b6154e9f54058806f05b59d3b20000b8c45e83b46300000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000093c922d7a48be25dd08c227d109ed4489a686b9095e20fb96b2b56473b4e384661eff2ba93d0820e8e029bb3ca9650e442ce2d5ccab98963288be314306cc626b1126591cbea0e04bab4f06886560d577e9f48af9efa1fa9a141046d2f3b0eb65253b225a085e9fcfeb7d96726c0db4cd19405f534f72a6f718b3c2ee3f0c2bf8d473f441a9f32c6963921fc3f3a51aef23aa46eeb842d929a89b87a5677671674981e9bd3f8ac831aa24e85022e075aed82fde894d1ceeeeee83f8bcf3bedad437202b6154e9f540580b844ca722cdc69ddfdde1bbf4f6a50bc136ffc7789dc7d0f47617cebcda5ccb7d98494ef975a0cf5de8aa4aed686f0fc8691e42f9934b7315bcc4181d46af788aeb696be98011ba0ad82c4924d5734ae9a86b5f94df2958aa7437239ad64c1a6ef927ff7b69849bba029e31d9b4e1d5ed91dab9b67e8396ecaaa0d611c66e5dbdeff7891f271bdbd0af902ae834a5ce7850218711a0083061a80942a0c0dbecc7e4d658f48e01e3fa353f44050c20880b90244ef34358800000000000000000000000000000000000000000000000002a30408b817cea0000000000000000000000000000000000000000000000005e1516e80e0b6000000000000000000000000000000000000000000000000000000000000000186a00000000000000000000000000000000000000000000000000000000014ec359200000000000000000000000000000000000000000000000002a30408b817cea00000000000000000000000000000000000000000000000000000000003023a5500000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000023b6d03d366b5f00000000000000000000000000000000000000000000000000000000000000000000000000000000000000008a854288a5976036a725879164ca3e91d30c6a1b000000000000000000000000e706b6071a5459fa34f7c85d938edd1912a4cf56000000000000000000000000cfca3d48d935c824bc195cd54e28d829ce96634b000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000001cb20c8e20beca4642ec65722f854163ed0a0b047f9798edfdcd4b16d97a657b3d2826857c79f25b9858a356da29d6c9218893e443733468105d7f201e94907305927cbfdc176109f32e72dda29735ec4aedbac981c3cba164393eba7e2e7577915710c6d8a01968a358bc6a552029d0add9df99689535bcbc478c81ad78c99faf26a0778c2c39be7125b18fbce6ab87e425893ec016864a8abdc807e9fdf2ff7ae81ea03447bd3b372d5cc721bd721bd3b1304df9d08b029a830f224a0c2738d74a1ad4f902ae834a5ce8850218711a0083061a80942a0c0dbecc7e4d658f48e01e3fa353f44050c20880b90244ef3435880000000000000000000000000000000000000000000000004563918244f3ffff0000000000000000000000000000000000000000000000298a51f5f4cde77a9a000000000000000000000000000000000000000000000000000000000002e6300000000000000000000000000000000000000000000000000000016eec6553c600000000000000000000000000000000000000000000000002fb139fa79ed4d300000000000000000000000000000000000000000000000000000000000010e000000000000000000000000000000000000000000000000000038d7ea4c68000000000000000000000000000000000000000000000000000002069a05a3934ad000000000000000000000000000000000000000000000000000000000000000000000000000000000000000086fadb80d8d2cff3c3680819e4da99c10232ba0f0000000000000000000000008a28fcd950407c324dc49e44bcd475043ffa9fa4000000000000000000000000badffcb79efe57e954fd79621b29b0c2dc170171000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000001cd6d0f95c49415619585880e7632012be9b381d63472f50ae057f28bfef8c0baf42e0b6a8c292a5dc60d9a92ff42129267e9a81446cf4c1729bbe24483404903f507e700ec0bd89c36db3cf919db7e6a7765704c303af18c9aa86b755a11ad4382208e31a72a7b3dd0c73a04a050270f1898a10c5813b968d70c228a933795c2d25a08e6e311fe804f781c274868a1b1975e462c8b03e3f996e1913214e75dee26787a04db3e4eaa86b753f0335ab49691429ef820b66f5e16d643971dd9d95497979f0f8ac8209ad850218711a008301077a94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000fd9e4123104d107f0f8f60316b169f6e82af2bf4000000000000000000000000000000000000000000000000000000001924a18526a0bf85ad2d702982698455e3e967b5f1d62d4bfe6cff4702f73cc07b875cab0833a0395d3c9bd0814d72bcac87bd371efdac4e5adafefdb5fea56a971604fa4f2549f8a9808501dcd6500082a0ed94dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb0000000000000000000000006f5b5668159d546b4a59b66691117b4d4f91621a000000000000000000000000000000000000000000000000000000000098968026a0ce1032f7125d2e5e06b7663477d5b8aea69398903b91f2365c08413faa875766a0268d66241b57e450d6a93d412dc215e7b1f8c3a02eb1c653c30ec2064d5826caf8aa028501dcd6500083030d4094a0b86991c6218b36c1d19d4a2e9eb0ce3606eb4880b844a9059cbb000000000000000000000000ea3f4d89133a5e8d922175f87f798631bf4f74a5000000000000000000000000000000000000000000000000000000003593794325a0553c3341c7050acec2129fbd4671119c1453f313aab4dde1e7b7baba77767ebfa00ff7cec6f0f81940fadd73c359b3b97d560928847efb103321eb51934b87456ff86b038501dcd6500082520894ea3f4d89133a5e8d922175f87f798631bf4f74a58705af3107a400008025a0a3d6a0db5c9ef52962f15c15d1b8d551751b18d6f1e45a9b0eb36535efe3e64da0347028d0ed832de8c19042a170c8f7b58bc992374344e2143d5a2505eec38292f86c808501dcd6500082520894121f2d296a461f7ee517a997c35982250c3acae68822b1c8c1227a00008025a02bb40b16a9b186b95a333a4c9b7d9b22803eb9bbd96ba3f25a038e31915111efa020cd19fcdb2d02e17025bab78a1103ecd383ab3252008eb0f02bae4ff2c947b9f86d808501dcd6500083015f9094973c3dd9f6082cfc3316cb517fbbc67c4f48c50588028cb8ea015bd0008025a06955b5ff01f54e97f81a06190a497ea0df988b422f0c006e15747dc442e78641a025385a5eff69aeb7d84d20408cc966659ad3959231a01cb1c43ba13835a6a056f86c808501dcd6500082520894c6dcd772131d95d61d9c53c52d162e2d0d43c063880de0b6b3a76400008025a086d688e42fdbee0a914672749898990bb9c027470996410a9050067e75d7a15ca004a25306e497831396725400540f1e64d4863d6024e87cf6b865c3d42503369ef870830101eb8501dcd6500083015f909446b31fab6cb08ce1c42e219a42286d478ead4e088855908c86b16680008026a0c2345fbfbe1f12a5f9815e9a5dfd529ee3cc008e62912099bca674f9e2edc322a02c3b2342b9c324d92f35676de8d199e83b1a12379463b0102c523201581621a5f88b8302afa18501b6d1c6ed82a3e19461935cbdd02287b511119ddb11aeb42f1593b7ef80a44f9559b100000000000000000000000000000000000000000000000000000170e54acf241ba0954b79b03e747214c205e224c18046a950c36086bbb36ee838e9c2bb772dc975a0488ca400752a1bf8412c77ac20d4bd69d5f672ec6ae298287865f767f716e069f901ed826ec08501a13b860083027bd0943fda25f27211a138adf211f4c060f2149674be6d80b9018439125215000000000000000000000000584a222ed4e1aac5495aedf8310165ad1e63d04d00000000000000000000000000000000000000000000000002df69673c81800000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d2c00000000000000000000000000000000000000000000000000000000000005c000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000416aa20e2abc336d823819cfcf1f6167707ca0e2307a5b1c31829614d76d61bc2c7a95e729e868c4b5ed8a646dc8edcb2fc71d2d1975e00152aed7b45c08a215031b000000000000000000000000000000000000000000000000000000000000001ba067be3a0c5662c4744637eb18395c5d3ad47b7a316d5ed1a6c49607a421951f96a050955870333823330eeec0c2653321f142d6e39bfc4d0f1c5090c0cb2316b053f901ed826ec18501a13b86008302dd6c943fda25f27211a138adf211f4c060f2149674be6d80b90184391252150000000000000000000000003418beb1f372325bd4a6cb9d7869cd5d39b070cc00000000000000000000000000000000000000000000000002386f26fc10000000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d3100000000000000000000000000000000000000000000000000000000000005c100000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000414337c46ccc0f1aa08f4a15ac051c56384457c8800f03b169507885b3ee1595a877ef8b9aae1e4e966d906938aa3d8e81a258d19af75239789a14865eceb3d1781b000000000000000000000000000000000000000000000000000000000000001ca01c71e5075c67e5ddd6f0896c2970c0769c6f852b87b4215ca041379914de7014a01730e521c809699701d8bc4448faf4b12334dd6fa460d0005ab45494e7e23beff901ed826ec28501a13b860083027bd0943fda25f27211a138adf211f4c060f2149674be6d80b9018439125215000000000000000000000000abb64756f84699a49f8bf548944c184d4f774fae0000000000000000000000000000000000000000000000000238e45e32ae600000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000005e792d3500000000000000000000000000000000000000000000000000000000000005c20000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041750ccbf56526353544f205baf1493b998d1db669eb7b25b47d07eec28cd5adda585180076e41dd12caaee366a15d426108e0f40cf6515d1874e4aaf315c26bec1b000000000000000000000000000000000000000000000000000000000000001ba0775f5c4ca89cf403b022698de49b985740dcd069d90e8a1b3f8376b906a0d3c4a034703c07c5913512c72a487390f69bb69e771dcf69caf7e88579f58fa9b5e782f86d8219eb85016b969d00825208942079ab404ba8ef4c56ffda468f9b8d511b8d3ff6870aa87bee5380008025a00b4e5247677969302172f893ca3bce264ff1b386994ceb90e30d0c70ba89e22da047ca2af652e0df168f6a844d5c8953f1b593f248638678bb95d25e79282c0a9ff86d8219ec85016b969d00825208942555b0ce3fc35da3dd73028288fe02087a0ee144870aa87bee5380008026a05c521ffdaa29de3f6ec18bb187e0e4326aae1409521f52e1da7858751d80a28da00f2887d27677ad71f17db0a389c2cbc96537fdb5acd5e69952d4b9c98c703a8bf86a1484b2d05e00825208941e52671bbb6f861ecb3efce11b858b76db00f1328781a3762fdaa000801ca032a466b7d65c11971da29c0de5a2b77b961dfd94b5221ab9d76ce858ced0a106a01f5421e2f7f11f1cb0c8da7b0479bbcf86f45dbec05bc5e908dfe703115b7d78f86b8304e74f847735940083034cd3948a91c9a16cd62693649d80afa85a09dbbdcb85088084de1b24301ca06e860e92d2e1a2b1bdb19f19cefb7bed5cd26042893401fa1ab6794b839ee657a016db56c40c97372c623e9a5fb605ca80a73c9616480060098b1dec248fa102b9f9020ef9020ba00bb817be7ecbf0971933151b15ee7d0c465d5e390d4ff88891ab2fcba1ca31b2a01dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d4934794652d38d814bcdf3e0f750a0faf272ee96c1f67eda0ea805f031138bcaeb8c912289782015496964d495186bce3fbeb7725f766dce9a0da213801190b3740cba490a45a4406dd9499f1225013b5eb7071da8ab4a1aa07a067f2632c71b6b5ad3b5b1cef1c226c10958688927856905ceb4850607be214fcb9010000061041d04b1a29000a04120000011d21a01100165100080006312804480a0802093514e6000040800003000022293c90003800508e8401200a108220299014c08022400622001048800808032040a00040001c032a264480424d0002003040180004c40200001100001200002808030480384018c46110100230161000822009208142420400410000000a000024b21250000803029004045840540a32100c0654a8000001220516104d8004c58c800400600480018000002240a018120044f092300349f004028080e001250020c40802422580880050512a0b50108c3000c8108420020a000880302082300002d044044224880090100a010020209008408707ca61adbdfbd68393c85a83982d758398265c845e6ff2978a706f6f6c696e2e636f6da04e3ea29f4695ce9fb7d3bcdf36b1ab341a4dc3b1c943e2d3d60e69b3c971f44788fd7d62a0126507d9"
+)
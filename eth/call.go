@@ -0,0 +1,79 @@
+package eth
+
+// CallMsg is the transaction call object accepted by eth_call, all fields are optional
+type CallMsg struct {
+	From                 *Address    `json:"from,omitempty"`
+	To                   *Address    `json:"to,omitempty"`
+	Gas                  *Quantity   `json:"gas,omitempty"`
+	GasPrice             *Quantity   `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *Quantity   `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *Quantity   `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *Quantity   `json:"value,omitempty"`
+	Data                 *Data       `json:"data,omitempty"`
+	AccessList           *AccessList `json:"accessList,omitempty"`
+}
+
+// NewCallMsg returns a CallMsg with the fields of a transaction that affect the result of a call.  Zero gas,
+// value and input are left out so that the node picks its defaults.
+func NewCallMsg(tx *Transaction) CallMsg {
+	msg := CallMsg{
+		To:                   tx.To,
+		GasPrice:             tx.GasPrice,
+		MaxFeePerGas:         tx.MaxFeePerGas,
+		MaxPriorityFeePerGas: tx.MaxPriorityFeePerGas,
+		AccessList:           tx.AccessList,
+	}
+
+	if tx.From != "" {
+		from := tx.From
+		msg.From = &from
+	}
+
+	if tx.Gas.UInt64() != 0 {
+		gas := tx.Gas
+		msg.Gas = &gas
+	}
+
+	if tx.Value.Big().Sign() != 0 {
+		value := tx.Value
+		msg.Value = &value
+	}
+
+	if len(tx.Input) > 2 {
+		input := tx.Input
+		msg.Data = &input
+	}
+
+	return msg
+}
+
+// AccountOverride replaces parts of an account's state for the duration of a call.  State replaces the whole
+// storage of the account while StateDiff only replaces the given slots, so at most one of them can be set.
+type AccountOverride struct {
+	Nonce     *Quantity         `json:"nonce,omitempty"`
+	Code      *Data             `json:"code,omitempty"`
+	Balance   *Quantity         `json:"balance,omitempty"`
+	State     map[Data32]Data32 `json:"state,omitempty"`
+	StateDiff map[Data32]Data32 `json:"stateDiff,omitempty"`
+}
+
+// StateOverride is geth's state override set, keyed by account address
+type StateOverride map[Address]AccountOverride
+
+// BlockOverrides replaces fields of the block a call is executed in
+type BlockOverrides struct {
+	Number        *Quantity `json:"number,omitempty"`
+	Difficulty    *Quantity `json:"difficulty,omitempty"`
+	Time          *Quantity `json:"time,omitempty"`
+	GasLimit      *Quantity `json:"gasLimit,omitempty"`
+	FeeRecipient  *Address  `json:"feeRecipient,omitempty"`
+	PrevRandao    *Hash     `json:"prevRandao,omitempty"`
+	BaseFeePerGas *Quantity `json:"baseFeePerGas,omitempty"`
+	BlobBaseFee   *Quantity `json:"blobBaseFee,omitempty"`
+}
+
+// CallOverrides are the optional overrides of eth_call
+type CallOverrides struct {
+	State StateOverride
+	Block *BlockOverrides
+}
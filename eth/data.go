@@ -321,6 +321,124 @@ func (d *Data32) RLP() rlp.Value {
 	}
 }
 
+// MarshalRLP implements rlp.Marshaler, encoding the Data as an RLP string.
+func (d Data) MarshalRLP() (rlp.Item, error) {
+	return dataItem(string(d))
+}
+
+// UnmarshalRLP implements rlp.Unmarshaler.
+func (d *Data) UnmarshalRLP(item rlp.Item) error {
+	if item.IsList() {
+		return errors.New("cannot decode RLP list to Data")
+	}
+
+	parsed, err := NewData("0x" + hex.EncodeToString(item.Bytes))
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed
+	return nil
+}
+
+// MarshalRLP implements rlp.Marshaler, encoding the Data8 as an RLP string.
+func (d Data8) MarshalRLP() (rlp.Item, error) {
+	return dataItem(string(d))
+}
+
+// UnmarshalRLP implements rlp.Unmarshaler.
+func (d *Data8) UnmarshalRLP(item rlp.Item) error {
+	if item.IsList() {
+		return errors.New("cannot decode RLP list to Data8")
+	}
+
+	parsed, err := NewData8("0x" + hex.EncodeToString(item.Bytes))
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed
+	return nil
+}
+
+// MarshalRLP implements rlp.Marshaler, encoding the Data20 as an RLP string.
+func (d Data20) MarshalRLP() (rlp.Item, error) {
+	return dataItem(string(d))
+}
+
+// UnmarshalRLP implements rlp.Unmarshaler.
+func (d *Data20) UnmarshalRLP(item rlp.Item) error {
+	if item.IsList() {
+		return errors.New("cannot decode RLP list to Data20")
+	}
+
+	parsed, err := NewData20("0x" + hex.EncodeToString(item.Bytes))
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed
+	return nil
+}
+
+// MarshalRLP implements rlp.Marshaler, encoding the Data32 as an RLP string.
+func (d Data32) MarshalRLP() (rlp.Item, error) {
+	return dataItem(string(d))
+}
+
+// UnmarshalRLP implements rlp.Unmarshaler.
+func (d *Data32) UnmarshalRLP(item rlp.Item) error {
+	if item.IsList() {
+		return errors.New("cannot decode RLP list to Data32")
+	}
+
+	parsed, err := NewData32("0x" + hex.EncodeToString(item.Bytes))
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed
+	return nil
+}
+
+// MarshalRLP implements rlp.Marshaler, encoding the Data256 as an RLP string.
+func (d Data256) MarshalRLP() (rlp.Item, error) {
+	return dataItem(string(d))
+}
+
+// UnmarshalRLP implements rlp.Unmarshaler.
+func (d *Data256) UnmarshalRLP(item rlp.Item) error {
+	if item.IsList() {
+		return errors.New("cannot decode RLP list to Data256")
+	}
+
+	parsed, err := NewData256("0x" + hex.EncodeToString(item.Bytes))
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed
+	return nil
+}
+
+// dataItem returns the RLP string of a hex string, the empty string for ""
+func dataItem(s string) (rlp.Item, error) {
+	if s == "" {
+		return rlp.Bytes(nil), nil
+	}
+
+	if !strings.HasPrefix(s, "0x") {
+		return rlp.Item{}, errors.Errorf("invalid hex string %s", s)
+	}
+
+	b, err := hex.DecodeString(s[2:])
+	if err != nil {
+		return rlp.Item{}, errors.Wrapf(err, "invalid hex string %s", s)
+	}
+
+	return rlp.Bytes(b), nil
+}
+
 type hasBytes interface {
 	Bytes() []byte
 }
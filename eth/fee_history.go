@@ -0,0 +1,21 @@
+package eth
+
+// FeeHistory is the result of eth_feeHistory.  BaseFeePerGas and BaseFeePerBlobGas have one more entry than
+// the number of blocks returned, the base fee of the block after the newest one.
+type FeeHistory struct {
+	OldestBlock   Quantity   `json:"oldestBlock"`
+	BaseFeePerGas []Quantity `json:"baseFeePerGas"`
+	GasUsedRatio  []float64  `json:"gasUsedRatio"`
+
+	// Reward holds, for every block, the priority fees at the requested percentiles of gas used
+	Reward [][]Quantity `json:"reward,omitempty"`
+
+	// EIP-4844 blob fees, only returned by nodes supporting Cancun
+	BaseFeePerBlobGas []Quantity `json:"baseFeePerBlobGas,omitempty"`
+	BlobGasUsedRatio  []float64  `json:"blobGasUsedRatio,omitempty"`
+}
+
+// Blocks returns the number of blocks the history covers
+func (f *FeeHistory) Blocks() int {
+	return len(f.GasUsedRatio)
+}
@@ -0,0 +1,161 @@
+package eth
+
+import (
+	"math/big"
+)
+
+// Fork selects the gas rules of the network upgrade a transaction is executed under
+type Fork int
+
+const (
+	ForkFrontier  Fork = iota + 1
+	ForkHomestead      // EIP-2: contract creations cost 53000
+	ForkIstanbul       // EIP-2028: non-zero calldata bytes cost 16 instead of 68
+	ForkBerlin         // EIP-2930: access lists
+	ForkLondon         // EIP-1559: base fee
+	ForkShanghai       // EIP-3860: initcode is charged per word
+	ForkCancun         // EIP-4844: blob transactions
+	ForkPrague         // EIP-7623: calldata floor, EIP-7702: set code transactions
+	ForkOsaka          // EIP-7825: transaction gas limit cap
+
+	// ForkLatest is the latest fork whose gas rules are known
+	ForkLatest = ForkOsaka
+)
+
+// Gas costs of the intrinsic gas of transactions
+const (
+	TxGas                     = 21000
+	TxCreationGas             = 53000
+	TxDataZeroGas             = 4
+	TxDataNonZeroGasFrontier  = 68
+	TxDataNonZeroGasIstanbul  = 16
+	TxAccessListAddressGas    = 2400
+	TxAccessListStorageKeyGas = 1900
+	InitCodeWordGas           = 2
+	TxCostFloorPerToken       = 10
+	TxAuthorizationGas        = 25000
+	GasPerBlob                = 1 << 17
+
+	// MaxTxGas is the most gas a transaction may use since Osaka
+	MaxTxGas = 1 << 24
+)
+
+// CalldataTokens returns the EIP-7623 tokens of the input of the transaction, a zero byte being one token and a
+// non-zero byte four
+func (t *Transaction) CalldataTokens() uint64 {
+	zero, nonZero := t.calldataBytes()
+	return zero + 4*nonZero
+}
+
+// CalldataGas returns the gas charged for the input of the transaction under fork
+func (t *Transaction) CalldataGas(fork Fork) uint64 {
+	zero, nonZero := t.calldataBytes()
+	if fork >= ForkIstanbul {
+		return zero*TxDataZeroGas + nonZero*TxDataNonZeroGasIstanbul
+	}
+
+	return zero*TxDataZeroGas + nonZero*TxDataNonZeroGasFrontier
+}
+
+// IntrinsicGas returns the gas charged for the transaction under fork before any code is executed: the base cost,
+// its calldata, its access list, since Shanghai the words of its initcode and since Prague its authorizations.
+func (t *Transaction) IntrinsicGas(fork Fork) uint64 {
+	gas := uint64(TxGas)
+	if t.To == nil && fork >= ForkHomestead {
+		gas = TxCreationGas
+	}
+
+	gas += t.CalldataGas(fork)
+
+	if t.To == nil && fork >= ForkShanghai {
+		gas += InitCodeWordGas * ((t.inputSize() + 31) / 32)
+	}
+
+	if t.AccessList != nil && fork >= ForkBerlin {
+		for _, entry := range *t.AccessList {
+			gas += TxAccessListAddressGas + TxAccessListStorageKeyGas*uint64(len(entry.StorageKeys))
+		}
+	}
+
+	if fork >= ForkPrague {
+		gas += TxAuthorizationGas * uint64(len(t.AuthorizationList))
+	}
+
+	return gas
+}
+
+// FloorDataGas returns the EIP-7623 minimum gas charged for the calldata of the transaction, zero before Prague
+func (t *Transaction) FloorDataGas(fork Fork) uint64 {
+	if fork < ForkPrague {
+		return 0
+	}
+
+	return TxGas + TxCostFloorPerToken*t.CalldataTokens()
+}
+
+// MinimumGas returns the lowest gas limit the transaction is valid with under fork, the larger of its intrinsic gas
+// and its floor data gas
+func (t *Transaction) MinimumGas(fork Fork) uint64 {
+	gas := t.IntrinsicGas(fork)
+	if floor := t.FloorDataGas(fork); floor > gas {
+		return floor
+	}
+
+	return gas
+}
+
+// BlobGas returns the blob gas used by the blobs of a blob transaction
+func (t *Transaction) BlobGas() uint64 {
+	return GasPerBlob * uint64(len(t.BlobVersionedHashes))
+}
+
+// EffectiveGasPrice returns the price per gas paid by the transaction in a block with baseFee, which is its max fee
+// capped to the base fee plus its max priority fee.  It is the gas price of legacy and access list transactions,
+// and the max fee, the most it could pay, when baseFee is nil.
+func (t *Transaction) EffectiveGasPrice(baseFee *Quantity) *Quantity {
+	switch t.TransactionType() {
+	case TransactionTypeLegacy, TransactionTypeAccessList:
+		return t.GasPrice
+	}
+
+	if t.MaxFeePerGas == nil {
+		return t.GasPrice
+	}
+
+	if t.MaxPriorityFeePerGas == nil || baseFee == nil {
+		return t.MaxFeePerGas
+	}
+
+	price := new(big.Int).Add(baseFee.Big(), t.MaxPriorityFeePerGas.Big())
+	if price.Cmp(t.MaxFeePerGas.Big()) > 0 {
+		price.Set(t.MaxFeePerGas.Big())
+	}
+
+	q := QuantityFromBigInt(price)
+	return &q
+}
+
+// calldataBytes returns the number of zero and non-zero bytes of the input of the transaction
+func (t *Transaction) calldataBytes() (zero uint64, nonZero uint64) {
+	if t.inputSize() == 0 {
+		return 0, 0
+	}
+
+	for _, b := range t.Input.Bytes() {
+		if b == 0 {
+			zero++
+		} else {
+			nonZero++
+		}
+	}
+
+	return zero, nonZero
+}
+
+func (t *Transaction) inputSize() uint64 {
+	if len(t.Input) <= 2 {
+		return 0
+	}
+
+	return uint64(len(t.Input)-2) / 2
+}
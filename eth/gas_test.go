@@ -0,0 +1,153 @@
+package eth_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+)
+
+func TestTransaction_IntrinsicGas(t *testing.T) {
+	to := eth.MustAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+	accessList := eth.AccessList{
+		{Address: *to, StorageKeys: []eth.Data32{
+			"0x0000000000000000000000000000000000000000000000000000000000000001",
+			"0x0000000000000000000000000000000000000000000000000000000000000002",
+		}},
+		{Address: *eth.MustAddress("0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b"), StorageKeys: []eth.Data32{
+			"0x0000000000000000000000000000000000000000000000000000000000000003",
+		}},
+	}
+
+	tests := []struct {
+		name     string
+		tx       eth.Transaction
+		fork     eth.Fork
+		expected uint64
+	}{
+		{"transfer", eth.Transaction{To: to, Input: "0x"}, eth.ForkLatest, 21000},
+		{"transfer without input", eth.Transaction{To: to}, eth.ForkLatest, 21000},
+		{"calldata", eth.Transaction{To: to, Input: "0x00ff01"}, eth.ForkIstanbul, 21000 + 4 + 2*16},
+		{"calldata before istanbul", eth.Transaction{To: to, Input: "0x00ff01"}, eth.ForkHomestead, 21000 + 4 + 2*68},
+		{"creation", eth.Transaction{Input: eth.Data("0x" + strings.Repeat("60", 33))}, eth.ForkShanghai, 53000 + 33*16 + 2*2},
+		{"creation before shanghai", eth.Transaction{Input: eth.Data("0x" + strings.Repeat("60", 33))}, eth.ForkBerlin, 53000 + 33*16},
+		{"creation in frontier", eth.Transaction{Input: eth.Data("0x" + strings.Repeat("60", 33))}, eth.ForkFrontier, 21000 + 33*68},
+		{"access list", eth.Transaction{To: to, Input: "0x", AccessList: &accessList}, eth.ForkBerlin, 21000 + 2*2400 + 3*1900},
+		{"access list before berlin", eth.Transaction{To: to, Input: "0x", AccessList: &accessList}, eth.ForkIstanbul, 21000},
+		{"authorizations", eth.Transaction{To: to, Input: "0x", AuthorizationList: make([]eth.Authorization, 2)}, eth.ForkPrague, 21000 + 2*25000},
+		{"authorizations before prague", eth.Transaction{To: to, Input: "0x", AuthorizationList: make([]eth.Authorization, 2)}, eth.ForkCancun, 21000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.tx.IntrinsicGas(tt.fork))
+		})
+	}
+}
+
+func TestTransaction_FloorDataGas(t *testing.T) {
+	to := eth.MustAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+	tx := eth.Transaction{To: to, Input: eth.Data("0x" + strings.Repeat("00", 100) + strings.Repeat("ff", 1000))}
+
+	require.Equal(t, uint64(100+4*1000), tx.CalldataTokens())
+	require.Equal(t, uint64(100*4+1000*16), tx.CalldataGas(eth.ForkPrague))
+
+	// calldata heavy transactions pay the floor from Prague on
+	require.Equal(t, uint64(0), tx.FloorDataGas(eth.ForkCancun))
+	require.Equal(t, uint64(21000+16400), tx.MinimumGas(eth.ForkCancun))
+	require.Equal(t, uint64(21000+10*4100), tx.FloorDataGas(eth.ForkPrague))
+	require.Equal(t, uint64(21000+10*4100), tx.MinimumGas(eth.ForkPrague))
+
+	// while the intrinsic gas of others is above their floor
+	tx.Input = "0x00ff"
+	tx.AccessList = &eth.AccessList{{Address: *to, StorageKeys: []eth.Data32{}}}
+	require.Equal(t, uint64(21000+10*5), tx.FloorDataGas(eth.ForkPrague))
+	require.Equal(t, uint64(21000+4+16+2400), tx.MinimumGas(eth.ForkPrague))
+}
+
+func TestTransaction_BlobGas(t *testing.T) {
+	raw := `{
+		"type": "0x3",
+		"chainId": "0x1",
+		"nonce": "0x1",
+		"to": "0x6b175474e89094c44da98b954eedeac495271d0f",
+		"gas": "0x5208",
+		"maxFeePerGas": "0x3b9aca00",
+		"maxPriorityFeePerGas": "0x1",
+		"maxFeePerBlobGas": "0x2",
+		"blobVersionedHashes": [
+			"0x01a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d8",
+			"0x01b0a8d1d3a3b4e6b3c1c1c1a1f1e1d1c1b1a191817161514131211100f0e0d0"
+		],
+		"value": "0x0",
+		"input": "0x",
+		"v": "0x0",
+		"r": "0x0",
+		"s": "0x0"
+	}`
+
+	tx := eth.Transaction{}
+	require.NoError(t, json.Unmarshal([]byte(raw), &tx))
+	require.Equal(t, eth.TransactionTypeBlob, tx.TransactionType())
+	require.Equal(t, int64(2), tx.MaxFeePerBlobGas.Int64())
+	require.Equal(t, uint64(2*131072), tx.BlobGas())
+
+	require.Equal(t, uint64(0), (&eth.Transaction{}).BlobGas())
+}
+
+func TestTransaction_AuthorizationList(t *testing.T) {
+	raw := `{
+		"type": "0x4",
+		"chainId": "0x1",
+		"nonce": "0x0",
+		"gas": "0x186a0",
+		"maxFeePerGas": "0x2",
+		"maxPriorityFeePerGas": "0x1",
+		"to": "0x6b175474e89094c44da98b954eedeac495271d0f",
+		"authorizationList": [{
+			"chainId": "0x1",
+			"address": "0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b",
+			"nonce": "0x3",
+			"yParity": "0x1",
+			"r": "0x1",
+			"s": "0x2"
+		}],
+		"value": "0x0",
+		"input": "0x",
+		"v": "0x0",
+		"r": "0x0",
+		"s": "0x0"
+	}`
+
+	tx := eth.Transaction{}
+	require.NoError(t, json.Unmarshal([]byte(raw), &tx))
+	require.Equal(t, eth.TransactionTypeSetCode, tx.TransactionType())
+	require.Len(t, tx.AuthorizationList, 1)
+	require.Equal(t, *eth.MustAddress("0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b"), tx.AuthorizationList[0].Address)
+	require.Equal(t, uint64(3), tx.AuthorizationList[0].Nonce.UInt64())
+	require.Equal(t, uint64(21000+25000), tx.IntrinsicGas(eth.ForkLatest))
+}
+
+func TestTransaction_EffectiveGasPrice(t *testing.T) {
+	maxFee := eth.QuantityFromInt64(11)
+	tip := eth.QuantityFromInt64(2)
+	dynamicFee := eth.QuantityFromInt64(eth.TransactionTypeDynamicFee)
+	tx := eth.Transaction{Type: &dynamicFee, MaxFeePerGas: &maxFee, MaxPriorityFeePerGas: &tip}
+
+	low := eth.QuantityFromInt64(5)
+	require.Equal(t, int64(7), tx.EffectiveGasPrice(&low).Int64())
+
+	// capped to the max fee
+	high := eth.QuantityFromInt64(10)
+	require.Equal(t, int64(11), tx.EffectiveGasPrice(&high).Int64())
+
+	// without a base fee it is the max fee, and for legacy transactions the gas price
+	require.Equal(t, &maxFee, tx.EffectiveGasPrice(nil))
+
+	gasPrice := eth.QuantityFromInt64(3)
+	legacy := eth.Transaction{GasPrice: &gasPrice}
+	require.Equal(t, &gasPrice, legacy.EffectiveGasPrice(&low))
+}
@@ -23,6 +23,11 @@ type Log struct {
 	Type       *string   `json:"type,omitempty"`
 }
 
+type LogsNotificationParams struct {
+	Subscription string `json:"subscription"`
+	Result       Log    `json:"result"`
+}
+
 type addrOrArray []Address
 
 func (a *addrOrArray) UnmarshalJSON(data []byte) error {
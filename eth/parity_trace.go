@@ -0,0 +1,439 @@
+package eth
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// TraceType is the type of the action of a Trace
+type TraceType string
+
+// Action types of the trace_* methods
+const (
+	TraceTypeCall    TraceType = "call"
+	TraceTypeCreate  TraceType = "create"
+	TraceTypeSuicide TraceType = "suicide"
+	TraceTypeReward  TraceType = "reward"
+)
+
+// TraceOption selects the outputs of trace_call and trace_replayBlockTransactions
+type TraceOption string
+
+const (
+	TraceOptionTrace     TraceOption = "trace"
+	TraceOptionVMTrace   TraceOption = "vmTrace"
+	TraceOptionStateDiff TraceOption = "stateDiff"
+)
+
+// Trace is an action of the trace_* methods, only the action and result fields of its type being set.  Calls and
+// creates have a result unless Error is set, suicides and rewards never have one.
+type Trace struct {
+	Type TraceType
+
+	Call    *CallAction
+	Create  *CreateAction
+	Suicide *SuicideAction
+	Reward  *RewardAction
+
+	CallResult   *CallResult
+	CreateResult *CreateResult
+	Error        string
+
+	// Subtraces is the number of sub calls of the action and TraceAddress the indexes of the sub calls leading to it
+	// from the top level call of its transaction
+	Subtraces    int
+	TraceAddress []int
+
+	// Not set by trace_call and trace_replayBlockTransactions, nor for rewards for the transaction fields
+	BlockHash           *Hash
+	BlockNumber         *uint64
+	TransactionHash     *Hash
+	TransactionPosition *uint64
+}
+
+// CallAction is a call made by a transaction or one of its sub calls
+type CallAction struct {
+	// CallType is one of call, callcode, delegatecall or staticcall
+	CallType string   `json:"callType"`
+	From     Address  `json:"from"`
+	To       Address  `json:"to"`
+	Gas      Quantity `json:"gas"`
+	Input    Data     `json:"input"`
+	Value    Quantity `json:"value"`
+}
+
+// CallResult is the result of a successful call
+type CallResult struct {
+	GasUsed Quantity `json:"gasUsed"`
+	Output  Data     `json:"output"`
+}
+
+// CreateAction is a contract creation made by a transaction or one of its sub calls
+type CreateAction struct {
+	From  Address  `json:"from"`
+	Gas   Quantity `json:"gas"`
+	Init  Data     `json:"init"`
+	Value Quantity `json:"value"`
+
+	// CreationMethod is one of create or create2, only returned by some clients
+	CreationMethod string `json:"creationMethod,omitempty"`
+}
+
+// CreateResult is the result of a successful contract creation
+type CreateResult struct {
+	Address Address  `json:"address"`
+	Code    Data     `json:"code"`
+	GasUsed Quantity `json:"gasUsed"`
+}
+
+// SuicideAction is the self destruction of a contract, its balance being sent to RefundAddress
+type SuicideAction struct {
+	Address       Address  `json:"address"`
+	RefundAddress Address  `json:"refundAddress"`
+	Balance       Quantity `json:"balance"`
+}
+
+// RewardAction is a block or uncle reward paid to Author
+type RewardAction struct {
+	Author Address  `json:"author"`
+	Value  Quantity `json:"value"`
+
+	// RewardType is one of block, uncle, emptyStep or external
+	RewardType string `json:"rewardType"`
+}
+
+// traceJSON is the JSON representation of a Trace
+type traceJSON struct {
+	Type                TraceType       `json:"type"`
+	Action              json.RawMessage `json:"action"`
+	Result              json.RawMessage `json:"result"`
+	Error               string          `json:"error,omitempty"`
+	Subtraces           int             `json:"subtraces"`
+	TraceAddress        []int           `json:"traceAddress"`
+	BlockHash           *Hash           `json:"blockHash,omitempty"`
+	BlockNumber         *uint64         `json:"blockNumber,omitempty"`
+	TransactionHash     *Hash           `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64         `json:"transactionPosition,omitempty"`
+}
+
+func (t *Trace) UnmarshalJSON(data []byte) error {
+	raw := traceJSON{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	trace := Trace{
+		Type:                raw.Type,
+		Error:               raw.Error,
+		Subtraces:           raw.Subtraces,
+		TraceAddress:        raw.TraceAddress,
+		BlockHash:           raw.BlockHash,
+		BlockNumber:         raw.BlockNumber,
+		TransactionHash:     raw.TransactionHash,
+		TransactionPosition: raw.TransactionPosition,
+	}
+
+	var action, result interface{}
+	switch raw.Type {
+	case TraceTypeCall:
+		action, result = &trace.Call, &trace.CallResult
+	case TraceTypeCreate:
+		action, result = &trace.Create, &trace.CreateResult
+	case TraceTypeSuicide:
+		action = &trace.Suicide
+	case TraceTypeReward:
+		action = &trace.Reward
+	default:
+		return errors.Errorf("unknown trace type %q", raw.Type)
+	}
+
+	if err := json.Unmarshal(raw.Action, action); err != nil {
+		return errors.Wrapf(err, "could not decode %s action", raw.Type)
+	}
+
+	if result != nil && len(raw.Result) > 0 {
+		if err := json.Unmarshal(raw.Result, result); err != nil {
+			return errors.Wrapf(err, "could not decode %s result", raw.Type)
+		}
+	}
+
+	*t = trace
+	return nil
+}
+
+func (t Trace) MarshalJSON() ([]byte, error) {
+	raw := traceJSON{
+		Type:                t.Type,
+		Error:               t.Error,
+		Subtraces:           t.Subtraces,
+		TraceAddress:        t.TraceAddress,
+		BlockHash:           t.BlockHash,
+		BlockNumber:         t.BlockNumber,
+		TransactionHash:     t.TransactionHash,
+		TransactionPosition: t.TransactionPosition,
+	}
+
+	if raw.TraceAddress == nil {
+		raw.TraceAddress = []int{}
+	}
+
+	var action, result interface{}
+	switch t.Type {
+	case TraceTypeCall:
+		action = t.Call
+		if t.CallResult != nil {
+			result = t.CallResult
+		}
+	case TraceTypeCreate:
+		action = t.Create
+		if t.CreateResult != nil {
+			result = t.CreateResult
+		}
+	case TraceTypeSuicide:
+		action = t.Suicide
+	case TraceTypeReward:
+		action = t.Reward
+	default:
+		return nil, errors.Errorf("unknown trace type %q", t.Type)
+	}
+
+	var err error
+	if raw.Action, err = json.Marshal(action); err != nil {
+		return nil, err
+	}
+
+	if raw.Result, err = json.Marshal(result); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&raw)
+}
+
+// TraceFilter filters the traces returned by trace_filter, addresses matching the from and to of the actions
+type TraceFilter struct {
+	FromBlock   *BlockNumberOrTag `json:"fromBlock,omitempty"`
+	ToBlock     *BlockNumberOrTag `json:"toBlock,omitempty"`
+	FromAddress []Address         `json:"fromAddress,omitempty"`
+	ToAddress   []Address         `json:"toAddress,omitempty"`
+
+	// After skips the first traces matching the filter and Count limits the number of traces returned
+	After *uint64 `json:"after,omitempty"`
+	Count *uint64 `json:"count,omitempty"`
+}
+
+// TraceReplay is the result of trace_call, or of a transaction replayed by trace_replayBlockTransactions, only the
+// outputs of the requested TraceOption values being set
+type TraceReplay struct {
+	Output          Data      `json:"output"`
+	Trace           []Trace   `json:"trace"`
+	VMTrace         *VMTrace  `json:"vmTrace"`
+	StateDiff       StateDiff `json:"stateDiff"`
+	TransactionHash *Hash     `json:"transactionHash,omitempty"`
+}
+
+// VMTrace is the trace of the opcodes executed by a call, along with the traces of its sub calls
+type VMTrace struct {
+	Code Data          `json:"code"`
+	Ops  []VMOperation `json:"ops"`
+}
+
+// VMOperation is an opcode executed, Sub being set for the opcodes making a sub call
+type VMOperation struct {
+	PC   uint64      `json:"pc"`
+	Cost uint64      `json:"cost"`
+	Op   string      `json:"op,omitempty"`
+	Ex   *VMExecuted `json:"ex"`
+	Sub  *VMTrace    `json:"sub"`
+}
+
+// VMExecuted are the effects of an opcode, not set if the opcode failed
+type VMExecuted struct {
+	Used  uint64         `json:"used"`
+	Push  []Quantity     `json:"push"`
+	Mem   *VMMemoryDiff  `json:"mem"`
+	Store *VMStorageDiff `json:"store"`
+}
+
+// VMMemoryDiff is the memory written by an opcode at offset Off
+type VMMemoryDiff struct {
+	Off  uint64 `json:"off"`
+	Data Data   `json:"data"`
+}
+
+// VMStorageDiff is the storage slot written by an opcode
+type VMStorageDiff struct {
+	Key Quantity `json:"key"`
+	Val Quantity `json:"val"`
+}
+
+// StateDiff are the accounts changed by a transaction, keyed by address
+type StateDiff map[Address]AccountDiff
+
+// UnmarshalJSON checksums the addresses of the accounts, since map keys don't go through Address.UnmarshalJSON
+func (s *StateDiff) UnmarshalJSON(data []byte) error {
+	raw := make(map[string]AccountDiff)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if raw == nil {
+		*s = nil
+		return nil
+	}
+
+	diff := make(StateDiff, len(raw))
+	for key, account := range raw {
+		address, err := NewAddress(key)
+		if err != nil {
+			return errors.Wrapf(err, "invalid account %s", key)
+		}
+		diff[*address] = account
+	}
+
+	*s = diff
+	return nil
+}
+
+// AccountDiff are the changes to the fields of an account, storage only including the slots changed
+type AccountDiff struct {
+	Balance QuantityDiff        `json:"balance"`
+	Nonce   QuantityDiff        `json:"nonce"`
+	Code    DataDiff            `json:"code"`
+	Storage map[Data32]HashDiff `json:"storage"`
+}
+
+// DiffKind is the kind of change of a field of a StateDiff
+type DiffKind string
+
+const (
+	DiffUnchanged DiffKind = "="
+	DiffBorn      DiffKind = "+"
+	DiffDied      DiffKind = "-"
+	DiffChanged   DiffKind = "*"
+)
+
+// QuantityDiff is the change of a quantity, From being set unless it was born and To unless it died
+type QuantityDiff struct {
+	Kind     DiffKind
+	From, To *Quantity
+}
+
+func (d *QuantityDiff) UnmarshalJSON(data []byte) error {
+	diff := QuantityDiff{}
+	kind, err := unmarshalDiff(data, &diff.From, &diff.To)
+	if err != nil {
+		return err
+	}
+
+	diff.Kind = kind
+	*d = diff
+	return nil
+}
+
+func (d QuantityDiff) MarshalJSON() ([]byte, error) {
+	return marshalDiff(d.Kind, d.From, d.To)
+}
+
+// DataDiff is the change of data, From being set unless it was born and To unless it died
+type DataDiff struct {
+	Kind     DiffKind
+	From, To *Data
+}
+
+func (d *DataDiff) UnmarshalJSON(data []byte) error {
+	diff := DataDiff{}
+	kind, err := unmarshalDiff(data, &diff.From, &diff.To)
+	if err != nil {
+		return err
+	}
+
+	diff.Kind = kind
+	*d = diff
+	return nil
+}
+
+func (d DataDiff) MarshalJSON() ([]byte, error) {
+	return marshalDiff(d.Kind, d.From, d.To)
+}
+
+// HashDiff is the change of a storage slot, From being set unless it was born and To unless it died
+type HashDiff struct {
+	Kind     DiffKind
+	From, To *Hash
+}
+
+func (d *HashDiff) UnmarshalJSON(data []byte) error {
+	diff := HashDiff{}
+	kind, err := unmarshalDiff(data, &diff.From, &diff.To)
+	if err != nil {
+		return err
+	}
+
+	diff.Kind = kind
+	*d = diff
+	return nil
+}
+
+func (d HashDiff) MarshalJSON() ([]byte, error) {
+	return marshalDiff(d.Kind, d.From, d.To)
+}
+
+// unmarshalDiff decodes a diff, which is either "=" or an object keyed by its kind, into the pointers from and to
+func unmarshalDiff(data []byte, from, to interface{}) (DiffKind, error) {
+	var unchanged string
+	if err := json.Unmarshal(data, &unchanged); err == nil {
+		if DiffKind(unchanged) != DiffUnchanged {
+			return "", errors.Errorf("invalid diff %q", unchanged)
+		}
+		return DiffUnchanged, nil
+	}
+
+	raw := make(map[DiffKind]json.RawMessage)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return "", errors.Wrap(err, "could not decode diff")
+	}
+
+	if len(raw) != 1 {
+		return "", errors.New("diff must have a single kind")
+	}
+
+	for kind, value := range raw {
+		var err error
+		switch kind {
+		case DiffBorn:
+			err = json.Unmarshal(value, to)
+		case DiffDied:
+			err = json.Unmarshal(value, from)
+		case DiffChanged:
+			err = json.Unmarshal(value, &struct {
+				From interface{} `json:"from"`
+				To   interface{} `json:"to"`
+			}{from, to})
+		default:
+			return "", errors.Errorf("invalid diff kind %q", kind)
+		}
+		if err != nil {
+			return "", errors.Wrapf(err, "could not decode %s diff", kind)
+		}
+
+		return kind, nil
+	}
+
+	return "", nil
+}
+
+func marshalDiff(kind DiffKind, from, to interface{}) ([]byte, error) {
+	switch kind {
+	case DiffUnchanged, "":
+		return json.Marshal(DiffUnchanged)
+	case DiffBorn:
+		return json.Marshal(map[DiffKind]interface{}{kind: to})
+	case DiffDied:
+		return json.Marshal(map[DiffKind]interface{}{kind: from})
+	case DiffChanged:
+		return json.Marshal(map[DiffKind]interface{}{kind: map[string]interface{}{"from": from, "to": to}})
+	default:
+		return nil, errors.Errorf("invalid diff kind %q", kind)
+	}
+}
@@ -0,0 +1,216 @@
+package eth_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+)
+
+func TestTrace_JSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		raw   string
+		check func(t *testing.T, trace *eth.Trace)
+	}{
+		{
+			name: "call",
+			raw: `{
+				"action": {
+					"callType": "delegatecall",
+					"from": "0x1000000000000000000000000000000000000001",
+					"to": "0x2000000000000000000000000000000000000002",
+					"gas": "0x1d4c0",
+					"input": "0x12345678",
+					"value": "0x0"
+				},
+				"blockHash": "0xd4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3",
+				"blockNumber": 15537394,
+				"result": {"gasUsed": "0x5208", "output": "0x01"},
+				"subtraces": 2,
+				"traceAddress": [0, 1],
+				"transactionHash": "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b",
+				"transactionPosition": 3,
+				"type": "call"
+			}`,
+			check: func(t *testing.T, trace *eth.Trace) {
+				require.Equal(t, eth.TraceTypeCall, trace.Type)
+				require.Equal(t, "delegatecall", trace.Call.CallType)
+				require.Equal(t, uint64(120000), trace.Call.Gas.UInt64())
+				require.Equal(t, uint64(21000), trace.CallResult.GasUsed.UInt64())
+				require.Equal(t, []int{0, 1}, trace.TraceAddress)
+				require.Equal(t, 2, trace.Subtraces)
+				require.Equal(t, uint64(15537394), *trace.BlockNumber)
+				require.Equal(t, uint64(3), *trace.TransactionPosition)
+				require.Nil(t, trace.Create)
+			},
+		},
+		{
+			name: "failed create",
+			raw: `{
+				"action": {
+					"from": "0x1000000000000000000000000000000000000001",
+					"gas": "0x1d4c0",
+					"init": "0x6000",
+					"value": "0x1",
+					"creationMethod": "create2"
+				},
+				"error": "out of gas",
+				"result": null,
+				"subtraces": 0,
+				"traceAddress": [],
+				"type": "create"
+			}`,
+			check: func(t *testing.T, trace *eth.Trace) {
+				require.Equal(t, eth.TraceTypeCreate, trace.Type)
+				require.Equal(t, "create2", trace.Create.CreationMethod)
+				require.Nil(t, trace.CreateResult)
+				require.Equal(t, "out of gas", trace.Error)
+				require.Nil(t, trace.BlockHash)
+			},
+		},
+		{
+			name: "create",
+			raw: `{
+				"action": {
+					"from": "0x1000000000000000000000000000000000000001",
+					"gas": "0x1d4c0",
+					"init": "0x6000",
+					"value": "0x0"
+				},
+				"result": {"address": "0x3000000000000000000000000000000000000003", "code": "0x00", "gasUsed": "0x100"},
+				"subtraces": 0,
+				"traceAddress": [],
+				"type": "create"
+			}`,
+			check: func(t *testing.T, trace *eth.Trace) {
+				require.Equal(t, *eth.MustAddress("0x3000000000000000000000000000000000000003"), trace.CreateResult.Address)
+			},
+		},
+		{
+			name: "suicide",
+			raw: `{
+				"action": {
+					"address": "0x3000000000000000000000000000000000000003",
+					"refundAddress": "0x1000000000000000000000000000000000000001",
+					"balance": "0xde0b6b3a7640000"
+				},
+				"result": null,
+				"subtraces": 0,
+				"traceAddress": [0],
+				"type": "suicide"
+			}`,
+			check: func(t *testing.T, trace *eth.Trace) {
+				require.Equal(t, "0xde0b6b3a7640000", trace.Suicide.Balance.String())
+			},
+		},
+		{
+			name: "reward",
+			raw: `{
+				"action": {
+					"author": "0xea674fdde714fd979de3edf0f56aa9716b898ec8",
+					"rewardType": "uncle",
+					"value": "0x1bc16d674ec80000"
+				},
+				"blockHash": "0xd4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3",
+				"blockNumber": 1000000,
+				"result": null,
+				"subtraces": 0,
+				"traceAddress": [],
+				"type": "reward"
+			}`,
+			check: func(t *testing.T, trace *eth.Trace) {
+				require.Equal(t, "uncle", trace.Reward.RewardType)
+				require.Nil(t, trace.TransactionHash)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trace := eth.Trace{}
+			require.NoError(t, json.Unmarshal([]byte(tt.raw), &trace))
+			tt.check(t, &trace)
+
+			b, err := json.Marshal(&trace)
+			require.NoError(t, err)
+			require.JSONEq(t, tt.raw, string(b))
+		})
+	}
+
+	err := json.Unmarshal([]byte(`{"action":{},"type":"selfdestruct","traceAddress":[]}`), &eth.Trace{})
+	require.Error(t, err)
+}
+
+func TestTraceReplay_JSON(t *testing.T) {
+	raw := `{
+		"output": "0x",
+		"stateDiff": {
+			"0x1000000000000000000000000000000000000001": {
+				"balance": {"*": {"from": "0xde0b6b3a7640000", "to": "0xde0b6b3a763ff9c"}},
+				"code": "=",
+				"nonce": {"*": {"from": "0x1", "to": "0x2"}},
+				"storage": {}
+			},
+			"0x3000000000000000000000000000000000000003": {
+				"balance": {"+": "0x64"},
+				"code": {"+": "0x6000"},
+				"nonce": {"+": "0x1"},
+				"storage": {
+					"0x0000000000000000000000000000000000000000000000000000000000000001": {"+": "0x00000000000000000000000000000000000000000000000000000000000000ff"}
+				}
+			},
+			"0x4000000000000000000000000000000000000004": {
+				"balance": {"-": "0x1"},
+				"code": {"-": "0x"},
+				"nonce": {"-": "0x0"},
+				"storage": {}
+			}
+		},
+		"trace": [],
+		"transactionHash": "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b",
+		"vmTrace": {
+			"code": "0x600160005500",
+			"ops": [
+				{"cost": 3, "ex": {"mem": null, "push": ["0x1"], "store": null, "used": 78994}, "pc": 0, "sub": null},
+				{"cost": 3, "ex": {"mem": null, "push": ["0x0"], "store": null, "used": 78991}, "pc": 2, "sub": null},
+				{"cost": 20000, "ex": {"mem": null, "push": [], "store": {"key": "0x0", "val": "0x1"}, "used": 58991}, "pc": 4, "sub": null},
+				{"cost": 0, "ex": {"mem": null, "push": [], "store": null, "used": 58991}, "pc": 5, "sub": null}
+			]
+		}
+	}`
+
+	replay := eth.TraceReplay{}
+	require.NoError(t, json.Unmarshal([]byte(raw), &replay))
+
+	sender := replay.StateDiff[*eth.MustAddress("0x1000000000000000000000000000000000000001")]
+	require.Equal(t, eth.DiffChanged, sender.Balance.Kind)
+	require.Equal(t, "0xde0b6b3a7640000", sender.Balance.From.String())
+	require.Equal(t, "0xde0b6b3a763ff9c", sender.Balance.To.String())
+	require.Equal(t, eth.DiffUnchanged, sender.Code.Kind)
+	require.Nil(t, sender.Code.From)
+
+	created := replay.StateDiff[*eth.MustAddress("0x3000000000000000000000000000000000000003")]
+	require.Equal(t, eth.DiffBorn, created.Code.Kind)
+	require.Nil(t, created.Code.From)
+	require.Equal(t, eth.Data("0x6000"), *created.Code.To)
+	slot := created.Storage[eth.Data32("0x0000000000000000000000000000000000000000000000000000000000000001")]
+	require.Equal(t, eth.DiffBorn, slot.Kind)
+
+	died := replay.StateDiff[*eth.MustAddress("0x4000000000000000000000000000000000000004")]
+	require.Equal(t, eth.DiffDied, died.Balance.Kind)
+	require.Equal(t, int64(1), died.Balance.From.Int64())
+	require.Nil(t, died.Balance.To)
+
+	require.Len(t, replay.VMTrace.Ops, 4)
+	require.Equal(t, int64(1), replay.VMTrace.Ops[2].Ex.Store.Val.Int64())
+
+	b, err := json.Marshal(&replay)
+	require.NoError(t, err)
+	require.JSONEq(t, raw, string(b))
+
+	err = json.Unmarshal([]byte(`{"stateDiff":{"0x1000000000000000000000000000000000000001":{"balance":"~"}}}`), &eth.TraceReplay{})
+	require.Error(t, err)
+}
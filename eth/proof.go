@@ -0,0 +1,268 @@
+package eth
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/rlp"
+)
+
+// EmptyRootHash is the root hash of an empty Merkle Patricia trie, e.g. the storage of an account without storage
+const EmptyRootHash = Hash("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")
+
+// EmptyCodeHash is the keccak256 hash of empty code, i.e. the code hash of accounts that aren't contracts
+const EmptyCodeHash = Hash("0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470")
+
+// AccountResult is the result of eth_getProof
+type AccountResult struct {
+	Address      Address        `json:"address"`
+	AccountProof []Data         `json:"accountProof"`
+	Balance      Quantity       `json:"balance"`
+	CodeHash     Hash           `json:"codeHash"`
+	Nonce        Quantity       `json:"nonce"`
+	StorageHash  Hash           `json:"storageHash"`
+	StorageProof []StorageProof `json:"storageProof"`
+}
+
+// StorageProof is the proof of a single storage slot of an AccountResult
+type StorageProof struct {
+	Key   Data     `json:"key"`
+	Value Quantity `json:"value"`
+	Proof []Data   `json:"proof"`
+}
+
+// Verify checks the account proof against stateRoot, which must come from a trusted block header, and then
+// every storage proof against the storage hash of the account.  Accounts and slots that don't exist must
+// have been returned as empty (zero nonce, balance and value, empty storage and code hashes), in which case
+// the proofs must show that they are absent from the tries.
+func (r *AccountResult) Verify(stateRoot Hash) error {
+	value, err := VerifyProof(stateRoot, hash(r.Address).Bytes(), r.AccountProof)
+	if err != nil {
+		return errors.Wrapf(err, "invalid account proof for %s", r.Address.String())
+	}
+
+	if value == nil {
+		if r.Nonce.UInt64() != 0 || r.Balance.Big().Sign() != 0 || !isEmptyHash(r.StorageHash, EmptyRootHash) || !isEmptyHash(r.CodeHash, EmptyCodeHash) {
+			return errors.Errorf("account %s is absent from the state but was returned as not empty", r.Address.String())
+		}
+	} else if err := r.verifyAccount(value); err != nil {
+		return err
+	}
+
+	for _, p := range r.StorageProof {
+		if err := p.Verify(r.StorageHash); err != nil {
+			return errors.Wrapf(err, "invalid storage proof for %s", r.Address.String())
+		}
+	}
+
+	return nil
+}
+
+// verifyAccount checks that the RLP encoded account at the end of the account proof matches the result
+func (r *AccountResult) verifyAccount(value []byte) error {
+	account, err := rlp.From("0x" + hex.EncodeToString(value))
+	if err != nil {
+		return errors.Wrap(err, "could not decode account")
+	}
+
+	if len(account.List) != 4 {
+		return errors.Errorf("account has %d fields, expected 4", len(account.List))
+	}
+
+	fields := []struct {
+		name string
+		want []byte
+	}{
+		{"nonce", r.Nonce.Big().Bytes()},
+		{"balance", r.Balance.Big().Bytes()},
+		{"storageHash", r.StorageHash.Bytes()},
+		{"codeHash", r.CodeHash.Bytes()},
+	}
+
+	for i, field := range fields {
+		got, err := stringBytes(account.List[i])
+		if err != nil {
+			return errors.Wrapf(err, "invalid account %s", field.name)
+		}
+
+		if !bytes.Equal(got, field.want) {
+			return errors.Errorf("account %s is 0x%x in the proof but was returned as 0x%x", field.name, got, field.want)
+		}
+	}
+
+	return nil
+}
+
+// Verify checks the storage proof against storageHash, the storage root of the account
+func (p *StorageProof) Verify(storageHash Hash) error {
+	if !strings.HasPrefix(p.Key.String(), "0x") {
+		return errors.Errorf("invalid storage key %s", p.Key.String())
+	}
+
+	key, ok := new(big.Int).SetString(p.Key.String()[2:], 16)
+	if !ok || key.BitLen() > 256 {
+		return errors.Errorf("invalid storage key %s", p.Key.String())
+	}
+
+	slot := make([]byte, 32)
+	key.FillBytes(slot)
+
+	value, err := VerifyProof(storageHash, Data("0x"+hex.EncodeToString(slot)).Hash().Bytes(), p.Proof)
+	if err != nil {
+		return errors.Wrapf(err, "invalid proof for slot %s", p.Key.String())
+	}
+
+	got := []byte{}
+	if value != nil {
+		v, err := rlp.From("0x" + hex.EncodeToString(value))
+		if err != nil {
+			return errors.Wrapf(err, "could not decode value of slot %s", p.Key.String())
+		}
+
+		got, err = stringBytes(*v)
+		if err != nil {
+			return errors.Wrapf(err, "invalid value of slot %s", p.Key.String())
+		}
+	}
+
+	if new(big.Int).SetBytes(got).Cmp(p.Value.Big()) != 0 {
+		return errors.Errorf("slot %s is 0x%x in the proof but was returned as %s", p.Key.String(), got, p.Value.String())
+	}
+
+	return nil
+}
+
+// VerifyProof walks a Merkle Patricia trie proof from root along the path of key and returns the value stored
+// at key, or nil if the proof shows that the trie doesn't contain key.  Every node of proof must be referenced
+// by hash from the root or the previous node; nodes shorter than 32 bytes are embedded in their parent instead.
+func VerifyProof(root Hash, key []byte, proof []Data) ([]byte, error) {
+	if strings.EqualFold(root.String(), EmptyRootHash.String()) && len(proof) == 0 {
+		return nil, nil
+	}
+
+	path := make([]byte, 0, len(key)*2)
+	for _, b := range key {
+		path = append(path, b>>4, b&0x0f)
+	}
+
+	want := root.Bytes()
+	next := 0
+	var node rlp.Value
+	for {
+		if want != nil {
+			if next >= len(proof) {
+				return nil, errors.New("proof ends before the path does")
+			}
+
+			raw := proof[next]
+			next++
+			if !bytes.Equal(raw.Hash().Bytes(), want) {
+				return nil, errors.Errorf("proof node %d doesn't match the hash referencing it", next-1)
+			}
+
+			n, err := rlp.From(raw.String())
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not decode proof node %d", next-1)
+			}
+
+			node = *n
+		}
+
+		var child rlp.Value
+		switch len(node.List) {
+		case 17:
+			if len(path) == 0 {
+				return valueBytes(node.List[16])
+			}
+
+			child = node.List[path[0]]
+			path = path[1:]
+		case 2:
+			encoded, err := stringBytes(node.List[0])
+			if err != nil || len(encoded) == 0 {
+				return nil, errors.New("invalid leaf or extension node path")
+			}
+
+			nibbles, leaf := compactToNibbles(encoded)
+			if leaf {
+				if !bytes.Equal(nibbles, path) {
+					return nil, nil
+				}
+
+				return valueBytes(node.List[1])
+			}
+
+			if len(path) < len(nibbles) || !bytes.Equal(nibbles, path[:len(nibbles)]) {
+				return nil, nil
+			}
+
+			child = node.List[1]
+			path = path[len(nibbles):]
+		default:
+			return nil, errors.Errorf("invalid trie node with %d items", len(node.List))
+		}
+
+		want = nil
+		if child.IsList() {
+			// nodes shorter than 32 bytes are embedded in their parent
+			node = child
+			continue
+		}
+
+		ref, err := stringBytes(child)
+		if err != nil {
+			return nil, err
+		}
+
+		switch len(ref) {
+		case 0:
+			return nil, nil
+		case 32:
+			want = ref
+		default:
+			return nil, errors.Errorf("invalid child reference of %d bytes", len(ref))
+		}
+	}
+}
+
+// compactToNibbles decodes the hex prefix encoding of the path of leaf and extension nodes
+func compactToNibbles(encoded []byte) ([]byte, bool) {
+	flag := encoded[0] >> 4
+	nibbles := make([]byte, 0, len(encoded)*2)
+	if flag&1 == 1 {
+		nibbles = append(nibbles, encoded[0]&0x0f)
+	}
+
+	for _, b := range encoded[1:] {
+		nibbles = append(nibbles, b>>4, b&0x0f)
+	}
+
+	return nibbles, flag&2 == 2
+}
+
+// valueBytes returns the value of a leaf or branch node, nil if there is none
+func valueBytes(v rlp.Value) ([]byte, error) {
+	b, err := stringBytes(v)
+	if err != nil || len(b) == 0 {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// stringBytes returns the bytes of an RLP string
+func stringBytes(v rlp.Value) ([]byte, error) {
+	if v.IsList() {
+		return nil, errors.New("expected an RLP string, got a list")
+	}
+
+	return hex.DecodeString(v.String[2:])
+}
+
+func isEmptyHash(h Hash, empty Hash) bool {
+	return strings.EqualFold(h.String(), empty.String()) || h == Hash("0x0000000000000000000000000000000000000000000000000000000000000000")
+}
@@ -0,0 +1,351 @@
+package eth_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/rlp"
+)
+
+// trie builds Merkle Patricia tries and their proofs, following the yellow paper
+type trie struct {
+	nodes map[string]rlp.Value
+	root  rlp.Value
+}
+
+type item struct {
+	path  []byte
+	value []byte
+}
+
+func str(b []byte) rlp.Value {
+	return rlp.Value{String: "0x" + hex.EncodeToString(b)}
+}
+
+func nibbles(key []byte) []byte {
+	out := make([]byte, 0, len(key)*2)
+	for _, b := range key {
+		out = append(out, b>>4, b&0x0f)
+	}
+	return out
+}
+
+func compact(path []byte, leaf bool) []byte {
+	flag := byte(0)
+	if leaf {
+		flag = 2
+	}
+
+	out := []byte{}
+	if len(path)%2 == 1 {
+		out = append(out, (flag+1)<<4|path[0])
+		path = path[1:]
+	} else {
+		out = append(out, flag<<4)
+	}
+
+	for i := 0; i < len(path); i += 2 {
+		out = append(out, path[i]<<4|path[i+1])
+	}
+	return out
+}
+
+func newTrie(t *testing.T, values map[string][]byte) *trie {
+	items := make([]item, 0, len(values))
+	for k, v := range values {
+		items = append(items, item{path: nibbles([]byte(k)), value: v})
+	}
+	sort.Slice(items, func(i, j int) bool { return string(items[i].path) < string(items[j].path) })
+
+	tr := &trie{nodes: map[string]rlp.Value{}}
+	tr.root = tr.build(t, items)
+	tr.hash(t, tr.root)
+	return tr
+}
+
+func encode(t *testing.T, v rlp.Value) []byte {
+	enc, err := v.Encode()
+	require.NoError(t, err)
+	b, err := hex.DecodeString(enc[2:])
+	require.NoError(t, err)
+	return b
+}
+
+// hash records node as referenced by hash and returns its hash
+func (tr *trie) hash(t *testing.T, node rlp.Value) []byte {
+	h := eth.Data("0x" + hex.EncodeToString(encode(t, node))).Hash()
+	tr.nodes[h.String()] = node
+	return h.Bytes()
+}
+
+// ref returns how a parent refers to node: embedded if its encoding is shorter than 32 bytes, else by hash
+func (tr *trie) ref(t *testing.T, node rlp.Value) rlp.Value {
+	if len(encode(t, node)) < 32 {
+		return node
+	}
+	return str(tr.hash(t, node))
+}
+
+func (tr *trie) build(t *testing.T, items []item) rlp.Value {
+	if len(items) == 1 {
+		return rlp.Value{List: []rlp.Value{str(compact(items[0].path, true)), str(items[0].value)}}
+	}
+
+	prefix := len(items[0].path)
+	for _, it := range items[1:] {
+		n := 0
+		for n < prefix && n < len(it.path) && it.path[n] == items[0].path[n] {
+			n++
+		}
+		prefix = n
+	}
+
+	if prefix > 0 {
+		rest := make([]item, len(items))
+		for i, it := range items {
+			rest[i] = item{path: it.path[prefix:], value: it.value}
+		}
+		return rlp.Value{List: []rlp.Value{str(compact(items[0].path[:prefix], false)), tr.ref(t, tr.build(t, rest))}}
+	}
+
+	branch := make([]rlp.Value, 17)
+	for i := range branch {
+		branch[i] = str(nil)
+	}
+
+	groups := map[byte][]item{}
+	for _, it := range items {
+		if len(it.path) == 0 {
+			branch[16] = str(it.value)
+			continue
+		}
+		groups[it.path[0]] = append(groups[it.path[0]], item{path: it.path[1:], value: it.value})
+	}
+
+	for nibble, group := range groups {
+		branch[nibble] = tr.ref(t, tr.build(t, group))
+	}
+
+	return rlp.Value{List: branch}
+}
+
+func (tr *trie) rootHash(t *testing.T) eth.Hash {
+	return eth.Data("0x" + hex.EncodeToString(encode(t, tr.root))).Hash()
+}
+
+// proof returns the nodes referenced by hash along the path of key
+func (tr *trie) proof(t *testing.T, key []byte) []eth.Data {
+	path := nibbles(key)
+	node := tr.root
+	proof := []eth.Data{eth.Data("0x" + hex.EncodeToString(encode(t, node)))}
+	for {
+		var child rlp.Value
+		if len(node.List) == 17 {
+			if len(path) == 0 {
+				return proof
+			}
+			child, path = node.List[path[0]], path[1:]
+		} else {
+			encoded, err := hex.DecodeString(node.List[0].String[2:])
+			require.NoError(t, err)
+			p := nibbles(encoded)
+			if encoded[0]>>4&1 == 1 {
+				p = p[1:]
+			} else {
+				p = p[2:]
+			}
+
+			if encoded[0]>>4&2 == 2 || len(path) < len(p) || string(path[:len(p)]) != string(p) {
+				return proof
+			}
+			child, path = node.List[1], path[len(p):]
+		}
+
+		if child.IsList() {
+			node = child
+			continue
+		}
+
+		if child.String == "0x" {
+			return proof
+		}
+
+		node = tr.nodes[child.String]
+		proof = append(proof, eth.Data("0x"+hex.EncodeToString(encode(t, node))))
+	}
+}
+
+func TestVerifyProof(t *testing.T) {
+	tr := newTrie(t, map[string][]byte{
+		"do":    []byte("verb"),
+		"dog":   []byte("puppy"),
+		"doge":  []byte("coin"),
+		"horse": []byte("stallion"),
+	})
+
+	// from the ethereum/tests trie tests
+	root := tr.rootHash(t)
+	require.Equal(t, "0x5991bb8c6514148a29db676a14ac506cd2cd5775ace63c30a4fe457715e9ac84", root.String())
+
+	for key, want := range map[string]string{"do": "verb", "dog": "puppy", "doge": "coin", "horse": "stallion"} {
+		value, err := eth.VerifyProof(root, []byte(key), tr.proof(t, []byte(key)))
+		require.NoError(t, err, key)
+		require.Equal(t, want, string(value))
+	}
+
+	for _, key := range []string{"d", "dogs", "cat", "hors", "horses", "x"} {
+		value, err := eth.VerifyProof(root, []byte(key), tr.proof(t, []byte(key)))
+		require.NoError(t, err, key)
+		require.Nil(t, value, key)
+	}
+
+	_, err := eth.VerifyProof(root, []byte("horse"), nil)
+	require.Error(t, err)
+
+	proof := tr.proof(t, []byte("doge"))
+	_, err = eth.VerifyProof(root, []byte("doge"), proof[:len(proof)-1])
+	require.Error(t, err)
+
+	tampered := append([]eth.Data{}, proof...)
+	tampered[len(tampered)-1] = tampered[len(tampered)-1][:len(tampered[len(tampered)-1])-2] + "00"
+	_, err = eth.VerifyProof(root, []byte("doge"), tampered)
+	require.Error(t, err)
+
+	value, err := eth.VerifyProof(eth.EmptyRootHash, []byte("dog"), nil)
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
+
+type account struct {
+	nonce   uint64
+	balance int64
+	storage map[uint64]int64
+}
+
+func slotKey(slot uint64) []byte {
+	return eth.Data(fmt.Sprintf("0x%064x", slot)).Hash().Bytes()
+}
+
+// state builds a state trie with the given accounts and returns its root and the eth_getProof result of address
+func state(t *testing.T, accounts map[string]account, address string, slots ...uint64) (eth.Hash, *eth.AccountResult) {
+	values := map[string][]byte{}
+	var result *eth.AccountResult
+	for addr, a := range accounts {
+		storage := map[string][]byte{}
+		for slot, v := range a.storage {
+			storage[string(slotKey(slot))] = encode(t, str(big.NewInt(v).Bytes()))
+		}
+
+		storageRoot, storageTrie := eth.EmptyRootHash, (*trie)(nil)
+		if len(storage) > 0 {
+			storageTrie = newTrie(t, storage)
+			storageRoot = storageTrie.rootHash(t)
+		}
+
+		key := eth.MustAddress(addr)
+		values[string(eth.Data(key.String()).Hash().Bytes())] = encode(t, rlp.Value{List: []rlp.Value{
+			str(new(big.Int).SetUint64(a.nonce).Bytes()),
+			str(big.NewInt(a.balance).Bytes()),
+			str(storageRoot.Bytes()),
+			str(eth.EmptyCodeHash.Bytes()),
+		}})
+
+		if addr == address {
+			result = &eth.AccountResult{
+				Address:      *key,
+				Nonce:        eth.QuantityFromUInt64(a.nonce),
+				Balance:      eth.QuantityFromInt64(a.balance),
+				StorageHash:  storageRoot,
+				CodeHash:     eth.EmptyCodeHash,
+				StorageProof: []eth.StorageProof{},
+			}
+
+			for _, slot := range slots {
+				p := eth.StorageProof{Key: eth.Data(fmt.Sprintf("0x%x", slot)), Value: eth.QuantityFromInt64(a.storage[slot]), Proof: []eth.Data{}}
+				if storageTrie != nil {
+					p.Proof = storageTrie.proof(t, slotKey(slot))
+				}
+				result.StorageProof = append(result.StorageProof, p)
+			}
+		}
+	}
+
+	tr := newTrie(t, values)
+	if result == nil {
+		key := eth.MustAddress(address)
+		result = &eth.AccountResult{
+			Address:     *key,
+			Nonce:       eth.QuantityFromUInt64(0),
+			Balance:     eth.QuantityFromUInt64(0),
+			StorageHash: eth.EmptyRootHash,
+			CodeHash:    eth.EmptyCodeHash,
+		}
+	}
+	result.AccountProof = tr.proof(t, eth.Data(result.Address.String()).Hash().Bytes())
+
+	// round trip through JSON like a real response
+	b, err := json.Marshal(result)
+	require.NoError(t, err)
+	decoded := eth.AccountResult{}
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	return tr.rootHash(t), &decoded
+}
+
+func TestAccountResult_Verify(t *testing.T) {
+	accounts := map[string]account{}
+	for i := 1; i <= 40; i++ {
+		accounts[fmt.Sprintf("0x%040x", i)] = account{nonce: uint64(i), balance: int64(i) * 1000000007}
+	}
+
+	contract := fmt.Sprintf("0x%040x", 7)
+	accounts[contract] = account{nonce: 1, balance: 0, storage: map[uint64]int64{0: 1, 1: 0x1234, 2: 1 << 40, 5: 255, 1000: 42}}
+
+	t.Run("inclusion", func(t *testing.T) {
+		root, result := state(t, accounts, contract, 0, 1, 1000, 3, 77)
+		require.NoError(t, result.Verify(root))
+	})
+
+	t.Run("account without storage", func(t *testing.T) {
+		address := fmt.Sprintf("0x%040x", 12)
+		root, result := state(t, accounts, address, 0)
+		require.NoError(t, result.Verify(root))
+	})
+
+	t.Run("exclusion", func(t *testing.T) {
+		root, result := state(t, accounts, "0x00000000000000000000000000000000deadbeef")
+		require.NoError(t, result.Verify(root))
+
+		result.Balance = eth.QuantityFromUInt64(1)
+		require.Error(t, result.Verify(root))
+	})
+
+	t.Run("wrong account", func(t *testing.T) {
+		root, result := state(t, accounts, contract, 1)
+		result.Balance = eth.QuantityFromUInt64(1)
+		require.Error(t, result.Verify(root))
+
+		_, result = state(t, accounts, contract, 1)
+		require.Error(t, result.Verify(eth.EmptyRootHash))
+	})
+
+	t.Run("wrong storage", func(t *testing.T) {
+		root, result := state(t, accounts, contract, 1, 3)
+		result.StorageProof[0].Value = eth.QuantityFromUInt64(0x1235)
+		require.Error(t, result.Verify(root))
+
+		root, result = state(t, accounts, contract, 1, 3)
+		result.StorageProof[1].Value = eth.QuantityFromUInt64(1)
+		require.Error(t, result.Verify(root))
+
+		root, result = state(t, accounts, contract, 1)
+		result.StorageProof[0].Proof = result.StorageProof[0].Proof[:1]
+		require.Error(t, result.Verify(root))
+	})
+}
@@ -158,6 +158,25 @@ func (q Quantity) RLP() rlp.Value {
 	}
 }
 
+// MarshalRLP implements rlp.Marshaler, encoding the Quantity as an RLP string without leading zeroes.
+func (q Quantity) MarshalRLP() (rlp.Item, error) {
+	return rlp.Bytes(q.i.Bytes()), nil
+}
+
+// UnmarshalRLP implements rlp.Unmarshaler.
+func (q *Quantity) UnmarshalRLP(item rlp.Item) error {
+	parsed, err := NewQuantityFromRLP(item.Value())
+	if err != nil {
+		return err
+	}
+
+	*q = *parsed
+	return nil
+}
+
+// RLPInteger implements rlp.Integer, so that strict decoding rejects leading zero bytes.
+func (q *Quantity) RLPInteger() {}
+
 func bigToQuantityString(i *big.Int) string {
 	b := i.Bytes()
 	if len(b) == 0 {
@@ -0,0 +1,60 @@
+package eth_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/rlp"
+)
+
+func TestRLP_MarshalUnmarshal(t *testing.T) {
+	type receipt struct {
+		Status     eth.Quantity
+		To         *eth.Address `rlp:"nil"`
+		From       eth.Address
+		Hash       eth.Hash
+		Input      eth.Data
+		Nonce      eth.Data8
+		AccessList eth.AccessList
+	}
+
+	r := receipt{
+		Status: eth.QuantityFromUInt64(1),
+		From:   *eth.MustAddress("0x7f0d15c7faae65896648c8273b6d7e43f58fa842"),
+		Hash:   eth.EmptyCodeHash,
+		Input:  "0xdeadbeef",
+		Nonce:  "0x0102030405060708",
+		AccessList: eth.AccessList{{
+			Address:     *eth.MustAddress("0x0000000000000000000000000000000000001337"),
+			StorageKeys: []eth.Data32{"0x0000000000000000000000000000000000000000000000000000000000000001"},
+		}},
+	}
+
+	b, err := rlp.Marshal(r)
+	require.NoError(t, err)
+	require.Equal(t, "f8800180947f0d15c7faae65896648c8273b6d7e43f58fa842a0c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a47084deadbeef880102030405060708f838f7940000000000000000000000000000000000001337e1a00000000000000000000000000000000000000000000000000000000000000001", hex.EncodeToString(b))
+
+	decoded := receipt{}
+	require.NoError(t, rlp.Unmarshal(b, &decoded))
+	require.Equal(t, r, decoded)
+
+	// an empty Data is the empty string, and a zero Quantity too
+	b, err = rlp.Marshal(receipt{})
+	require.NoError(t, err)
+	require.Equal(t, "c7808080808080c0", hex.EncodeToString(b))
+
+	// lengths are checked
+	b, err = rlp.Marshal([]interface{}{uint64(1), nil, []byte{0x01}})
+	require.NoError(t, err)
+	require.Error(t, rlp.Unmarshal(b, &decoded))
+
+	var address eth.Address
+	require.Error(t, rlp.Unmarshal([]byte{0x82, 0x01, 0x02}, &address))
+	var hash eth.Hash
+	require.Error(t, rlp.Unmarshal([]byte{0x82, 0x01, 0x02}, &hash))
+	var q eth.Quantity
+	require.Error(t, rlp.Unmarshal([]byte{0xc0}, &q))
+}
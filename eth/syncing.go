@@ -0,0 +1,132 @@
+package eth
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// SyncStatus is the sync progress of a node as returned by eth_syncing and delivered by "syncing" subscriptions.
+// When Syncing is false the remaining fields are zero.
+type SyncStatus struct {
+	Syncing       bool
+	StartingBlock Quantity
+	CurrentBlock  Quantity
+	HighestBlock  Quantity
+
+	// State sync progress, only reported by some clients
+	PulledStates *Quantity
+	KnownStates  *Quantity
+}
+
+type SyncingNotificationParams struct {
+	Subscription string     `json:"subscription"`
+	Result       SyncStatus `json:"result"`
+}
+
+// syncProgress is the progress object shared by the different result formats, geth subscriptions encode
+// these values as plain numbers rather than hex quantities so both are accepted.
+type syncProgress struct {
+	StartingBlock syncQuantity  `json:"startingBlock"`
+	CurrentBlock  syncQuantity  `json:"currentBlock"`
+	HighestBlock  syncQuantity  `json:"highestBlock"`
+	PulledStates  *syncQuantity `json:"pulledStates"`
+	KnownStates   *syncQuantity `json:"knownStates"`
+}
+
+type syncQuantity Quantity
+
+func (q *syncQuantity) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] != '"' {
+		n := uint64(0)
+		if err := json.Unmarshal(data, &n); err != nil {
+			return errors.Wrap(err, "invalid sync progress value")
+		}
+
+		*q = syncQuantity(QuantityFromUInt64(n))
+		return nil
+	}
+
+	return (*Quantity)(q).UnmarshalJSON(data)
+}
+
+// UnmarshalJSON accepts the eth_syncing result (false or a progress object), the geth subscription format
+// ({"syncing":true,"status":{...}}) and the nethermind subscription format ({"isSyncing":true,...}).
+func (s *SyncStatus) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte(`false`)):
+		*s = SyncStatus{}
+		return nil
+	case bytes.Equal(data, []byte(`true`)):
+		*s = SyncStatus{Syncing: true}
+		return nil
+	}
+
+	wrapped := struct {
+		Syncing   *bool         `json:"syncing"`
+		IsSyncing *bool         `json:"isSyncing"`
+		Status    *syncProgress `json:"status"`
+	}{}
+
+	if err := json.Unmarshal(data, &wrapped); err != nil {
+		return errors.Wrap(err, "invalid sync status")
+	}
+
+	progress := wrapped.Status
+	if progress == nil {
+		progress = &syncProgress{}
+		if err := json.Unmarshal(data, progress); err != nil {
+			return errors.Wrap(err, "invalid sync status")
+		}
+	}
+
+	syncing := true
+	if wrapped.Syncing != nil {
+		syncing = *wrapped.Syncing
+	} else if wrapped.IsSyncing != nil {
+		syncing = *wrapped.IsSyncing
+	}
+
+	*s = SyncStatus{
+		Syncing:       syncing,
+		StartingBlock: Quantity(progress.StartingBlock),
+		CurrentBlock:  Quantity(progress.CurrentBlock),
+		HighestBlock:  Quantity(progress.HighestBlock),
+	}
+
+	if progress.PulledStates != nil {
+		q := Quantity(*progress.PulledStates)
+		s.PulledStates = &q
+	}
+
+	if progress.KnownStates != nil {
+		q := Quantity(*progress.KnownStates)
+		s.KnownStates = &q
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the status in the eth_syncing format, that is false when not syncing or a
+// progress object otherwise.
+func (s SyncStatus) MarshalJSON() ([]byte, error) {
+	if !s.Syncing {
+		return []byte(`false`), nil
+	}
+
+	return json.Marshal(struct {
+		StartingBlock Quantity  `json:"startingBlock"`
+		CurrentBlock  Quantity  `json:"currentBlock"`
+		HighestBlock  Quantity  `json:"highestBlock"`
+		PulledStates  *Quantity `json:"pulledStates,omitempty"`
+		KnownStates   *Quantity `json:"knownStates,omitempty"`
+	}{
+		StartingBlock: s.StartingBlock,
+		CurrentBlock:  s.CurrentBlock,
+		HighestBlock:  s.HighestBlock,
+		PulledStates:  s.PulledStates,
+		KnownStates:   s.KnownStates,
+	})
+}
@@ -0,0 +1,66 @@
+package eth_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+)
+
+func TestSyncStatus_UnmarshalJSON(t *testing.T) {
+	type TestCase struct {
+		Message  string
+		Payload  string
+		Syncing  bool
+		Current  uint64
+		Highest  uint64
+		Pulled   bool
+		Marshals string
+	}
+
+	tests := []TestCase{
+		{
+			Message:  "eth_syncing result when not syncing",
+			Payload:  `false`,
+			Marshals: `false`,
+		},
+		{
+			Message:  "eth_syncing result when syncing",
+			Payload:  `{"startingBlock":"0x384","currentBlock":"0x386","highestBlock":"0x454"}`,
+			Syncing:  true,
+			Current:  0x386,
+			Highest:  0x454,
+			Marshals: `{"startingBlock":"0x384","currentBlock":"0x386","highestBlock":"0x454"}`,
+		},
+		{
+			Message:  "geth subscription notification with numeric progress",
+			Payload:  `{"syncing":true,"status":{"startingBlock":900,"currentBlock":902,"highestBlock":1108,"pulledStates":10,"knownStates":20}}`,
+			Syncing:  true,
+			Current:  902,
+			Highest:  1108,
+			Pulled:   true,
+			Marshals: `{"startingBlock":"0x384","currentBlock":"0x386","highestBlock":"0x454","pulledStates":"0xa","knownStates":"0x14"}`,
+		},
+		{
+			Message:  "nethermind subscription notification",
+			Payload:  `{"isSyncing":false,"startingBlock":"0x0","currentBlock":"0x0","highestBlock":"0x0"}`,
+			Marshals: `false`,
+		},
+	}
+
+	for _, test := range tests {
+		status := eth.SyncStatus{}
+		err := json.Unmarshal([]byte(test.Payload), &status)
+		require.NoError(t, err, test.Message)
+		require.Equal(t, test.Syncing, status.Syncing, test.Message)
+		require.Equal(t, test.Current, status.CurrentBlock.UInt64(), test.Message)
+		require.Equal(t, test.Highest, status.HighestBlock.UInt64(), test.Message)
+		require.Equal(t, test.Pulled, status.PulledStates != nil, test.Message)
+
+		b, err := json.Marshal(&status)
+		require.NoError(t, err, test.Message)
+		require.JSONEq(t, test.Marshals, string(b), test.Message)
+	}
+}
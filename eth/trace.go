@@ -0,0 +1,345 @@
+package eth
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Tracers of the debug_trace* methods
+const (
+	TracerStructLogs = ""
+	TracerCall       = "callTracer"
+	TracerPrestate   = "prestateTracer"
+)
+
+// TraceConfig configures the debug_trace* methods.  At most one of CallTracer and PrestateTracer can be set,
+// the struct logger being used otherwise.
+type TraceConfig struct {
+	StructLogs     *StructLogConfig
+	CallTracer     *CallTracerConfig
+	PrestateTracer *PrestateTracerConfig
+
+	// Timeout overrides the default timeout of tracing a transaction, e.g. "10s"
+	Timeout string
+}
+
+// StructLogConfig configures the struct logger, which traces every opcode executed
+type StructLogConfig struct {
+	EnableMemory     bool `json:"enableMemory,omitempty"`
+	DisableStack     bool `json:"disableStack,omitempty"`
+	DisableStorage   bool `json:"disableStorage,omitempty"`
+	EnableReturnData bool `json:"enableReturnData,omitempty"`
+
+	// Limit is the maximum number of struct logs, 0 being unlimited
+	Limit int `json:"limit,omitempty"`
+}
+
+// CallTracerConfig configures the callTracer, which traces the tree of calls
+type CallTracerConfig struct {
+	// OnlyTopCall skips the sub calls of the top level call
+	OnlyTopCall bool `json:"onlyTopCall,omitempty"`
+	// WithLog includes the logs emitted by each call
+	WithLog bool `json:"withLog,omitempty"`
+}
+
+// PrestateTracerConfig configures the prestateTracer, which traces the state of the accounts touched
+type PrestateTracerConfig struct {
+	// DiffMode returns the state before and after the transaction rather than only before
+	DiffMode bool `json:"diffMode,omitempty"`
+}
+
+// Tracer returns the name of the tracer of the config
+func (c *TraceConfig) Tracer() (string, error) {
+	switch {
+	case c.CallTracer != nil && c.PrestateTracer != nil:
+		return "", errors.New("callTracer and prestateTracer are mutually exclusive")
+	case (c.CallTracer != nil || c.PrestateTracer != nil) && c.StructLogs != nil:
+		return "", errors.New("struct log options require the struct logger")
+	case c.CallTracer != nil:
+		return TracerCall, nil
+	case c.PrestateTracer != nil:
+		return TracerPrestate, nil
+	default:
+		return TracerStructLogs, nil
+	}
+}
+
+func (c TraceConfig) MarshalJSON() ([]byte, error) {
+	config, err := c.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&config)
+}
+
+// traceConfigParams is the JSON representation of the config of debug_trace* methods
+type traceConfigParams struct {
+	*StructLogConfig
+
+	Tracer       string      `json:"tracer,omitempty"`
+	TracerConfig interface{} `json:"tracerConfig,omitempty"`
+	Timeout      string      `json:"timeout,omitempty"`
+
+	// debug_traceCall only
+	StateOverrides StateOverride   `json:"stateOverrides,omitempty"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides,omitempty"`
+}
+
+func (c *TraceConfig) params() (traceConfigParams, error) {
+	tracer, err := c.Tracer()
+	if err != nil {
+		return traceConfigParams{}, err
+	}
+
+	config := traceConfigParams{
+		StructLogConfig: c.StructLogs,
+		Tracer:          tracer,
+		Timeout:         c.Timeout,
+	}
+
+	if c.CallTracer != nil {
+		config.TracerConfig = c.CallTracer
+	} else if c.PrestateTracer != nil {
+		config.TracerConfig = c.PrestateTracer
+	}
+
+	return config, nil
+}
+
+// TraceCallConfig is the TraceConfig of debug_traceCall, which also accepts the overrides of eth_call
+type TraceCallConfig struct {
+	TraceConfig
+	Overrides *CallOverrides
+}
+
+func (c TraceCallConfig) MarshalJSON() ([]byte, error) {
+	config, err := c.params()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Overrides != nil {
+		config.StateOverrides = c.Overrides.State
+		config.BlockOverrides = c.Overrides.Block
+	}
+
+	return json.Marshal(&config)
+}
+
+// TraceResult is the result of a debug_trace* method, only the field of the tracer of its config being set
+type TraceResult struct {
+	StructLogs   *StructLogResult
+	CallFrame    *CallFrame
+	Prestate     Prestate
+	PrestateDiff *PrestateDiff
+}
+
+// DecodeTraceResult decodes the raw result of a debug_trace* method traced with config
+func DecodeTraceResult(config TraceConfig, raw json.RawMessage) (*TraceResult, error) {
+	tracer, err := config.Tracer()
+	if err != nil {
+		return nil, err
+	}
+
+	result := TraceResult{}
+	switch {
+	case tracer == TracerCall:
+		err = json.Unmarshal(raw, &result.CallFrame)
+	case tracer == TracerPrestate && config.PrestateTracer.DiffMode:
+		err = json.Unmarshal(raw, &result.PrestateDiff)
+	case tracer == TracerPrestate:
+		err = json.Unmarshal(raw, &result.Prestate)
+	default:
+		err = json.Unmarshal(raw, &result.StructLogs)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode %s result", tracerName(tracer))
+	}
+
+	return &result, nil
+}
+
+func (r TraceResult) MarshalJSON() ([]byte, error) {
+	switch {
+	case r.CallFrame != nil:
+		return json.Marshal(r.CallFrame)
+	case r.PrestateDiff != nil:
+		return json.Marshal(r.PrestateDiff)
+	case r.Prestate != nil:
+		return json.Marshal(r.Prestate)
+	default:
+		return json.Marshal(r.StructLogs)
+	}
+}
+
+func tracerName(tracer string) string {
+	if tracer == TracerStructLogs {
+		return "struct logger"
+	}
+
+	return tracer
+}
+
+// TransactionTrace is the trace of a transaction of a block returned by debug_traceBlockByNumber
+type TransactionTrace struct {
+	TxHash *Hash        `json:"txHash,omitempty"`
+	Result *TraceResult `json:"result,omitempty"`
+	Error  string       `json:"error,omitempty"`
+}
+
+// StructLogResult is the result of the struct logger
+type StructLogResult struct {
+	Gas         uint64      `json:"gas"`
+	Failed      bool        `json:"failed"`
+	ReturnValue string      `json:"returnValue"`
+	StructLogs  []StructLog `json:"structLogs"`
+}
+
+// StructLog is an opcode executed, along with the state of the EVM before its execution
+type StructLog struct {
+	PC         uint64            `json:"pc"`
+	Op         string            `json:"op"`
+	Gas        uint64            `json:"gas"`
+	GasCost    uint64            `json:"gasCost"`
+	Depth      int               `json:"depth"`
+	Error      string            `json:"error,omitempty"`
+	Stack      []string          `json:"stack,omitempty"`
+	Memory     []string          `json:"memory,omitempty"`
+	Storage    map[string]string `json:"storage,omitempty"`
+	ReturnData string            `json:"returnData,omitempty"`
+	Refund     uint64            `json:"refund,omitempty"`
+}
+
+// CallFrame is a call traced by the callTracer, along with its sub calls
+type CallFrame struct {
+	Type         string      `json:"type"`
+	From         Address     `json:"from"`
+	To           *Address    `json:"to,omitempty"`
+	Value        *Quantity   `json:"value,omitempty"`
+	Gas          Quantity    `json:"gas"`
+	GasUsed      Quantity    `json:"gasUsed"`
+	Input        Data        `json:"input"`
+	Output       *Data       `json:"output,omitempty"`
+	Error        string      `json:"error,omitempty"`
+	RevertReason string      `json:"revertReason,omitempty"`
+	Calls        []CallFrame `json:"calls,omitempty"`
+	Logs         []CallLog   `json:"logs,omitempty"`
+}
+
+// CallLog is a log emitted by a call, Position being the number of sub calls made before it
+type CallLog struct {
+	Address  Address  `json:"address"`
+	Topics   []Topic  `json:"topics"`
+	Data     Data     `json:"data"`
+	Position Quantity `json:"position"`
+}
+
+// SkipCalls can be returned by the function passed to CallFrame.Walk to skip the sub calls of a frame
+var SkipCalls = errors.New("skip calls")
+
+// Walk calls fn for the frame and all its sub calls depth first, along with the indexes of the sub calls leading
+// to them from the frame.  The path is reused between calls and must be copied to be retained.
+func (f *CallFrame) Walk(fn func(frame *CallFrame, path []int) error) error {
+	err := f.walk(fn, make([]int, 0, 8))
+	if err == SkipCalls {
+		return nil
+	}
+
+	return err
+}
+
+func (f *CallFrame) walk(fn func(frame *CallFrame, path []int) error, path []int) error {
+	if err := fn(f, path); err != nil {
+		return err
+	}
+
+	for i := range f.Calls {
+		err := f.Calls[i].walk(fn, append(path, i))
+		if err != nil && err != SkipCalls {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InternalTransfer is a transfer of ether made by a sub call of a transaction
+type InternalTransfer struct {
+	Type  string
+	From  Address
+	To    Address
+	Value Quantity
+
+	// Path are the indexes of the sub calls leading to the transfer from the top level call
+	Path []int
+}
+
+// InternalTransfers returns the transfers of ether made by the sub calls of the frame.  Failed calls are skipped
+// along with their sub calls since they're reverted, and so are delegate calls which don't transfer ether.
+func (f *CallFrame) InternalTransfers() []InternalTransfer {
+	transfers := make([]InternalTransfer, 0)
+	_ = f.Walk(func(frame *CallFrame, path []int) error {
+		if frame.Error != "" {
+			return SkipCalls
+		}
+
+		if len(path) == 0 || frame.To == nil || frame.Value == nil || frame.Value.Big().Sign() == 0 {
+			return nil
+		}
+
+		switch frame.Type {
+		case "CALL", "CREATE", "CREATE2", "SELFDESTRUCT":
+			transfers = append(transfers, InternalTransfer{
+				Type:  frame.Type,
+				From:  frame.From,
+				To:    *frame.To,
+				Value: *frame.Value,
+				Path:  append([]int{}, path...),
+			})
+		}
+
+		return nil
+	})
+
+	return transfers
+}
+
+// PrestateAccount is the state of an account traced by the prestateTracer, only touched storage slots being included
+type PrestateAccount struct {
+	Balance *Quantity     `json:"balance,omitempty"`
+	Nonce   uint64        `json:"nonce,omitempty"`
+	Code    *Data         `json:"code,omitempty"`
+	Storage map[Hash]Hash `json:"storage,omitempty"`
+}
+
+// Prestate is the result of the prestateTracer, keyed by account address
+type Prestate map[Address]PrestateAccount
+
+// UnmarshalJSON checksums the addresses of the accounts, since map keys don't go through Address.UnmarshalJSON
+func (p *Prestate) UnmarshalJSON(data []byte) error {
+	raw := make(map[string]PrestateAccount)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	prestate := make(Prestate, len(raw))
+	for key, account := range raw {
+		address, err := NewAddress(key)
+		if err != nil {
+			return errors.Wrapf(err, "invalid account %s", key)
+		}
+		prestate[*address] = account
+	}
+
+	*p = prestate
+	return nil
+}
+
+// PrestateDiff is the result of the prestateTracer in diff mode, Post only including the accounts and fields that
+// changed, and Pre their values before the transaction
+type PrestateDiff struct {
+	Pre  Prestate `json:"pre"`
+	Post Prestate `json:"post"`
+}
@@ -0,0 +1,288 @@
+package eth_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+)
+
+func TestTraceConfig_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   eth.TraceConfig
+		expected string
+	}{
+		{
+			name:     "default",
+			config:   eth.TraceConfig{},
+			expected: `{}`,
+		},
+		{
+			name:     "struct logs",
+			config:   eth.TraceConfig{StructLogs: &eth.StructLogConfig{EnableMemory: true, DisableStack: true, Limit: 10}, Timeout: "5s"},
+			expected: `{"enableMemory":true,"disableStack":true,"limit":10,"timeout":"5s"}`,
+		},
+		{
+			name:     "call tracer",
+			config:   eth.TraceConfig{CallTracer: &eth.CallTracerConfig{WithLog: true}},
+			expected: `{"tracer":"callTracer","tracerConfig":{"withLog":true}}`,
+		},
+		{
+			name:     "prestate tracer",
+			config:   eth.TraceConfig{PrestateTracer: &eth.PrestateTracerConfig{DiffMode: true}},
+			expected: `{"tracer":"prestateTracer","tracerConfig":{"diffMode":true}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.config)
+			require.NoError(t, err)
+			require.JSONEq(t, tt.expected, string(b))
+		})
+	}
+
+	_, err := json.Marshal(eth.TraceConfig{CallTracer: &eth.CallTracerConfig{}, PrestateTracer: &eth.PrestateTracerConfig{}})
+	require.Error(t, err)
+
+	_, err = json.Marshal(eth.TraceConfig{CallTracer: &eth.CallTracerConfig{}, StructLogs: &eth.StructLogConfig{}})
+	require.Error(t, err)
+}
+
+func TestTraceCallConfig_MarshalJSON(t *testing.T) {
+	number := eth.QuantityFromUInt64(100)
+	balance := eth.QuantityFromUInt64(1000)
+	to := eth.MustAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+	config := eth.TraceCallConfig{
+		TraceConfig: eth.TraceConfig{CallTracer: &eth.CallTracerConfig{OnlyTopCall: true}},
+		Overrides: &eth.CallOverrides{
+			State: eth.StateOverride{*to: {Balance: &balance}},
+			Block: &eth.BlockOverrides{Number: &number},
+		},
+	}
+
+	b, err := json.Marshal(config)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"tracer": "callTracer",
+		"tracerConfig": {"onlyTopCall": true},
+		"stateOverrides": {"0x6B175474E89094C44Da98b954EedeAC495271d0F": {"balance": "0x3e8"}},
+		"blockOverrides": {"number": "0x64"}
+	}`, string(b))
+}
+
+const callTrace = `{
+	"type": "CALL",
+	"from": "0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b",
+	"to": "0x1000000000000000000000000000000000000001",
+	"value": "0xde0b6b3a7640000",
+	"gas": "0x7a120",
+	"gasUsed": "0x1d4c0",
+	"input": "0x",
+	"calls": [
+		{
+			"type": "CALL",
+			"from": "0x1000000000000000000000000000000000000001",
+			"to": "0x2000000000000000000000000000000000000002",
+			"value": "0x64",
+			"gas": "0x5208",
+			"gasUsed": "0x0",
+			"input": "0x"
+		},
+		{
+			"type": "DELEGATECALL",
+			"from": "0x1000000000000000000000000000000000000001",
+			"to": "0x3000000000000000000000000000000000000003",
+			"value": "0xc8",
+			"gas": "0x5208",
+			"gasUsed": "0x100",
+			"input": "0x12345678",
+			"output": "0x",
+			"calls": [
+				{
+					"type": "CREATE2",
+					"from": "0x1000000000000000000000000000000000000001",
+					"to": "0x4000000000000000000000000000000000000004",
+					"value": "0x1",
+					"gas": "0x5208",
+					"gasUsed": "0x100",
+					"input": "0x6000"
+				}
+			]
+		},
+		{
+			"type": "CALL",
+			"from": "0x1000000000000000000000000000000000000001",
+			"to": "0x5000000000000000000000000000000000000005",
+			"value": "0x12c",
+			"gas": "0x5208",
+			"gasUsed": "0x5208",
+			"input": "0x",
+			"error": "execution reverted",
+			"revertReason": "not enough funds",
+			"calls": [
+				{
+					"type": "CALL",
+					"from": "0x5000000000000000000000000000000000000005",
+					"to": "0x6000000000000000000000000000000000000006",
+					"value": "0x1",
+					"gas": "0x5208",
+					"gasUsed": "0x0",
+					"input": "0x"
+				}
+			]
+		},
+		{
+			"type": "STATICCALL",
+			"from": "0x1000000000000000000000000000000000000001",
+			"to": "0x7000000000000000000000000000000000000007",
+			"gas": "0x5208",
+			"gasUsed": "0x0",
+			"input": "0x"
+		},
+		{
+			"type": "CALL",
+			"from": "0x1000000000000000000000000000000000000001",
+			"to": "0x8000000000000000000000000000000000000008",
+			"value": "0x0",
+			"gas": "0x5208",
+			"gasUsed": "0x0",
+			"input": "0x"
+		}
+	],
+	"logs": [
+		{
+			"address": "0x1000000000000000000000000000000000000001",
+			"topics": ["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"],
+			"data": "0x",
+			"position": "0x1"
+		}
+	]
+}`
+
+func TestDecodeTraceResult(t *testing.T) {
+	t.Run("call tracer", func(t *testing.T) {
+		config := eth.TraceConfig{CallTracer: &eth.CallTracerConfig{WithLog: true}}
+		result, err := eth.DecodeTraceResult(config, json.RawMessage(callTrace))
+		require.NoError(t, err)
+		require.NotNil(t, result.CallFrame)
+		require.Nil(t, result.StructLogs)
+
+		frame := result.CallFrame
+		require.Equal(t, "CALL", frame.Type)
+		require.Equal(t, uint64(120000), frame.GasUsed.UInt64())
+		require.Len(t, frame.Calls, 5)
+		require.Equal(t, "not enough funds", frame.Calls[2].RevertReason)
+		require.Len(t, frame.Logs, 1)
+		require.Equal(t, uint64(1), frame.Logs[0].Position.UInt64())
+
+		b, err := json.Marshal(result)
+		require.NoError(t, err)
+		require.JSONEq(t, callTrace, string(b))
+	})
+
+	t.Run("prestate tracer", func(t *testing.T) {
+		raw := `{
+			"0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b": {"balance": "0xde0b6b3a7640000", "nonce": 1},
+			"0x1000000000000000000000000000000000000001": {
+				"balance": "0x0",
+				"code": "0x6000",
+				"storage": {"0x0000000000000000000000000000000000000000000000000000000000000001": "0x00000000000000000000000000000000000000000000000000000000000000ff"}
+			}
+		}`
+
+		result, err := eth.DecodeTraceResult(eth.TraceConfig{PrestateTracer: &eth.PrestateTracerConfig{}}, json.RawMessage(raw))
+		require.NoError(t, err)
+		require.Len(t, result.Prestate, 2)
+
+		sender := result.Prestate[*eth.MustAddress("0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b")]
+		require.Equal(t, uint64(1), sender.Nonce)
+		require.Equal(t, "0xde0b6b3a7640000", sender.Balance.String())
+
+		contract := result.Prestate[*eth.MustAddress("0x1000000000000000000000000000000000000001")]
+		require.Equal(t, eth.Data("0x6000"), *contract.Code)
+		require.Equal(t,
+			eth.Hash("0x00000000000000000000000000000000000000000000000000000000000000ff"),
+			contract.Storage[eth.Hash("0x0000000000000000000000000000000000000000000000000000000000000001")],
+		)
+	})
+
+	t.Run("prestate tracer diff mode", func(t *testing.T) {
+		raw := `{
+			"pre": {"0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b": {"balance": "0xde0b6b3a7640000", "nonce": 1}},
+			"post": {"0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b": {"balance": "0xde0b6b3a763ff9c", "nonce": 2}}
+		}`
+
+		result, err := eth.DecodeTraceResult(eth.TraceConfig{PrestateTracer: &eth.PrestateTracerConfig{DiffMode: true}}, json.RawMessage(raw))
+		require.NoError(t, err)
+		require.Nil(t, result.Prestate)
+		require.NotNil(t, result.PrestateDiff)
+
+		sender := *eth.MustAddress("0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b")
+		require.Equal(t, uint64(1), result.PrestateDiff.Pre[sender].Nonce)
+		require.Equal(t, uint64(2), result.PrestateDiff.Post[sender].Nonce)
+	})
+
+	t.Run("struct logs", func(t *testing.T) {
+		raw := `{
+			"gas": 21003,
+			"failed": false,
+			"returnValue": "",
+			"structLogs": [
+				{"pc": 0, "op": "PUSH1", "gas": 78994, "gasCost": 3, "depth": 1, "stack": []},
+				{"pc": 2, "op": "SSTORE", "gas": 78991, "gasCost": 20000, "depth": 1, "stack": ["0x1", "0x0"], "storage": {"0000000000000000000000000000000000000000000000000000000000000000": "0000000000000000000000000000000000000000000000000000000000000001"}}
+			]
+		}`
+
+		result, err := eth.DecodeTraceResult(eth.TraceConfig{}, json.RawMessage(raw))
+		require.NoError(t, err)
+		require.NotNil(t, result.StructLogs)
+		require.Equal(t, uint64(21003), result.StructLogs.Gas)
+		require.Len(t, result.StructLogs.StructLogs, 2)
+		require.Equal(t, "SSTORE", result.StructLogs.StructLogs[1].Op)
+		require.Equal(t, []string{"0x1", "0x0"}, result.StructLogs.StructLogs[1].Stack)
+	})
+
+	t.Run("mismatched tracer", func(t *testing.T) {
+		_, err := eth.DecodeTraceResult(eth.TraceConfig{}, json.RawMessage(`[]`))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "could not decode struct logger result")
+	})
+}
+
+func TestCallFrame_Walk(t *testing.T) {
+	frame := eth.CallFrame{}
+	require.NoError(t, json.Unmarshal([]byte(callTrace), &frame))
+
+	paths := make([][]int, 0)
+	err := frame.Walk(func(f *eth.CallFrame, path []int) error {
+		paths = append(paths, append([]int{}, path...))
+		if f.Type == "DELEGATECALL" {
+			return eth.SkipCalls
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]int{{}, {0}, {1}, {2}, {2, 0}, {3}, {4}}, paths)
+}
+
+func TestCallFrame_InternalTransfers(t *testing.T) {
+	frame := eth.CallFrame{}
+	require.NoError(t, json.Unmarshal([]byte(callTrace), &frame))
+
+	transfers := frame.InternalTransfers()
+	require.Len(t, transfers, 2)
+
+	require.Equal(t, "CALL", transfers[0].Type)
+	require.Equal(t, *eth.MustAddress("0x1000000000000000000000000000000000000001"), transfers[0].From)
+	require.Equal(t, *eth.MustAddress("0x2000000000000000000000000000000000000002"), transfers[0].To)
+	require.Equal(t, int64(100), transfers[0].Value.Int64())
+	require.Equal(t, []int{0}, transfers[0].Path)
+
+	// the create of a delegate call is still a transfer from the calling contract
+	require.Equal(t, "CREATE2", transfers[1].Type)
+	require.Equal(t, []int{1, 0}, transfers[1].Path)
+}
@@ -1,6 +1,7 @@
 package eth
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,6 +16,8 @@ var (
 	TransactionTypeLegacy     = int64(0x0) // TransactionTypeLegacy refers to pre-EIP-2718 transactions.
 	TransactionTypeAccessList = int64(0x1) // TransactionTypeAccessList refers to EIP-2930 transactions.
 	TransactionTypeDynamicFee = int64(0x2) // TransactionTypeDynamicFee refers to EIP-1559 transactions.
+	TransactionTypeBlob       = int64(0x3) // TransactionTypeBlob refers to EIP-4844 transactions.
+	TransactionTypeSetCode    = int64(0x4) // TransactionTypeSetCode refers to EIP-7702 transactions.
 )
 
 type Transaction struct {
@@ -51,10 +54,27 @@ type Transaction struct {
 	// EIP-2930 accessList
 	AccessList *AccessList `json:"accessList,omitempty"`
 
+	// EIP-4844 MaxFeePerBlobGas/BlobVersionedHashes (optional since only included in blob transactions)
+	MaxFeePerBlobGas    *Quantity `json:"maxFeePerBlobGas,omitempty"`
+	BlobVersionedHashes []Hash    `json:"blobVersionedHashes,omitempty"`
+
+	// EIP-7702 authorizationList (optional since only included in set code transactions)
+	AuthorizationList []Authorization `json:"authorizationList,omitempty"`
+
 	// Keep the source so we can recreate its expected representation
 	source string
 }
 
+// Authorization is an EIP-7702 authorization, signed by an account to delegate its code to Address
+type Authorization struct {
+	ChainId Quantity `json:"chainId"`
+	Address Address  `json:"address"`
+	Nonce   Quantity `json:"nonce"`
+	YParity Quantity `json:"yParity"`
+	R       Quantity `json:"r"`
+	S       Quantity `json:"s"`
+}
+
 type NewPendingTxBodyNotificationParams struct {
 	Subscription string      `json:"subscription"`
 	Result       Transaction `json:"result"`
@@ -136,77 +156,78 @@ func (t *Transaction) RawRepresentation() (*Data, error) {
 		return nil, err
 	}
 
+	var (
+		payload interface{}
+		prefix  []byte
+	)
+
 	switch t.TransactionType() {
 	case TransactionTypeLegacy:
 		// Legacy Transactions are RLP(Nonce, GasPrice, Gas, To, Value, Input, V, R, S)
-		message := rlp.Value{List: []rlp.Value{
-			t.Nonce.RLP(),
-			t.GasPrice.RLP(),
-			t.Gas.RLP(),
-			t.To.RLP(),
-			t.Value.RLP(),
-			{String: t.Input.String()},
-			t.V.RLP(),
-			t.R.RLP(),
-			t.S.RLP(),
-		}}
-		if encoded, err := message.Encode(); err != nil {
-			return nil, err
-		} else {
-			return NewData(encoded)
+		payload = legacyTransaction{
+			Nonce:    t.Nonce,
+			GasPrice: *t.GasPrice,
+			Gas:      t.Gas,
+			To:       t.To,
+			Value:    t.Value,
+			Input:    t.Input,
+			V:        t.V,
+			R:        t.R,
+			S:        t.S,
 		}
 	case TransactionTypeAccessList:
 		// EIP-2930 Transactions are 0x1 || rlp([chainId, nonce, gasPrice, gasLimit, to, value, data, access_list, yParity, senderR, senderS])
-		typePrefix, err := t.Type.RLP().Encode()
-		if err != nil {
-			return nil, err
-		}
-		payload := rlp.Value{List: []rlp.Value{
-			t.ChainId.RLP(),
-			t.Nonce.RLP(),
-			t.GasPrice.RLP(),
-			t.Gas.RLP(),
-			t.To.RLP(),
-			t.Value.RLP(),
-			{String: t.Input.String()},
-			t.AccessList.RLP(),
-			t.V.RLP(),
-			t.R.RLP(),
-			t.S.RLP(),
-		}}
-		if encodedPayload, err := payload.Encode(); err != nil {
-			return nil, err
-		} else {
-			return NewData(typePrefix + encodedPayload[2:])
+		payload = accessListTransaction{
+			ChainId:    *t.ChainId,
+			Nonce:      t.Nonce,
+			GasPrice:   *t.GasPrice,
+			Gas:        t.Gas,
+			To:         t.To,
+			Value:      t.Value,
+			Input:      t.Input,
+			AccessList: t.accessList(),
+			V:          t.V,
+			R:          t.R,
+			S:          t.S,
 		}
+		prefix = []byte{byte(TransactionTypeAccessList)}
 	case TransactionTypeDynamicFee:
 		// We introduce a new EIP-2718 transaction type, with the format 0x02 || rlp([chainId, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimit, to, value, data, access_list, signatureYParity, signatureR, signatureS]).
-		typePrefix, err := t.Type.RLP().Encode()
-		if err != nil {
-			return nil, err
-		}
-		payload := rlp.Value{List: []rlp.Value{
-			t.ChainId.RLP(),
-			t.Nonce.RLP(),
-			t.MaxPriorityFeePerGas.RLP(),
-			t.MaxFeePerGas.RLP(),
-			t.Gas.RLP(),
-			t.To.RLP(),
-			t.Value.RLP(),
-			{String: t.Input.String()},
-			t.AccessList.RLP(),
-			t.V.RLP(),
-			t.R.RLP(),
-			t.S.RLP(),
-		}}
-		if encodedPayload, err := payload.Encode(); err != nil {
-			return nil, err
-		} else {
-			return NewData(typePrefix + encodedPayload[2:])
+		payload = dynamicFeeTransaction{
+			ChainId:              *t.ChainId,
+			Nonce:                t.Nonce,
+			MaxPriorityFeePerGas: *t.MaxPriorityFeePerGas,
+			MaxFeePerGas:         *t.MaxFeePerGas,
+			Gas:                  t.Gas,
+			To:                   t.To,
+			Value:                t.Value,
+			Input:                t.Input,
+			AccessList:           t.accessList(),
+			V:                    t.V,
+			R:                    t.R,
+			S:                    t.S,
 		}
+		prefix = []byte{byte(TransactionTypeDynamicFee)}
 	default:
 		return nil, errors.New("unsupported transaction type")
 	}
+
+	encoded, err := rlp.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := Data("0x" + hex.EncodeToString(append(prefix, encoded...)))
+	return &raw, nil
+}
+
+// accessList returns the AccessList of the transaction, which may be nil
+func (t *Transaction) accessList() AccessList {
+	if t.AccessList == nil {
+		return nil
+	}
+
+	return *t.AccessList
 }
 
 func (t Transaction) MarshalJSON() ([]byte, error) {
@@ -1,7 +1,7 @@
 package eth
 
 import (
-	"reflect"
+	"encoding/hex"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -14,28 +14,25 @@ import (
 // after EIP-2718 the payload format depends on the transaction type included as the first byte.
 // Unsigned transactions where R, S, and V are zero are not currently supported.
 func (t *Transaction) FromRaw(input string) error {
-	// Code was originally heavily inspired by ethers.js v4 utils.transaction.parse:
-	// https://github.com/ethers-io/ethers.js/blob/v4-legacy/utils/transaction.js#L90
-	// Copyright (c) 2017 Richard Moore
-	//
-	// However it's since been somewhat extensively rewritten to support EIP-2718 and -2930
+	if !strings.HasPrefix(input, "0x") {
+		return errors.New("input must start with 0x")
+	}
 
-	var (
-		chainId              Quantity
-		nonce                Quantity
-		gasPrice             Quantity
-		gasLimit             Quantity
-		maxPriorityFeePerGas Quantity
-		maxFeePerGas         Quantity
-		to                   *Address
-		value                Quantity
-		data                 Data
-		v                    Quantity
-		r                    Quantity
-		s                    Quantity
-		accessList           AccessList
-	)
+	if len(input) < 4 {
+		return errors.New("not enough input to decode")
+	}
 
+	raw, err := hex.DecodeString(input[2:])
+	if err != nil {
+		return errors.Wrap(err, "could not decode hex input")
+	}
+
+	return t.fromRaw(raw, false)
+}
+
+// FromRawStrict is like FromRaw but rejects raw transactions that aren't canonical RLP, like nodes do.  The
+// cause of such errors is an *rlp.NonCanonicalError whose offset is relative to the raw transaction.
+func (t *Transaction) FromRawStrict(input string) error {
 	if !strings.HasPrefix(input, "0x") {
 		return errors.New("input must start with 0x")
 	}
@@ -44,221 +41,186 @@ func (t *Transaction) FromRaw(input string) error {
 		return errors.New("not enough input to decode")
 	}
 
-	var firstByte byte
-	if prefix, err := NewData(input[:4]); err != nil {
-		return errors.Wrap(err, "could not inspect transaction prefix")
-	} else {
-		firstByte = prefix.Bytes()[0]
+	raw, err := hex.DecodeString(input[2:])
+	if err != nil {
+		return errors.Wrap(err, "could not decode hex input")
 	}
 
-	switch {
-	case firstByte == byte(TransactionTypeAccessList):
-		// EIP-2930 transaction
-		payload := "0x" + input[4:]
-		if err := rlpDecodeList(payload, &chainId, &nonce, &gasPrice, &gasLimit, &to, &value, &data, &accessList, &v, &r, &s); err != nil {
-			return errors.Wrap(err, "could not decode RLP components")
-		}
+	return t.fromRaw(raw, true)
+}
 
-		if r.Int64() == 0 && s.Int64() == 0 {
-			return errors.New("unsigned transactions not supported")
-		}
+// fromRaw populates a Transaction's fields from the raw transaction bytes, see FromRaw and FromRawStrict.
+func (t *Transaction) fromRaw(input []byte, strict bool) error {
+	// Code was originally heavily inspired by ethers.js v4 utils.transaction.parse:
+	// https://github.com/ethers-io/ethers.js/blob/v4-legacy/utils/transaction.js#L90
+	// Copyright (c) 2017 Richard Moore
+	//
+	// However it's since been somewhat extensively rewritten to support EIP-2718 and -2930
 
-		t.Type = OptionalQuantityFromInt(int(firstByte))
-		t.Nonce = nonce
-		t.GasPrice = &gasPrice
-		t.Gas = gasLimit
-		t.To = to
-		t.Value = value
-		t.Input = data
-		t.AccessList = &accessList
-		t.V = v
-		t.R = r
-		t.S = s
-		t.ChainId = &chainId
+	if len(input) < 1 {
+		return errors.New("not enough input to decode")
+	}
 
-		signingHash, err := t.SigningHash(chainId)
-		if err != nil {
-			return err
-		}
+	firstByte := input[0]
 
-		signature, err := NewEIP2718Signature(chainId, r, s, v)
-		if err != nil {
-			return err
-		}
+	unmarshal := rlp.Unmarshal
+	if strict {
+		unmarshal = rlp.UnmarshalStrict
+	}
 
-		sender, err := signature.Recover(signingHash)
-		if err != nil {
-			return err
+	// typed transactions are decoded without their type byte, which must be accounted for in error offsets
+	unmarshalPayload := func(v interface{}) error {
+		err := unmarshal(input[1:], v)
+		if nc, ok := errors.Cause(err).(*rlp.NonCanonicalError); ok && nc.Offset >= 0 {
+			nc.Offset++
 		}
+		return err
+	}
 
-		raw, err := t.RawRepresentation()
-		if err != nil {
-			return err
+	var (
+		chainId *Quantity
+		v, r, s Quantity
+	)
+
+	switch {
+	case firstByte == byte(TransactionTypeAccessList):
+		// EIP-2930 transaction
+		var payload accessListTransaction
+		if err := unmarshalPayload(&payload); err != nil {
+			return errors.Wrap(err, "could not decode RLP components")
 		}
 
-		t.Hash = raw.Hash()
-		t.From = *sender
-		return nil
+		t.Type = OptionalQuantityFromInt(int(firstByte))
+		t.Nonce = payload.Nonce
+		t.GasPrice = &payload.GasPrice
+		t.Gas = payload.Gas
+		t.To = payload.To
+		t.Value = payload.Value
+		t.Input = payload.Input
+		t.AccessList = &payload.AccessList
+		t.ChainId = &payload.ChainId
+		chainId, v, r, s = t.ChainId, payload.V, payload.R, payload.S
 	case firstByte == byte(TransactionTypeDynamicFee):
 		// EIP-1559 transaction
-		payload := "0x" + input[4:]
-		// 0x02 || rlp([chainId, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimit, to, value, data, access_list, signatureYParity, signatureR, signatureS])
-		if err := rlpDecodeList(payload, &chainId, &nonce, &maxPriorityFeePerGas, &maxFeePerGas, &gasLimit, &to, &value, &data, &accessList, &v, &r, &s); err != nil {
+		var payload dynamicFeeTransaction
+		if err := unmarshalPayload(&payload); err != nil {
 			return errors.Wrap(err, "could not decode RLP components")
 		}
 
-		if r.Int64() == 0 && s.Int64() == 0 {
-			return errors.New("unsigned transactions not supported")
-		}
-
 		t.Type = OptionalQuantityFromInt(int(firstByte))
-		t.Nonce = nonce
-		t.MaxPriorityFeePerGas = &maxPriorityFeePerGas
-		t.MaxFeePerGas = &maxFeePerGas
-		t.Gas = gasLimit
-		t.To = to
-		t.Value = value
-		t.Input = data
-		t.AccessList = &accessList
-		t.V = v
-		t.R = r
-		t.S = s
-		t.ChainId = &chainId
-
-		signingHash, err := t.SigningHash(chainId)
-		if err != nil {
-			return err
-		}
-
-		signature, err := NewEIP2718Signature(chainId, r, s, v)
-		if err != nil {
-			return err
-		}
-
-		sender, err := signature.Recover(signingHash)
-		if err != nil {
-			return err
-		}
-
-		raw, err := t.RawRepresentation()
-		if err != nil {
-			return err
-		}
-
-		t.Hash = raw.Hash()
-		t.From = *sender
-		return nil
+		t.Nonce = payload.Nonce
+		t.MaxPriorityFeePerGas = &payload.MaxPriorityFeePerGas
+		t.MaxFeePerGas = &payload.MaxFeePerGas
+		t.Gas = payload.Gas
+		t.To = payload.To
+		t.Value = payload.Value
+		t.Input = payload.Input
+		t.AccessList = &payload.AccessList
+		t.ChainId = &payload.ChainId
+		chainId, v, r, s = t.ChainId, payload.V, payload.R, payload.S
 	case firstByte > 0x7f:
 		// In EIP-2718 types larger than 0x7f are reserved since they potentially conflict with legacy RLP encoded
-		// transactions.  As such we can attempt to decode any such transactions as legacy format and attempt to
-		// decode the input string as an rlp.Value
-		if err := rlpDecodeList(input, &nonce, &gasPrice, &gasLimit, &to, &value, &data, &v, &r, &s); err != nil {
+		// transactions.  As such we can attempt to decode any such transactions as legacy format.
+		var payload legacyTransaction
+		if err := unmarshal(input, &payload); err != nil {
 			return errors.Wrap(err, "could not decode RLP components")
 		}
 
-		if r.Int64() == 0 && s.Int64() == 0 {
-			return errors.New("unsigned transactions not supported")
-		}
-
-		// ... and fill in all our fields with the decoded values
-		t.Nonce = nonce
-		t.GasPrice = &gasPrice
-		t.Gas = gasLimit
-		t.To = to
-		t.Value = value
-		t.Input = data
-		t.V = v
-		t.R = r
-		t.S = s
+		t.Nonce = payload.Nonce
+		t.GasPrice = &payload.GasPrice
+		t.Gas = payload.Gas
+		t.To = payload.To
+		t.Value = payload.Value
+		t.Input = payload.Input
+		v, r, s = payload.V, payload.R, payload.S
+	default:
+		return errors.New("unsupported transaction type")
+	}
 
-		signature, err := NewEIP155Signature(r, s, v)
-		if err != nil {
-			return err
-		}
+	if r.Int64() == 0 && s.Int64() == 0 {
+		return errors.New("unsigned transactions not supported")
+	}
 
-		signingHash, err := t.SigningHash(signature.chainId)
-		if err != nil {
-			return err
-		}
+	t.V = v
+	t.R = r
+	t.S = s
 
-		sender, err := signature.Recover(signingHash)
+	var signature *Signature
+	if chainId != nil {
+		sig, err := NewEIP2718Signature(*chainId, r, s, v)
 		if err != nil {
 			return err
 		}
-
-		raw, err := t.RawRepresentation()
+		signature = sig
+	} else {
+		sig, err := NewEIP155Signature(r, s, v)
 		if err != nil {
 			return err
 		}
-
-		t.Hash = raw.Hash()
-		t.From = *sender
-		return nil
-	default:
-		return errors.New("unsupported transaction type")
+		signature = sig
+		chainId = &sig.chainId
 	}
-}
 
-// rlpDecodeList decodes an RLP list into the passed in receivers.  Currently only the receiver types needed for
-// legacy and EIP-2930 transactions are implemented, new receivers can easily be added in the for loop.
-//
-// Note that when calling this function, the receivers MUST be pointers never values, and for "optional" receivers
-// such as Address a pointer to a pointer must be passed.  For example:
-//
-//    var (
-//      addr  *eth.Address
-//      nonce eth.Quantity
-//    )
-//    err := rlpDecodeList(payload, &addr, &nonce)
-//
-// TODO: Consider making this function public once all receiver types in the eth package are supported.
-func rlpDecodeList(input string, receivers ...interface{}) error {
-	decoded, err := rlp.From(input)
+	signingHash, err := t.SigningHash(*chainId)
 	if err != nil {
 		return err
 	}
 
-	if len(decoded.List) < len(receivers) {
-		return errors.Errorf("expected %d items but only received %d", len(receivers), len(decoded.List))
+	sender, err := signature.Recover(signingHash)
+	if err != nil {
+		return err
 	}
 
-	for i := range receivers {
-		value := decoded.List[i]
-		switch receiver := receivers[i].(type) {
-		case *Quantity:
-			q, err := NewQuantityFromRLP(value)
-			if err != nil {
-				return errors.Wrapf(err, "could not decode list item %d to Quantity", i)
-			}
-			*receiver = *q
-		case **Address:
-			if value.String == "0x" {
-				*receiver = nil
-			} else {
-				a, err := NewAddress(value.String)
-				if err != nil {
-					return errors.Wrapf(err, "could not decode list item %d to Address", i)
-				}
-				*receiver = a
-			}
-		case *Data:
-			d, err := NewData(value.String)
-			if err != nil {
-				return errors.Wrapf(err, "could not decode list item %d to Data", i)
-			}
-			*receiver = *d
-		case *rlp.Value:
-			*receiver = value
-		case *AccessList:
-			accessList, err := NewAccessListFromRLP(value)
-			if err != nil {
-				return errors.Wrapf(err, "could not decode list item %d to AccessList", i)
-			}
-			*receiver = accessList
-		default:
-			return errors.Errorf("unsupported decode receiver %s", reflect.TypeOf(receiver).String())
-		}
+	raw, err := t.RawRepresentation()
+	if err != nil {
+		return err
 	}
 
+	t.Hash = raw.Hash()
+	t.From = *sender
 	return nil
 }
+
+// legacyTransaction is the RLP layout of pre-EIP-2718 transactions
+type legacyTransaction struct {
+	Nonce    Quantity
+	GasPrice Quantity
+	Gas      Quantity
+	To       *Address `rlp:"nil"`
+	Value    Quantity
+	Input    Data
+	V        Quantity
+	R        Quantity
+	S        Quantity
+}
+
+// accessListTransaction is the RLP layout of EIP-2930 transactions, following their type byte
+type accessListTransaction struct {
+	ChainId    Quantity
+	Nonce      Quantity
+	GasPrice   Quantity
+	Gas        Quantity
+	To         *Address `rlp:"nil"`
+	Value      Quantity
+	Input      Data
+	AccessList AccessList
+	V          Quantity
+	R          Quantity
+	S          Quantity
+}
+
+// dynamicFeeTransaction is the RLP layout of EIP-1559 transactions, following their type byte
+type dynamicFeeTransaction struct {
+	ChainId              Quantity
+	Nonce                Quantity
+	MaxPriorityFeePerGas Quantity
+	MaxFeePerGas         Quantity
+	Gas                  Quantity
+	To                   *Address `rlp:"nil"`
+	Value                Quantity
+	Input                Data
+	AccessList           AccessList
+	V                    Quantity
+	R                    Quantity
+	S                    Quantity
+}
@@ -3,9 +3,11 @@ package eth_test
 import (
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/rlp"
 )
 
 func TestTransaction_FromRaw(t *testing.T) {
@@ -246,6 +248,36 @@ func TestTransaction_FromRaw_Samples(t *testing.T) {
 	}
 }
 
+func TestTransaction_FromRawStrict(t *testing.T) {
+	for i, sample := range samples {
+		tx := eth.Transaction{}
+		err := tx.FromRawStrict(sample.Raw)
+		require.NoError(t, err, i)
+		require.Equal(t, sample.Hash, tx.Hash.String(), i)
+	}
+
+	for _, c := range []struct {
+		raw    string
+		offset int
+		rule   rlp.Rule
+	}{
+		// the nonce 0x0522 of the second sample with a leading zero byte
+		{"0xf88c83000522" + samples[1].Raw[12:], 2, rlp.RuleIntegerLeadingZero},
+		// the nonce 0x0c of the EIP-2930 example as a single byte string, the offset includes the type byte
+		{"0x01f9017f86796f6c6f7633810c843b9aca00829ab0948a8eafb1cf62bfbeb1741769dae1a9dd479961928080f90111f859940000000000000000000000000000000000001337f842a00000000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000133700000000000000000000000f859940000000000000000000000000000000000001337f842a00000000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000133700000000000000000000000f859940000000000000000000000000000000000001337f842a00000000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000013370000000000000000000000080a01684eb52101a049f7bda9b0239dbd56db6735f8eed4207cdabd682dde3ed06bfa071d5cf480a272dc907ff4a4efb2a4a96e2132409592268747acfa5d03f50deb7", 11, rlp.RuleSingleByte},
+	} {
+		tx := eth.Transaction{}
+		require.NoError(t, tx.FromRaw(c.raw))
+
+		err := tx.FromRawStrict(c.raw)
+		require.Error(t, err)
+		nc, ok := errors.Cause(err).(*rlp.NonCanonicalError)
+		require.True(t, ok, err.Error())
+		require.Equal(t, c.offset, nc.Offset)
+		require.Equal(t, c.rule, nc.Rule)
+	}
+}
+
 func BenchmarkTransaction_FromRaw_Samples(b *testing.B) {
 	for n := 0; n < b.N; n++ {
 		tx := eth.Transaction{}
@@ -1,5 +1,11 @@
 package eth
 
+import (
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/rlp"
+)
+
 type TransactionReceipt struct {
 	Type              *Quantity `json:"type,omitempty"`
 	TransactionHash   Hash      `json:"transactionHash"`
@@ -26,3 +32,112 @@ func (t *TransactionReceipt) TransactionType() int64 {
 
 	return t.Type.Int64()
 }
+
+// MarshalRLP implements rlp.Marshaler, encoding the consensus fields of the receipt as in the receipts trie.  Like
+// transactions, typed receipts are an RLP string of the type followed by the RLP encoded fields.
+func (t TransactionReceipt) MarshalRLP() (rlp.Item, error) {
+	r := receiptRLP{
+		CumulativeGasUsed: t.CumulativeGasUsed,
+		LogsBloom:         t.LogsBloom,
+		Logs:              make([]receiptLog, len(t.Logs)),
+	}
+
+	switch {
+	case t.Root != nil:
+		r.PostStateOrStatus = Data(*t.Root)
+	case t.Status != nil && t.Status.UInt64() == 1:
+		r.PostStateOrStatus = "0x01"
+	case t.Status != nil && t.Status.UInt64() == 0:
+		r.PostStateOrStatus = ""
+	default:
+		return rlp.Item{}, errors.New("receipt requires a valid status or root")
+	}
+
+	for i := range t.Logs {
+		r.Logs[i] = receiptLog{
+			Address: t.Logs[i].Address,
+			Topics:  t.Logs[i].Topics,
+			Data:    t.Logs[i].Data,
+		}
+	}
+
+	item, err := rlp.MarshalItem(&r)
+	if err != nil {
+		return rlp.Item{}, err
+	}
+
+	if t.TransactionType() == TransactionTypeLegacy {
+		return item, nil
+	}
+
+	return rlp.Bytes(append([]byte{byte(t.TransactionType())}, item.Encode()...)), nil
+}
+
+// UnmarshalRLP implements rlp.Unmarshaler, populating the consensus fields of the receipt, the others being
+// derived from the block and transaction.
+func (t *TransactionReceipt) UnmarshalRLP(item rlp.Item) error {
+	var (
+		r   receiptRLP
+		typ *Quantity
+	)
+
+	if item.IsList() {
+		if err := rlp.UnmarshalItem(item, &r); err != nil {
+			return errors.Wrap(err, "could not decode legacy receipt")
+		}
+	} else {
+		if len(item.Bytes) == 0 || item.Bytes[0] > 0x7f {
+			return errors.New("invalid typed receipt")
+		}
+		if err := rlp.Unmarshal(item.Bytes[1:], &r); err != nil {
+			return errors.Wrap(err, "could not decode typed receipt")
+		}
+		q := QuantityFromInt64(int64(item.Bytes[0]))
+		typ = &q
+	}
+
+	t.Type = typ
+	t.Root, t.Status = nil, nil
+	switch r.PostStateOrStatus {
+	case "", "0x":
+		status := QuantityFromInt64(0)
+		t.Status = &status
+	case "0x01":
+		status := QuantityFromInt64(1)
+		t.Status = &status
+	default:
+		root, err := NewData32(r.PostStateOrStatus.String())
+		if err != nil {
+			return errors.Wrap(err, "invalid receipt status or root")
+		}
+		t.Root = root
+	}
+
+	t.CumulativeGasUsed = r.CumulativeGasUsed
+	t.LogsBloom = r.LogsBloom
+	t.Logs = make([]Log, len(r.Logs))
+	for i := range r.Logs {
+		t.Logs[i] = Log{
+			Address: r.Logs[i].Address,
+			Topics:  r.Logs[i].Topics,
+			Data:    r.Logs[i].Data,
+		}
+	}
+
+	return nil
+}
+
+// receiptRLP is the RLP layout of the consensus fields of receipts
+type receiptRLP struct {
+	PostStateOrStatus Data
+	CumulativeGasUsed Quantity
+	LogsBloom         Data256
+	Logs              []receiptLog
+}
+
+// receiptLog is the RLP layout of logs
+type receiptLog struct {
+	Address Address
+	Topics  []Topic
+	Data    Data
+}
@@ -1,10 +1,13 @@
 package eth_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/rlp"
 	"github.com/stretchr/testify/require"
 )
 
@@ -203,3 +206,57 @@ func TestTransactionReceipts(t *testing.T) {
 	}
 
 }
+
+func TestTransactionReceipt_RLP(t *testing.T) {
+	bloom := eth.Data256("0x" + strings.Repeat("00", 256))
+	status := eth.QuantityFromInt64(1)
+	receipt := eth.TransactionReceipt{
+		CumulativeGasUsed: eth.QuantityFromInt64(21000),
+		LogsBloom:         bloom,
+		Status:            &status,
+		Logs:              []eth.Log{},
+	}
+
+	// rlp([status, cumulativeGasUsed, logsBloom, logs])
+	b, err := rlp.Marshal(receipt)
+	require.NoError(t, err)
+	require.Equal(t, "f9010801825208b90100"+strings.Repeat("00", 256)+"c0", hex.EncodeToString(b))
+
+	decoded := eth.TransactionReceipt{}
+	require.NoError(t, rlp.Unmarshal(b, &decoded))
+	require.Equal(t, receipt, decoded)
+
+	// typed receipts are strings of the type followed by the RLP encoded fields
+	typ := eth.QuantityFromInt64(eth.TransactionTypeDynamicFee)
+	failed := eth.QuantityFromInt64(0)
+	receipt.Type = &typ
+	receipt.Status = &failed
+	receipt.Logs = []eth.Log{{
+		Address: *eth.MustAddress("0x0000000000000000000000000000000000001337"),
+		Topics:  []eth.Topic{"0x0000000000000000000000000000000000000000000000000000000000000001"},
+		Data:    "0xdeadbeef",
+	}}
+	b, err = rlp.Marshal(receipt)
+	require.NoError(t, err)
+	item, err := rlp.Decode(b)
+	require.NoError(t, err)
+	require.False(t, item.IsList())
+	require.Equal(t, byte(0x02), item.Bytes[0])
+
+	decoded = eth.TransactionReceipt{}
+	require.NoError(t, rlp.Unmarshal(b, &decoded))
+	require.Equal(t, receipt, decoded)
+
+	// pre-byzantium receipts have a state root instead of a status
+	root := eth.Data32("0x0000000000000000000000000000000000000000000000000000000000001337")
+	receipt.Type, receipt.Status, receipt.Root = nil, nil, &root
+	b, err = rlp.Marshal(receipt)
+	require.NoError(t, err)
+	decoded = eth.TransactionReceipt{}
+	require.NoError(t, rlp.Unmarshal(b, &decoded))
+	require.Equal(t, receipt, decoded)
+
+	receipt.Root = nil
+	_, err = rlp.Marshal(receipt)
+	require.Error(t, err)
+}
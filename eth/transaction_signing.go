@@ -5,23 +5,15 @@ import (
 	"errors"
 	"strings"
 
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+
 	"github.com/INFURA/go-ethlibs/rlp"
 )
 
 // Sign uses the hex-encoded private key and chainId to update the R, S, and V values
 // for a Transaction, and returns the raw signed transaction or an error.
 func (t *Transaction) Sign(privateKey string, chainId Quantity) (*Data, error) {
-	var (
-		pKey []byte
-		err  error
-	)
-
-	if strings.HasPrefix(privateKey, "0x") && len(privateKey) > 2 {
-		pKey, err = hex.DecodeString(privateKey[2:])
-	} else {
-		pKey, err = hex.DecodeString(privateKey)
-	}
-
+	pKey, err := decodePrivateKey(privateKey)
 	if err != nil {
 		return nil, err
 	}
@@ -209,3 +201,22 @@ func (t *Transaction) IsProtected() bool {
 	}
 	return signature.chainId.Int64() != 0x0
 }
+
+// PrivateKeyAddress returns the address of the account controlled by the hex-encoded private key
+func PrivateKeyAddress(privateKey string) (*Address, error) {
+	pKey, err := decodePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	_, pub := secp256k1.PrivKeyFromBytes(secp256k1.S256(), pKey)
+	return pubKeyBytesToAddress(pub.SerializeUncompressed())
+}
+
+func decodePrivateKey(privateKey string) ([]byte, error) {
+	if strings.HasPrefix(privateKey, "0x") && len(privateKey) > 2 {
+		return hex.DecodeString(privateKey[2:])
+	}
+
+	return hex.DecodeString(privateKey)
+}
@@ -350,3 +350,12 @@ func TestTransaction_Sign_InvalidTxType(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, "unsupported transaction type", err.Error())
 }
+
+func TestPrivateKeyAddress(t *testing.T) {
+	address, err := eth.PrivateKeyAddress("0xfad9c8855b740a0b7ed4c221dbad0f33a83a49cad6b3fe8d5817ac83d38b6a19")
+	require.NoError(t, err)
+	require.Equal(t, "0x96216849c49358B10257cb55b28eA603c874b05E", address.String())
+
+	_, err = eth.PrivateKeyAddress("0xzz")
+	require.Error(t, err)
+}
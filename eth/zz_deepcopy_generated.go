@@ -47,6 +47,126 @@ func (in *AccessListEntry) DeepCopy() *AccessListEntry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountDiff) DeepCopyInto(out *AccountDiff) {
+	*out = *in
+	in.Balance.DeepCopyInto(&out.Balance)
+	in.Nonce.DeepCopyInto(&out.Nonce)
+	in.Code.DeepCopyInto(&out.Code)
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = make(map[Data32]HashDiff, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountDiff.
+func (in *AccountDiff) DeepCopy() *AccountDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountOverride) DeepCopyInto(out *AccountOverride) {
+	*out = *in
+	if in.Nonce != nil {
+		in, out := &in.Nonce, &out.Nonce
+		*out = (*in).DeepCopy()
+	}
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = new(Data)
+		**out = **in
+	}
+	if in.Balance != nil {
+		in, out := &in.Balance, &out.Balance
+		*out = (*in).DeepCopy()
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = make(map[Data32]Data32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.StateDiff != nil {
+		in, out := &in.StateDiff, &out.StateDiff
+		*out = make(map[Data32]Data32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountOverride.
+func (in *AccountOverride) DeepCopy() *AccountOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountResult) DeepCopyInto(out *AccountResult) {
+	*out = *in
+	if in.AccountProof != nil {
+		in, out := &in.AccountProof, &out.AccountProof
+		*out = make([]Data, len(*in))
+		copy(*out, *in)
+	}
+	in.Balance.DeepCopyInto(&out.Balance)
+	in.Nonce.DeepCopyInto(&out.Nonce)
+	if in.StorageProof != nil {
+		in, out := &in.StorageProof, &out.StorageProof
+		*out = make([]StorageProof, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountResult.
+func (in *AccountResult) DeepCopy() *AccountResult {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Authorization) DeepCopyInto(out *Authorization) {
+	*out = *in
+	in.ChainId.DeepCopyInto(&out.ChainId)
+	in.Nonce.DeepCopyInto(&out.Nonce)
+	in.YParity.DeepCopyInto(&out.YParity)
+	in.R.DeepCopyInto(&out.R)
+	in.S.DeepCopyInto(&out.S)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Authorization.
+func (in *Authorization) DeepCopy() *Authorization {
+	if in == nil {
+		return nil
+	}
+	out := new(Authorization)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Block) DeepCopyInto(out *Block) {
 	*out = *in
@@ -81,6 +201,14 @@ func (in *Block) DeepCopyInto(out *Block) {
 		in, out := &in.BaseFeePerGas, &out.BaseFeePerGas
 		*out = (*in).DeepCopy()
 	}
+	if in.BlobGasUsed != nil {
+		in, out := &in.BlobGasUsed, &out.BlobGasUsed
+		*out = (*in).DeepCopy()
+	}
+	if in.ExcessBlobGas != nil {
+		in, out := &in.ExcessBlobGas, &out.ExcessBlobGas
+		*out = (*in).DeepCopy()
+	}
 	if in.Nonce != nil {
 		in, out := &in.Nonce, &out.Nonce
 		*out = new(Data8)
@@ -140,6 +268,56 @@ func (in *BlockNumberOrTag) DeepCopy() *BlockNumberOrTag {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockOverrides) DeepCopyInto(out *BlockOverrides) {
+	*out = *in
+	if in.Number != nil {
+		in, out := &in.Number, &out.Number
+		*out = (*in).DeepCopy()
+	}
+	if in.Difficulty != nil {
+		in, out := &in.Difficulty, &out.Difficulty
+		*out = (*in).DeepCopy()
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+	if in.GasLimit != nil {
+		in, out := &in.GasLimit, &out.GasLimit
+		*out = (*in).DeepCopy()
+	}
+	if in.FeeRecipient != nil {
+		in, out := &in.FeeRecipient, &out.FeeRecipient
+		*out = new(Address)
+		**out = **in
+	}
+	if in.PrevRandao != nil {
+		in, out := &in.PrevRandao, &out.PrevRandao
+		*out = new(Data32)
+		**out = **in
+	}
+	if in.BaseFeePerGas != nil {
+		in, out := &in.BaseFeePerGas, &out.BaseFeePerGas
+		*out = (*in).DeepCopy()
+	}
+	if in.BlobBaseFee != nil {
+		in, out := &in.BlobBaseFee, &out.BlobBaseFee
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockOverrides.
+func (in *BlockOverrides) DeepCopy() *BlockOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockSpecifier) DeepCopyInto(out *BlockSpecifier) {
 	*out = *in
@@ -171,258 +349,1235 @@ func (in *BlockSpecifier) DeepCopy() *BlockSpecifier {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in Condition) DeepCopyInto(out *Condition) {
-	{
-		in := &in
-		*out = make(Condition, len(*in))
-		copy(*out, *in)
-		return
-	}
+func (in *CallAction) DeepCopyInto(out *CallAction) {
+	*out = *in
+	in.Gas.DeepCopyInto(&out.Gas)
+	in.Value.DeepCopyInto(&out.Value)
+	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
-func (in Condition) DeepCopy() Condition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CallAction.
+func (in *CallAction) DeepCopy() *CallAction {
 	if in == nil {
 		return nil
 	}
-	out := new(Condition)
+	out := new(CallAction)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Log) DeepCopyInto(out *Log) {
+func (in *CallFrame) DeepCopyInto(out *CallFrame) {
 	*out = *in
-	if in.LogIndex != nil {
-		in, out := &in.LogIndex, &out.LogIndex
-		*out = (*in).DeepCopy()
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = new(Address)
+		**out = **in
 	}
-	if in.TxIndex != nil {
-		in, out := &in.TxIndex, &out.TxIndex
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
 		*out = (*in).DeepCopy()
 	}
-	if in.TxHash != nil {
-		in, out := &in.TxHash, &out.TxHash
-		*out = new(Data32)
+	in.Gas.DeepCopyInto(&out.Gas)
+	in.GasUsed.DeepCopyInto(&out.GasUsed)
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(Data)
 		**out = **in
 	}
-	if in.BlockHash != nil {
-		in, out := &in.BlockHash, &out.BlockHash
-		*out = new(Data32)
-		**out = **in
+	if in.Calls != nil {
+		in, out := &in.Calls, &out.Calls
+		*out = make([]CallFrame, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.BlockNumber != nil {
-		in, out := &in.BlockNumber, &out.BlockNumber
-		*out = (*in).DeepCopy()
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = make([]CallLog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CallFrame.
+func (in *CallFrame) DeepCopy() *CallFrame {
+	if in == nil {
+		return nil
 	}
+	out := new(CallFrame)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CallLog) DeepCopyInto(out *CallLog) {
+	*out = *in
 	if in.Topics != nil {
 		in, out := &in.Topics, &out.Topics
 		*out = make([]Data32, len(*in))
 		copy(*out, *in)
 	}
-	if in.TxLogIndex != nil {
-		in, out := &in.TxLogIndex, &out.TxLogIndex
+	in.Position.DeepCopyInto(&out.Position)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CallLog.
+func (in *CallLog) DeepCopy() *CallLog {
+	if in == nil {
+		return nil
+	}
+	out := new(CallLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CallMsg) DeepCopyInto(out *CallMsg) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = new(Address)
+		**out = **in
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = new(Address)
+		**out = **in
+	}
+	if in.Gas != nil {
+		in, out := &in.Gas, &out.Gas
 		*out = (*in).DeepCopy()
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(string)
+	if in.GasPrice != nil {
+		in, out := &in.GasPrice, &out.GasPrice
+		*out = (*in).DeepCopy()
+	}
+	if in.MaxFeePerGas != nil {
+		in, out := &in.MaxFeePerGas, &out.MaxFeePerGas
+		*out = (*in).DeepCopy()
+	}
+	if in.MaxPriorityFeePerGas != nil {
+		in, out := &in.MaxPriorityFeePerGas, &out.MaxPriorityFeePerGas
+		*out = (*in).DeepCopy()
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = (*in).DeepCopy()
+	}
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = new(Data)
 		**out = **in
 	}
+	if in.AccessList != nil {
+		in, out := &in.AccessList, &out.AccessList
+		*out = new(AccessList)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]AccessListEntry, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Log.
-func (in *Log) DeepCopy() *Log {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CallMsg.
+func (in *CallMsg) DeepCopy() *CallMsg {
 	if in == nil {
 		return nil
 	}
-	out := new(Log)
+	out := new(CallMsg)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogFilter) DeepCopyInto(out *LogFilter) {
+func (in *CallOverrides) DeepCopyInto(out *CallOverrides) {
 	*out = *in
-	if in.FromBlock != nil {
-		in, out := &in.FromBlock, &out.FromBlock
-		*out = new(BlockNumberOrTag)
-		(*in).DeepCopyInto(*out)
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = make(StateOverride, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
 	}
-	if in.ToBlock != nil {
-		in, out := &in.ToBlock, &out.ToBlock
-		*out = new(BlockNumberOrTag)
+	if in.Block != nil {
+		in, out := &in.Block, &out.Block
+		*out = new(BlockOverrides)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.BlockHash != nil {
-		in, out := &in.BlockHash, &out.BlockHash
-		*out = new(Data32)
-		**out = **in
-	}
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
-		*out = make([]Address, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CallOverrides.
+func (in *CallOverrides) DeepCopy() *CallOverrides {
+	if in == nil {
+		return nil
 	}
-	if in.Topics != nil {
-		in, out := &in.Topics, &out.Topics
-		*out = make([][]Data32, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = make([]Data32, len(*in))
-				copy(*out, *in)
+	out := new(CallOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CallResult) DeepCopyInto(out *CallResult) {
+	*out = *in
+	in.GasUsed.DeepCopyInto(&out.GasUsed)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CallResult.
+func (in *CallResult) DeepCopy() *CallResult {
+	if in == nil {
+		return nil
+	}
+	out := new(CallResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CallTracerConfig) DeepCopyInto(out *CallTracerConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CallTracerConfig.
+func (in *CallTracerConfig) DeepCopy() *CallTracerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CallTracerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Condition) DeepCopyInto(out *Condition) {
+	{
+		in := &in
+		*out = make(Condition, len(*in))
+		copy(*out, *in)
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in Condition) DeepCopy() Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CreateAction) DeepCopyInto(out *CreateAction) {
+	*out = *in
+	in.Gas.DeepCopyInto(&out.Gas)
+	in.Value.DeepCopyInto(&out.Value)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreateAction.
+func (in *CreateAction) DeepCopy() *CreateAction {
+	if in == nil {
+		return nil
+	}
+	out := new(CreateAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CreateResult) DeepCopyInto(out *CreateResult) {
+	*out = *in
+	in.GasUsed.DeepCopyInto(&out.GasUsed)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreateResult.
+func (in *CreateResult) DeepCopy() *CreateResult {
+	if in == nil {
+		return nil
+	}
+	out := new(CreateResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataDiff) DeepCopyInto(out *DataDiff) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = new(Data)
+		**out = **in
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = new(Data)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataDiff.
+func (in *DataDiff) DeepCopy() *DataDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(DataDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeeHistory) DeepCopyInto(out *FeeHistory) {
+	*out = *in
+	in.OldestBlock.DeepCopyInto(&out.OldestBlock)
+	if in.BaseFeePerGas != nil {
+		in, out := &in.BaseFeePerGas, &out.BaseFeePerGas
+		*out = make([]Quantity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GasUsedRatio != nil {
+		in, out := &in.GasUsedRatio, &out.GasUsedRatio
+		*out = make([]float64, len(*in))
+		copy(*out, *in)
+	}
+	if in.Reward != nil {
+		in, out := &in.Reward, &out.Reward
+		*out = make([][]Quantity, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = make([]Quantity, len(*in))
+				for i := range *in {
+					(*in)[i].DeepCopyInto(&(*out)[i])
+				}
+			}
+		}
+	}
+	if in.BaseFeePerBlobGas != nil {
+		in, out := &in.BaseFeePerBlobGas, &out.BaseFeePerBlobGas
+		*out = make([]Quantity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BlobGasUsedRatio != nil {
+		in, out := &in.BlobGasUsedRatio, &out.BlobGasUsedRatio
+		*out = make([]float64, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeeHistory.
+func (in *FeeHistory) DeepCopy() *FeeHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(FeeHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HashDiff) DeepCopyInto(out *HashDiff) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = new(Data32)
+		**out = **in
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = new(Data32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashDiff.
+func (in *HashDiff) DeepCopy() *HashDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(HashDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternalTransfer) DeepCopyInto(out *InternalTransfer) {
+	*out = *in
+	in.Value.DeepCopyInto(&out.Value)
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalTransfer.
+func (in *InternalTransfer) DeepCopy() *InternalTransfer {
+	if in == nil {
+		return nil
+	}
+	out := new(InternalTransfer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Log) DeepCopyInto(out *Log) {
+	*out = *in
+	if in.LogIndex != nil {
+		in, out := &in.LogIndex, &out.LogIndex
+		*out = (*in).DeepCopy()
+	}
+	if in.TxIndex != nil {
+		in, out := &in.TxIndex, &out.TxIndex
+		*out = (*in).DeepCopy()
+	}
+	if in.TxHash != nil {
+		in, out := &in.TxHash, &out.TxHash
+		*out = new(Data32)
+		**out = **in
+	}
+	if in.BlockHash != nil {
+		in, out := &in.BlockHash, &out.BlockHash
+		*out = new(Data32)
+		**out = **in
+	}
+	if in.BlockNumber != nil {
+		in, out := &in.BlockNumber, &out.BlockNumber
+		*out = (*in).DeepCopy()
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]Data32, len(*in))
+		copy(*out, *in)
+	}
+	if in.TxLogIndex != nil {
+		in, out := &in.TxLogIndex, &out.TxLogIndex
+		*out = (*in).DeepCopy()
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Log.
+func (in *Log) DeepCopy() *Log {
+	if in == nil {
+		return nil
+	}
+	out := new(Log)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogFilter) DeepCopyInto(out *LogFilter) {
+	*out = *in
+	if in.FromBlock != nil {
+		in, out := &in.FromBlock, &out.FromBlock
+		*out = new(BlockNumberOrTag)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ToBlock != nil {
+		in, out := &in.ToBlock, &out.ToBlock
+		*out = new(BlockNumberOrTag)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlockHash != nil {
+		in, out := &in.BlockHash, &out.BlockHash
+		*out = new(Data32)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = make([]Address, len(*in))
+		copy(*out, *in)
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([][]Data32, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = make([]Data32, len(*in))
+				copy(*out, *in)
 			}
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogFilter.
-func (in *LogFilter) DeepCopy() *LogFilter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogFilter.
+func (in *LogFilter) DeepCopy() *LogFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(LogFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogsNotificationParams) DeepCopyInto(out *LogsNotificationParams) {
+	*out = *in
+	in.Result.DeepCopyInto(&out.Result)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogsNotificationParams.
+func (in *LogsNotificationParams) DeepCopy() *LogsNotificationParams {
+	if in == nil {
+		return nil
+	}
+	out := new(LogsNotificationParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewHeadsNotificationParams) DeepCopyInto(out *NewHeadsNotificationParams) {
+	*out = *in
+	in.Result.DeepCopyInto(&out.Result)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewHeadsNotificationParams.
+func (in *NewHeadsNotificationParams) DeepCopy() *NewHeadsNotificationParams {
+	if in == nil {
+		return nil
+	}
+	out := new(NewHeadsNotificationParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewHeadsResult) DeepCopyInto(out *NewHeadsResult) {
+	*out = *in
+	in.Number.DeepCopyInto(&out.Number)
+	in.Difficulty.DeepCopyInto(&out.Difficulty)
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = (*in).DeepCopy()
+	}
+	in.GasLimit.DeepCopyInto(&out.GasLimit)
+	in.GasUsed.DeepCopyInto(&out.GasUsed)
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	if in.BaseFeePerGas != nil {
+		in, out := &in.BaseFeePerGas, &out.BaseFeePerGas
+		*out = (*in).DeepCopy()
+	}
+	if in.Nonce != nil {
+		in, out := &in.Nonce, &out.Nonce
+		*out = new(Data8)
+		**out = **in
+	}
+	if in.MixHash != nil {
+		in, out := &in.MixHash, &out.MixHash
+		*out = new(Data)
+		**out = **in
+	}
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		*out = new(string)
+		**out = **in
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(string)
+		**out = **in
+	}
+	if in.SealFields != nil {
+		in, out := &in.SealFields, &out.SealFields
+		*out = new([]Data)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]Data, len(*in))
+			copy(*out, *in)
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewHeadsResult.
+func (in *NewHeadsResult) DeepCopy() *NewHeadsResult {
+	if in == nil {
+		return nil
+	}
+	out := new(NewHeadsResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewPendingTxBodyNotificationParams) DeepCopyInto(out *NewPendingTxBodyNotificationParams) {
+	*out = *in
+	in.Result.DeepCopyInto(&out.Result)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewPendingTxBodyNotificationParams.
+func (in *NewPendingTxBodyNotificationParams) DeepCopy() *NewPendingTxBodyNotificationParams {
+	if in == nil {
+		return nil
+	}
+	out := new(NewPendingTxBodyNotificationParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewPendingTxNotificationParams) DeepCopyInto(out *NewPendingTxNotificationParams) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewPendingTxNotificationParams.
+func (in *NewPendingTxNotificationParams) DeepCopy() *NewPendingTxNotificationParams {
+	if in == nil {
+		return nil
+	}
+	out := new(NewPendingTxNotificationParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Prestate) DeepCopyInto(out *Prestate) {
+	{
+		in := &in
+		*out = make(Prestate, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Prestate.
+func (in Prestate) DeepCopy() Prestate {
+	if in == nil {
+		return nil
+	}
+	out := new(Prestate)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrestateAccount) DeepCopyInto(out *PrestateAccount) {
+	*out = *in
+	if in.Balance != nil {
+		in, out := &in.Balance, &out.Balance
+		*out = (*in).DeepCopy()
+	}
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = new(Data)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = make(map[Data32]Data32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrestateAccount.
+func (in *PrestateAccount) DeepCopy() *PrestateAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(PrestateAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrestateDiff) DeepCopyInto(out *PrestateDiff) {
+	*out = *in
+	if in.Pre != nil {
+		in, out := &in.Pre, &out.Pre
+		*out = make(Prestate, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Post != nil {
+		in, out := &in.Post, &out.Post
+		*out = make(Prestate, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrestateDiff.
+func (in *PrestateDiff) DeepCopy() *PrestateDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(PrestateDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrestateTracerConfig) DeepCopyInto(out *PrestateTracerConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrestateTracerConfig.
+func (in *PrestateTracerConfig) DeepCopy() *PrestateTracerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrestateTracerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Quantity.
+func (in *Quantity) DeepCopy() *Quantity {
+	if in == nil {
+		return nil
+	}
+	out := new(Quantity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantityDiff) DeepCopyInto(out *QuantityDiff) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = (*in).DeepCopy()
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantityDiff.
+func (in *QuantityDiff) DeepCopy() *QuantityDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantityDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RewardAction) DeepCopyInto(out *RewardAction) {
+	*out = *in
+	in.Value.DeepCopyInto(&out.Value)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RewardAction.
+func (in *RewardAction) DeepCopy() *RewardAction {
+	if in == nil {
+		return nil
+	}
+	out := new(RewardAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Signature) DeepCopyInto(out *Signature) {
+	*out = *in
+	in.r.DeepCopyInto(&out.r)
+	in.s.DeepCopyInto(&out.s)
+	in.v.DeepCopyInto(&out.v)
+	in.chainId.DeepCopyInto(&out.chainId)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Signature.
+func (in *Signature) DeepCopy() *Signature {
+	if in == nil {
+		return nil
+	}
+	out := new(Signature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in StateDiff) DeepCopyInto(out *StateDiff) {
+	{
+		in := &in
+		*out = make(StateDiff, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateDiff.
+func (in StateDiff) DeepCopy() StateDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(StateDiff)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in StateOverride) DeepCopyInto(out *StateOverride) {
+	{
+		in := &in
+		*out = make(StateOverride, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateOverride.
+func (in StateOverride) DeepCopy() StateOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(StateOverride)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageProof) DeepCopyInto(out *StorageProof) {
+	*out = *in
+	in.Value.DeepCopyInto(&out.Value)
+	if in.Proof != nil {
+		in, out := &in.Proof, &out.Proof
+		*out = make([]Data, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageProof.
+func (in *StorageProof) DeepCopy() *StorageProof {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageProof)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StructLog) DeepCopyInto(out *StructLog) {
+	*out = *in
+	if in.Stack != nil {
+		in, out := &in.Stack, &out.Stack
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StructLog.
+func (in *StructLog) DeepCopy() *StructLog {
+	if in == nil {
+		return nil
+	}
+	out := new(StructLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StructLogConfig) DeepCopyInto(out *StructLogConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StructLogConfig.
+func (in *StructLogConfig) DeepCopy() *StructLogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StructLogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StructLogResult) DeepCopyInto(out *StructLogResult) {
+	*out = *in
+	if in.StructLogs != nil {
+		in, out := &in.StructLogs, &out.StructLogs
+		*out = make([]StructLog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StructLogResult.
+func (in *StructLogResult) DeepCopy() *StructLogResult {
+	if in == nil {
+		return nil
+	}
+	out := new(StructLogResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuicideAction) DeepCopyInto(out *SuicideAction) {
+	*out = *in
+	in.Balance.DeepCopyInto(&out.Balance)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuicideAction.
+func (in *SuicideAction) DeepCopy() *SuicideAction {
+	if in == nil {
+		return nil
+	}
+	out := new(SuicideAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncStatus) DeepCopyInto(out *SyncStatus) {
+	*out = *in
+	in.StartingBlock.DeepCopyInto(&out.StartingBlock)
+	in.CurrentBlock.DeepCopyInto(&out.CurrentBlock)
+	in.HighestBlock.DeepCopyInto(&out.HighestBlock)
+	if in.PulledStates != nil {
+		in, out := &in.PulledStates, &out.PulledStates
+		*out = (*in).DeepCopy()
+	}
+	if in.KnownStates != nil {
+		in, out := &in.KnownStates, &out.KnownStates
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncStatus.
+func (in *SyncStatus) DeepCopy() *SyncStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LogFilter)
+	out := new(SyncStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NewHeadsNotificationParams) DeepCopyInto(out *NewHeadsNotificationParams) {
+func (in *SyncingNotificationParams) DeepCopyInto(out *SyncingNotificationParams) {
 	*out = *in
 	in.Result.DeepCopyInto(&out.Result)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewHeadsNotificationParams.
-func (in *NewHeadsNotificationParams) DeepCopy() *NewHeadsNotificationParams {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncingNotificationParams.
+func (in *SyncingNotificationParams) DeepCopy() *SyncingNotificationParams {
 	if in == nil {
 		return nil
 	}
-	out := new(NewHeadsNotificationParams)
+	out := new(SyncingNotificationParams)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NewHeadsResult) DeepCopyInto(out *NewHeadsResult) {
+func (in *Trace) DeepCopyInto(out *Trace) {
 	*out = *in
-	in.Number.DeepCopyInto(&out.Number)
-	in.Difficulty.DeepCopyInto(&out.Difficulty)
-	if in.Size != nil {
-		in, out := &in.Size, &out.Size
-		*out = (*in).DeepCopy()
+	if in.Call != nil {
+		in, out := &in.Call, &out.Call
+		*out = new(CallAction)
+		(*in).DeepCopyInto(*out)
 	}
-	in.GasLimit.DeepCopyInto(&out.GasLimit)
-	in.GasUsed.DeepCopyInto(&out.GasUsed)
-	in.Timestamp.DeepCopyInto(&out.Timestamp)
-	if in.BaseFeePerGas != nil {
-		in, out := &in.BaseFeePerGas, &out.BaseFeePerGas
-		*out = (*in).DeepCopy()
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = new(CreateAction)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Nonce != nil {
-		in, out := &in.Nonce, &out.Nonce
-		*out = new(Data8)
+	if in.Suicide != nil {
+		in, out := &in.Suicide, &out.Suicide
+		*out = new(SuicideAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reward != nil {
+		in, out := &in.Reward, &out.Reward
+		*out = new(RewardAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CallResult != nil {
+		in, out := &in.CallResult, &out.CallResult
+		*out = new(CallResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreateResult != nil {
+		in, out := &in.CreateResult, &out.CreateResult
+		*out = new(CreateResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TraceAddress != nil {
+		in, out := &in.TraceAddress, &out.TraceAddress
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlockHash != nil {
+		in, out := &in.BlockHash, &out.BlockHash
+		*out = new(Data32)
 		**out = **in
 	}
-	if in.MixHash != nil {
-		in, out := &in.MixHash, &out.MixHash
-		*out = new(Data)
+	if in.BlockNumber != nil {
+		in, out := &in.BlockNumber, &out.BlockNumber
+		*out = new(uint64)
 		**out = **in
 	}
-	if in.Step != nil {
-		in, out := &in.Step, &out.Step
-		*out = new(string)
+	if in.TransactionHash != nil {
+		in, out := &in.TransactionHash, &out.TransactionHash
+		*out = new(Data32)
 		**out = **in
 	}
-	if in.Signature != nil {
-		in, out := &in.Signature, &out.Signature
-		*out = new(string)
+	if in.TransactionPosition != nil {
+		in, out := &in.TransactionPosition, &out.TransactionPosition
+		*out = new(uint64)
 		**out = **in
 	}
-	if in.SealFields != nil {
-		in, out := &in.SealFields, &out.SealFields
-		*out = new([]Data)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make([]Data, len(*in))
-			copy(*out, *in)
-		}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Trace.
+func (in *Trace) DeepCopy() *Trace {
+	if in == nil {
+		return nil
+	}
+	out := new(Trace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceCallConfig) DeepCopyInto(out *TraceCallConfig) {
+	*out = *in
+	in.TraceConfig.DeepCopyInto(&out.TraceConfig)
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(CallOverrides)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewHeadsResult.
-func (in *NewHeadsResult) DeepCopy() *NewHeadsResult {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceCallConfig.
+func (in *TraceCallConfig) DeepCopy() *TraceCallConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(NewHeadsResult)
+	out := new(TraceCallConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NewPendingTxBodyNotificationParams) DeepCopyInto(out *NewPendingTxBodyNotificationParams) {
+func (in *TraceConfig) DeepCopyInto(out *TraceConfig) {
 	*out = *in
-	in.Result.DeepCopyInto(&out.Result)
+	if in.StructLogs != nil {
+		in, out := &in.StructLogs, &out.StructLogs
+		*out = new(StructLogConfig)
+		**out = **in
+	}
+	if in.CallTracer != nil {
+		in, out := &in.CallTracer, &out.CallTracer
+		*out = new(CallTracerConfig)
+		**out = **in
+	}
+	if in.PrestateTracer != nil {
+		in, out := &in.PrestateTracer, &out.PrestateTracer
+		*out = new(PrestateTracerConfig)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewPendingTxBodyNotificationParams.
-func (in *NewPendingTxBodyNotificationParams) DeepCopy() *NewPendingTxBodyNotificationParams {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceConfig.
+func (in *TraceConfig) DeepCopy() *TraceConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(NewPendingTxBodyNotificationParams)
+	out := new(TraceConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NewPendingTxNotificationParams) DeepCopyInto(out *NewPendingTxNotificationParams) {
+func (in *TraceFilter) DeepCopyInto(out *TraceFilter) {
 	*out = *in
+	if in.FromBlock != nil {
+		in, out := &in.FromBlock, &out.FromBlock
+		*out = new(BlockNumberOrTag)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ToBlock != nil {
+		in, out := &in.ToBlock, &out.ToBlock
+		*out = new(BlockNumberOrTag)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FromAddress != nil {
+		in, out := &in.FromAddress, &out.FromAddress
+		*out = make([]Address, len(*in))
+		copy(*out, *in)
+	}
+	if in.ToAddress != nil {
+		in, out := &in.ToAddress, &out.ToAddress
+		*out = make([]Address, len(*in))
+		copy(*out, *in)
+	}
+	if in.After != nil {
+		in, out := &in.After, &out.After
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(uint64)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewPendingTxNotificationParams.
-func (in *NewPendingTxNotificationParams) DeepCopy() *NewPendingTxNotificationParams {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceFilter.
+func (in *TraceFilter) DeepCopy() *TraceFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(NewPendingTxNotificationParams)
+	out := new(TraceFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Quantity.
-func (in *Quantity) DeepCopy() *Quantity {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceReplay) DeepCopyInto(out *TraceReplay) {
+	*out = *in
+	if in.Trace != nil {
+		in, out := &in.Trace, &out.Trace
+		*out = make([]Trace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VMTrace != nil {
+		in, out := &in.VMTrace, &out.VMTrace
+		*out = new(VMTrace)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StateDiff != nil {
+		in, out := &in.StateDiff, &out.StateDiff
+		*out = make(StateDiff, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.TransactionHash != nil {
+		in, out := &in.TransactionHash, &out.TransactionHash
+		*out = new(Data32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceReplay.
+func (in *TraceReplay) DeepCopy() *TraceReplay {
 	if in == nil {
 		return nil
 	}
-	out := new(Quantity)
+	out := new(TraceReplay)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Signature) DeepCopyInto(out *Signature) {
+func (in *TraceResult) DeepCopyInto(out *TraceResult) {
 	*out = *in
-	in.r.DeepCopyInto(&out.r)
-	in.s.DeepCopyInto(&out.s)
-	in.v.DeepCopyInto(&out.v)
-	in.chainId.DeepCopyInto(&out.chainId)
+	if in.StructLogs != nil {
+		in, out := &in.StructLogs, &out.StructLogs
+		*out = new(StructLogResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CallFrame != nil {
+		in, out := &in.CallFrame, &out.CallFrame
+		*out = new(CallFrame)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prestate != nil {
+		in, out := &in.Prestate, &out.Prestate
+		*out = make(Prestate, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.PrestateDiff != nil {
+		in, out := &in.PrestateDiff, &out.PrestateDiff
+		*out = new(PrestateDiff)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Signature.
-func (in *Signature) DeepCopy() *Signature {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceResult.
+func (in *TraceResult) DeepCopy() *TraceResult {
 	if in == nil {
 		return nil
 	}
-	out := new(Signature)
+	out := new(TraceResult)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -513,6 +1668,22 @@ func (in *Transaction) DeepCopyInto(out *Transaction) {
 			}
 		}
 	}
+	if in.MaxFeePerBlobGas != nil {
+		in, out := &in.MaxFeePerBlobGas, &out.MaxFeePerBlobGas
+		*out = (*in).DeepCopy()
+	}
+	if in.BlobVersionedHashes != nil {
+		in, out := &in.BlobVersionedHashes, &out.BlobVersionedHashes
+		*out = make([]Data32, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuthorizationList != nil {
+		in, out := &in.AuthorizationList, &out.AuthorizationList
+		*out = make([]Authorization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -580,6 +1751,32 @@ func (in *TransactionReceipt) DeepCopy() *TransactionReceipt {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransactionTrace) DeepCopyInto(out *TransactionTrace) {
+	*out = *in
+	if in.TxHash != nil {
+		in, out := &in.TxHash, &out.TxHash
+		*out = new(Data32)
+		**out = **in
+	}
+	if in.Result != nil {
+		in, out := &in.Result, &out.Result
+		*out = new(TraceResult)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransactionTrace.
+func (in *TransactionTrace) DeepCopy() *TransactionTrace {
+	if in == nil {
+		return nil
+	}
+	out := new(TransactionTrace)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TxOrHash) DeepCopyInto(out *TxOrHash) {
 	*out = *in
@@ -674,3 +1871,119 @@ func (in *Uncle) DeepCopy() *Uncle {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMExecuted) DeepCopyInto(out *VMExecuted) {
+	*out = *in
+	if in.Push != nil {
+		in, out := &in.Push, &out.Push
+		*out = make([]Quantity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Mem != nil {
+		in, out := &in.Mem, &out.Mem
+		*out = new(VMMemoryDiff)
+		**out = **in
+	}
+	if in.Store != nil {
+		in, out := &in.Store, &out.Store
+		*out = new(VMStorageDiff)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMExecuted.
+func (in *VMExecuted) DeepCopy() *VMExecuted {
+	if in == nil {
+		return nil
+	}
+	out := new(VMExecuted)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMMemoryDiff) DeepCopyInto(out *VMMemoryDiff) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMMemoryDiff.
+func (in *VMMemoryDiff) DeepCopy() *VMMemoryDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(VMMemoryDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMOperation) DeepCopyInto(out *VMOperation) {
+	*out = *in
+	if in.Ex != nil {
+		in, out := &in.Ex, &out.Ex
+		*out = new(VMExecuted)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sub != nil {
+		in, out := &in.Sub, &out.Sub
+		*out = new(VMTrace)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMOperation.
+func (in *VMOperation) DeepCopy() *VMOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(VMOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMStorageDiff) DeepCopyInto(out *VMStorageDiff) {
+	*out = *in
+	in.Key.DeepCopyInto(&out.Key)
+	in.Val.DeepCopyInto(&out.Val)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMStorageDiff.
+func (in *VMStorageDiff) DeepCopy() *VMStorageDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(VMStorageDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMTrace) DeepCopyInto(out *VMTrace) {
+	*out = *in
+	if in.Ops != nil {
+		in, out := &in.Ops, &out.Ops
+		*out = make([]VMOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMTrace.
+func (in *VMTrace) DeepCopy() *VMTrace {
+	if in == nil {
+		return nil
+	}
+	out := new(VMTrace)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,522 @@
+package node
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+)
+
+// BalancerStrategy decides which of the healthy endpoints of a balanced client receives a request.
+type BalancerStrategy int
+
+const (
+	// StrategyRoundRobin cycles through the healthy endpoints in turn
+	StrategyRoundRobin BalancerStrategy = iota
+	// StrategyWeighted picks a healthy endpoint at random, proportionally to its Weight
+	StrategyWeighted
+	// StrategyPrimaryBackup always uses the first healthy endpoint in the order they were supplied
+	StrategyPrimaryBackup
+)
+
+var ErrNoEndpoints = errors.New("no endpoints available")
+
+// Endpoint is one of the underlying clients of a balanced client.
+type Endpoint struct {
+	Client Client
+
+	// Weight is only used by StrategyWeighted, endpoints with a Weight of 0 are treated as having a Weight of 1
+	Weight int
+}
+
+// BalancerConfig controls the behavior of a client created by NewBalancedClient.
+type BalancerConfig struct {
+	Strategy BalancerStrategy
+
+	// HealthCheckInterval is how often each endpoint is polled with eth_blockNumber, defaults to 15 seconds
+	HealthCheckInterval time.Duration
+
+	// HealthCheckTimeout bounds each individual health check, defaults to 5 seconds
+	HealthCheckTimeout time.Duration
+
+	// MaxBlockLag excludes endpoints whose head is more than MaxBlockLag blocks behind the highest head
+	// seen across all endpoints.  A zero value disables lag detection.
+	MaxBlockLag uint64
+}
+
+// NewBalancedClient returns a Client which spreads requests over several underlying clients according to the
+// configured strategy.  The health of every endpoint is tracked by polling eth_blockNumber until ctx is done,
+// and endpoints that fail or fall too far behind are excluded until they recover.  Requests failing with a
+// transport or HTTP error are failed over to the next healthy endpoint, and transport errors and 5xx responses
+// also exclude the endpoint.  Subscriptions are pinned to a single healthy
+// endpoint and transparently re-created on another one if that endpoint fails.
+func NewBalancedClient(ctx context.Context, endpoints []Endpoint, config BalancerConfig, opts ...Option) (Client, error) {
+	if len(endpoints) == 0 {
+		return nil, ErrNoEndpoints
+	}
+
+	if config.HealthCheckInterval <= 0 {
+		config.HealthCheckInterval = 15 * time.Second
+	}
+
+	if config.HealthCheckTimeout <= 0 {
+		config.HealthCheckTimeout = 5 * time.Second
+	}
+
+	b := balancer{
+		ctx:           ctx,
+		config:        config,
+		subscriptions: make(map[*balancedSubscription]struct{}),
+	}
+
+	for i := range endpoints {
+		if endpoints[i].Client == nil {
+			return nil, errors.Errorf("endpoint %d has no client", i)
+		}
+
+		weight := endpoints[i].Weight
+		if weight <= 0 {
+			weight = 1
+		}
+
+		b.endpoints = append(b.endpoints, &endpointState{
+			client:  endpoints[i].Client,
+			weight:  weight,
+			healthy: true,
+		})
+	}
+
+	b.checkHealth()
+	go b.loop()
+
+	o := newOptions(opts)
+	return &client{
+		transport: o.wrap(&b),
+		rawURL:    "",
+	}, nil
+}
+
+type endpointState struct {
+	client  Client
+	weight  int
+	healthy bool
+	head    uint64
+}
+
+type balancer struct {
+	ctx       context.Context
+	config    BalancerConfig
+	endpoints []*endpointState
+	counter   uint64
+
+	// mu guards the health fields of endpoints and the subscriptions map
+	mu            sync.RWMutex
+	subscriptions map[*balancedSubscription]struct{}
+}
+
+func (b *balancer) loop() {
+	ticker := time.NewTicker(b.config.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.ctx.Done():
+			return
+		case <-ticker.C:
+			b.checkHealth()
+		}
+	}
+}
+
+// checkHealth polls every endpoint concurrently and then updates their health
+func (b *balancer) checkHealth() {
+	type result struct {
+		head uint64
+		err  error
+	}
+
+	results := make([]result, len(b.endpoints))
+	wg := sync.WaitGroup{}
+	for i := range b.endpoints {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(b.ctx, b.config.HealthCheckTimeout)
+			defer cancel()
+			results[i].head, results[i].err = b.endpoints[i].client.BlockNumber(ctx)
+		}(i)
+	}
+	wg.Wait()
+
+	highest := uint64(0)
+	for i := range results {
+		if results[i].err == nil && results[i].head > highest {
+			highest = results[i].head
+		}
+	}
+
+	b.mu.Lock()
+	for i, e := range b.endpoints {
+		e.healthy = results[i].err == nil
+		if e.healthy {
+			e.head = results[i].head
+			if b.config.MaxBlockLag > 0 && e.head+b.config.MaxBlockLag < highest {
+				e.healthy = false
+			}
+		}
+	}
+	b.mu.Unlock()
+
+	b.moveSubscriptions()
+}
+
+// markUnhealthy excludes an endpoint until the next health check succeeds
+func (b *balancer) markUnhealthy(e *endpointState) {
+	b.mu.Lock()
+	e.healthy = false
+	b.mu.Unlock()
+
+	b.moveSubscriptions()
+}
+
+// candidates returns the endpoints to try for a request in order of preference, only considering endpoints
+// which support subscriptions if subscriptions is true.  If every endpoint is unhealthy they're all returned.
+func (b *balancer) candidates(subscriptions bool) []*endpointState {
+	b.mu.RLock()
+	healthy := make([]*endpointState, 0, len(b.endpoints))
+	unhealthy := make([]*endpointState, 0)
+	for _, e := range b.endpoints {
+		if subscriptions && !e.client.SupportsSubscriptions() {
+			continue
+		}
+
+		if e.healthy {
+			healthy = append(healthy, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+	b.mu.RUnlock()
+
+	if len(healthy) == 0 {
+		return unhealthy
+	}
+
+	switch b.config.Strategy {
+	case StrategyRoundRobin:
+		n := int(atomic.AddUint64(&b.counter, 1) % uint64(len(healthy)))
+		ordered := make([]*endpointState, 0, len(healthy))
+		ordered = append(ordered, healthy[n:]...)
+		return append(ordered, healthy[:n]...)
+	case StrategyWeighted:
+		total := 0
+		for _, e := range healthy {
+			total += e.weight
+		}
+
+		pick := rand.Intn(total)
+		for i, e := range healthy {
+			if pick < e.weight {
+				healthy[0], healthy[i] = healthy[i], healthy[0]
+				break
+			}
+			pick -= e.weight
+		}
+		return healthy
+	default:
+		return healthy
+	}
+}
+
+func (b *balancer) Request(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+	err := ErrNoEndpoints
+	for _, e := range b.candidates(false) {
+		var response *jsonrpc.RawResponse
+		response, err = e.client.Request(ctx, r)
+		if err == nil {
+			return response, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, err
+		}
+
+		if isEndpointFailure(err) {
+			b.markUnhealthy(e)
+		}
+	}
+
+	return nil, err
+}
+
+// isEndpointFailure reports whether err means the endpoint itself is failing, which is the case for transport
+// errors and 5xx responses but not for other HTTP errors such as rate limiting
+func isEndpointFailure(err error) bool {
+	if httpErr, ok := errors.Cause(err).(*HTTPError); ok {
+		return httpErr.StatusCode >= 500
+	}
+
+	return true
+}
+
+func (b *balancer) Subscribe(ctx context.Context, r *jsonrpc.Request) (Subscription, error) {
+	owned, err := copyRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s := balancedSubscription{
+		balancer:        b,
+		request:         &owned,
+		notificationsCh: make(chan *jsonrpc.Notification),
+		moveCh:          make(chan struct{}, 1),
+		doneCh:          make(chan struct{}),
+	}
+
+	if err := s.subscribe(ctx); err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	b.subscriptions[&s] = struct{}{}
+	b.mu.Unlock()
+
+	go s.loop()
+	return &s, nil
+}
+
+func (b *balancer) IsBidirectional() bool {
+	for _, e := range b.endpoints {
+		if e.client.IsBidirectional() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *balancer) SupportsSubscriptions() bool {
+	for _, e := range b.endpoints {
+		if e.client.SupportsSubscriptions() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// moveSubscriptions signals every subscription pinned to an unhealthy endpoint that it should move
+func (b *balancer) moveSubscriptions() {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for s := range b.subscriptions {
+		if e := s.pinned(); e != nil && !e.healthy {
+			select {
+			case s.moveCh <- struct{}{}:
+			default:
+				// a move is already pending
+			}
+		}
+	}
+}
+
+// balancedSubscription forwards the notifications of a subscription pinned to one endpoint, and re-creates
+// that subscription on another endpoint whenever the pinned endpoint fails.
+type balancedSubscription struct {
+	balancer *balancer
+	request  *jsonrpc.Request
+
+	mu       sync.RWMutex
+	current  Subscription
+	endpoint *endpointState
+
+	// torndown is the last subscription that was unsubscribed, which mustn't be unsubscribed again
+	torndown Subscription
+
+	notificationsCh chan *jsonrpc.Notification
+	moveCh          chan struct{}
+	doneCh          chan struct{}
+	once            sync.Once
+}
+
+func (s *balancedSubscription) Response() *jsonrpc.RawResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.current.Response()
+}
+
+// ID returns the subscription ID on the currently pinned endpoint, which changes when the subscription moves
+func (s *balancedSubscription) ID() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.current.ID()
+}
+
+func (s *balancedSubscription) Ch() <-chan *jsonrpc.Notification {
+	return s.notificationsCh
+}
+
+func (s *balancedSubscription) Unsubscribe(ctx context.Context) error {
+	s.once.Do(func() {
+		close(s.doneCh)
+	})
+
+	s.balancer.mu.Lock()
+	delete(s.balancer.subscriptions, s)
+	s.balancer.mu.Unlock()
+
+	if current := s.teardown(); current != nil {
+		return current.Unsubscribe(ctx)
+	}
+
+	return nil
+}
+
+// teardown returns the current subscription to be unsubscribed, or nil if it already was
+func (s *balancedSubscription) teardown() Subscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.current == s.torndown {
+		return nil
+	}
+
+	s.torndown = s.current
+	return s.current
+}
+
+// unsubscribeCurrent unsubscribes the current subscription unless it already was, which is needed when it
+// replaced the one torn down by Unsubscribe
+func (s *balancedSubscription) unsubscribeCurrent() {
+	if current := s.teardown(); current != nil {
+		ctx, cancel := context.WithTimeout(s.balancer.ctx, s.balancer.config.HealthCheckTimeout)
+		defer cancel()
+		_ = current.Unsubscribe(ctx)
+	}
+}
+
+func (s *balancedSubscription) pinned() *endpointState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.endpoint
+}
+
+// subscribe creates the subscription on the first healthy endpoint that accepts it
+func (s *balancedSubscription) subscribe(ctx context.Context) error {
+	err := errors.Wrap(ErrNoEndpoints, "no endpoint supports subscriptions")
+	for _, e := range s.balancer.candidates(true) {
+		var sub Subscription
+		sub, err = e.client.Subscribe(ctx, s.request)
+		if err != nil {
+			if ctx.Err() != nil {
+				return err
+			}
+			continue
+		}
+
+		s.mu.Lock()
+		s.current = sub
+		s.endpoint = e
+		s.mu.Unlock()
+		return nil
+	}
+
+	return err
+}
+
+// move abandons the current subscription and keeps trying to re-subscribe until it succeeds or is stopped
+func (s *balancedSubscription) move() bool {
+	if old := s.teardown(); old != nil {
+		go func() {
+			ctx, cancel := context.WithTimeout(s.balancer.ctx, s.balancer.config.HealthCheckTimeout)
+			defer cancel()
+			_ = old.Unsubscribe(ctx)
+		}()
+	}
+
+	for {
+		ctx, cancel := context.WithTimeout(s.balancer.ctx, s.balancer.config.HealthCheckTimeout)
+		err := s.subscribe(ctx)
+		cancel()
+		if err == nil {
+			select {
+			case <-s.doneCh:
+				// unsubscribed while re-subscribing, so nothing else will tear down the new subscription
+				s.unsubscribeCurrent()
+				return false
+			default:
+				return true
+			}
+		}
+
+		select {
+		case <-s.doneCh:
+			return false
+		case <-s.balancer.ctx.Done():
+			return false
+		case <-time.After(s.balancer.config.HealthCheckInterval):
+		}
+	}
+}
+
+func (s *balancedSubscription) loop() {
+	defer close(s.notificationsCh)
+
+	for {
+		s.mu.RLock()
+		ch := s.current.Ch()
+		s.mu.RUnlock()
+
+		select {
+		case n, ok := <-ch:
+			if !ok {
+				select {
+				case <-s.doneCh:
+					s.unsubscribeCurrent()
+					return
+				default:
+				}
+
+				// the underlying subscription ended without being unsubscribed, so its endpoint has failed
+				s.balancer.markUnhealthy(s.pinned())
+				if !s.move() {
+					return
+				}
+				continue
+			}
+
+			select {
+			case s.notificationsCh <- n:
+			case <-s.doneCh:
+				s.unsubscribeCurrent()
+				return
+			}
+		case <-s.moveCh:
+			if e := s.pinned(); e != nil && s.isHealthy(e) {
+				// the endpoint recovered before we got around to moving
+				continue
+			}
+
+			if !s.move() {
+				return
+			}
+		case <-s.doneCh:
+			s.unsubscribeCurrent()
+			return
+		case <-s.balancer.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *balancedSubscription) isHealthy(e *endpointState) bool {
+	s.balancer.mu.RLock()
+	defer s.balancer.mu.RUnlock()
+	return e.healthy
+}
@@ -0,0 +1,254 @@
+package node_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+)
+
+// fakeEndpoint is a client reporting a fixed head which can be switched to failing
+type fakeEndpoint struct {
+	name     string
+	head     uint64
+	failing  int32
+	requests int32
+
+	// limited is the number of requests still to be answered with a 429
+	limited int32
+
+	// block holds back subscribing until it's closed, if set
+	block chan struct{}
+
+	mu   sync.Mutex
+	subs []*fakeSubscription
+}
+
+func (f *fakeEndpoint) client(t *testing.T, bidirectional bool) node.Client {
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		if atomic.LoadInt32(&f.failing) == 1 {
+			return nil, errors.New("connection refused")
+		}
+
+		if r.Method != "eth_blockNumber" && atomic.AddInt32(&f.limited, -1) >= 0 {
+			return nil, &node.HTTPError{StatusCode: http.StatusTooManyRequests}
+		}
+
+		if r.Method == "eth_blockNumber" {
+			q := eth.QuantityFromUInt64(f.head)
+			return nodetest.Result(r, `"`+q.String()+`"`), nil
+		}
+
+		atomic.AddInt32(&f.requests, 1)
+		return nodetest.Result(r, `"`+f.name+`"`), nil
+	})
+
+	var subscriber node.Subscriber
+	if bidirectional {
+		subscriber = nodetest.SubscriberFunc(func(ctx context.Context, r *jsonrpc.Request) (node.Subscription, error) {
+			if atomic.LoadInt32(&f.failing) == 1 {
+				return nil, errors.New("connection refused")
+			}
+
+			if f.block != nil {
+				<-f.block
+			}
+
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			sub := &fakeSubscription{id: f.name, ch: make(chan *jsonrpc.Notification)}
+			f.subs = append(f.subs, sub)
+			return sub, nil
+		})
+	}
+
+	c := nodetest.NewClient(t, requester, subscriber)
+	return c
+}
+
+func (f *fakeEndpoint) lastSubscription() *fakeSubscription {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.subs) == 0 {
+		return nil
+	}
+	return f.subs[len(f.subs)-1]
+}
+
+// waitFor polls cond until it returns true, failing the test after a second
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestBalancedClient_Strategies(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := &fakeEndpoint{name: "a", head: 100}
+	b := &fakeEndpoint{name: "b", head: 100}
+	lagging := &fakeEndpoint{name: "lagging", head: 50}
+
+	endpoints := []node.Endpoint{{Client: a.client(t, false)}, {Client: b.client(t, false)}, {Client: lagging.client(t, false)}}
+
+	roundRobin, err := node.NewBalancedClient(ctx, endpoints, node.BalancerConfig{
+		Strategy:    node.StrategyRoundRobin,
+		MaxBlockLag: 10,
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		_, err := roundRobin.ChainId(ctx)
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(5), atomic.LoadInt32(&a.requests))
+	require.Equal(t, int32(5), atomic.LoadInt32(&b.requests))
+	require.Equal(t, int32(0), atomic.LoadInt32(&lagging.requests), "lagging endpoint should be excluded")
+
+	primary, err := node.NewBalancedClient(ctx, endpoints, node.BalancerConfig{Strategy: node.StrategyPrimaryBackup})
+	require.NoError(t, err)
+
+	id, err := primary.ChainId(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "a", id)
+
+	// failover to the backup when the primary fails
+	atomic.StoreInt32(&a.failing, 1)
+	id, err = primary.ChainId(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "b", id)
+
+	weighted, err := node.NewBalancedClient(ctx, []node.Endpoint{
+		{Client: b.client(t, false), Weight: 1},
+		{Client: lagging.client(t, false), Weight: 0},
+	}, node.BalancerConfig{Strategy: node.StrategyWeighted})
+	require.NoError(t, err)
+
+	_, err = weighted.ChainId(ctx)
+	require.NoError(t, err)
+}
+
+func TestBalancedClient_RateLimited(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := &fakeEndpoint{name: "a", head: 100, limited: 1}
+	b := &fakeEndpoint{name: "b", head: 100}
+
+	client, err := node.NewBalancedClient(ctx, []node.Endpoint{{Client: a.client(t, false)}, {Client: b.client(t, false)}}, node.BalancerConfig{
+		Strategy: node.StrategyPrimaryBackup,
+	})
+	require.NoError(t, err)
+
+	id, err := client.ChainId(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "b", id)
+
+	// being rate limited doesn't make the primary unhealthy
+	id, err = client.ChainId(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "a", id)
+}
+
+func TestBalancedClient_SubscriptionMoves(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := &fakeEndpoint{name: "a", head: 100}
+	b := &fakeEndpoint{name: "b", head: 100}
+
+	client, err := node.NewBalancedClient(ctx, []node.Endpoint{{Client: a.client(t, true)}, {Client: b.client(t, true)}}, node.BalancerConfig{
+		Strategy:            node.StrategyPrimaryBackup,
+		HealthCheckInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.True(t, client.IsBidirectional())
+
+	sub, err := client.SubscribeNewHeads(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "a", sub.ID())
+
+	a.lastSubscription().ch <- &jsonrpc.Notification{Method: "eth_subscription", Params: []byte(`"from a"`)}
+	n := <-sub.Ch()
+	require.Equal(t, `"from a"`, string(n.Params))
+
+	// endpoint a goes down, which the health check picks up and moves the subscription to b
+	atomic.StoreInt32(&a.failing, 1)
+	waitFor(t, func() bool {
+		return b.lastSubscription() != nil
+	})
+
+	b.lastSubscription().ch <- &jsonrpc.Notification{Method: "eth_subscription", Params: []byte(`"from b"`)}
+	n = <-sub.Ch()
+	require.Equal(t, `"from b"`, string(n.Params))
+	require.Equal(t, "b", sub.ID())
+
+	require.NoError(t, sub.Unsubscribe(ctx))
+	_, ok := <-sub.Ch()
+	require.False(t, ok)
+}
+
+func TestBalancedClient_UnsubscribeWhileMoving(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := &fakeEndpoint{name: "a", head: 100}
+	b := &fakeEndpoint{name: "b", head: 100, block: make(chan struct{})}
+
+	client, err := node.NewBalancedClient(ctx, []node.Endpoint{{Client: a.client(t, true)}, {Client: b.client(t, true)}}, node.BalancerConfig{
+		Strategy:            node.StrategyPrimaryBackup,
+		HealthCheckInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	sub, err := client.SubscribeNewHeads(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "a", sub.ID())
+
+	// the subscription starts moving to b, and is unsubscribed before b accepts it
+	atomic.StoreInt32(&a.failing, 1)
+	waitFor(t, func() bool {
+		select {
+		case _, ok := <-a.lastSubscription().ch:
+			return !ok
+		default:
+			return false
+		}
+	})
+
+	require.NoError(t, sub.Unsubscribe(ctx))
+	close(b.block)
+
+	waitFor(t, func() bool {
+		moved := b.lastSubscription()
+		if moved == nil {
+			return false
+		}
+
+		select {
+		case _, ok := <-moved.ch:
+			return !ok
+		default:
+			return false
+		}
+	})
+
+	_, ok := <-sub.Ch()
+	require.False(t, ok)
+}
@@ -0,0 +1,169 @@
+package node_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+)
+
+const revertReason = "0x08c379a0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000106e6f7420656e6f7567682066756e647300000000000000000000000000000000"
+
+func TestClient_Call(t *testing.T) {
+	ctx := context.Background()
+
+	var params jsonrpc.Params
+	var response func(r *jsonrpc.Request) *jsonrpc.RawResponse
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		require.Equal(t, "eth_call", r.Method)
+		params = r.Params
+		return response(r), nil
+	})
+
+	client := nodetest.NewClient(t, requester, nil)
+
+	to := eth.MustAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+	input := eth.Data("0x70a08231000000000000000000000000a94f5374fce5edbc8e2a8697c15331677e6ebf0b")
+	msg := eth.NewCallMsg(&eth.Transaction{To: to, Input: input})
+	latest := eth.MustBlockSpecifier("latest")
+
+	t.Run("no overrides", func(t *testing.T) {
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Result(r, `"0x000000000000000000000000000000000000000000000000000000000000002a"`)
+		}
+
+		out, err := client.Call(ctx, msg, *latest, nil)
+		require.NoError(t, err)
+		require.Equal(t, eth.Data("0x000000000000000000000000000000000000000000000000000000000000002a"), out)
+		require.Len(t, params, 2)
+		require.JSONEq(t, `{"to":"0x6b175474e89094c44da98b954eedeac495271d0f","data":"`+input.String()+`"}`, string(params[0]))
+		require.JSONEq(t, `"latest"`, string(params[1]))
+	})
+
+	t.Run("by hash with overrides", func(t *testing.T) {
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Result(r, `"0x"`)
+		}
+
+		block := eth.MustBlockSpecifier(map[string]interface{}{
+			"blockHash":        "0xd4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3",
+			"requireCanonical": true,
+		})
+
+		balance := eth.QuantityFromUInt64(1000)
+		code := eth.Data("0x6000")
+		number := eth.QuantityFromUInt64(100)
+		slot := eth.Data32("0x0000000000000000000000000000000000000000000000000000000000000001")
+		value := eth.Data32("0x00000000000000000000000000000000000000000000000000000000000000ff")
+		overrides := eth.CallOverrides{
+			State: eth.StateOverride{
+				*to: {Balance: &balance, Code: &code, StateDiff: map[eth.Data32]eth.Data32{slot: value}},
+			},
+			Block: &eth.BlockOverrides{Number: &number},
+		}
+
+		out, err := client.Call(ctx, msg, *block, &overrides)
+		require.NoError(t, err)
+		require.Equal(t, eth.Data("0x"), out)
+		require.Len(t, params, 4)
+		require.JSONEq(t, `{"blockHash":"0xd4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3","requireCanonical":true}`, string(params[1]))
+		require.JSONEq(t, `{"0x6B175474E89094C44Da98b954EedeAC495271d0F":{"balance":"0x3e8","code":"0x6000","stateDiff":{"`+string(slot)+`":"`+string(value)+`"}}}`, string(params[2]))
+		require.JSONEq(t, `{"number":"0x64"}`, string(params[3]))
+
+		// block overrides alone still send an (empty) state override set
+		_, err = client.Call(ctx, msg, *latest, &eth.CallOverrides{Block: &eth.BlockOverrides{Number: &number}})
+		require.NoError(t, err)
+		require.Len(t, params, 4)
+		require.JSONEq(t, `{}`, string(params[2]))
+
+		overrides.State[*to] = eth.AccountOverride{State: map[eth.Data32]eth.Data32{}, StateDiff: map[eth.Data32]eth.Data32{}}
+		_, err = client.Call(ctx, msg, *latest, &overrides)
+		require.Error(t, err)
+	})
+
+	t.Run("reverts", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			error  string
+			reason string
+			data   string
+		}{
+			{"geth", `{"code":3,"message":"execution reverted: not enough funds","data":"` + revertReason + `"}`, "not enough funds", revertReason},
+			{"nethermind", `{"code":-32015,"message":"VM execution error.","data":"Reverted ` + revertReason + `"}`, "not enough funds", revertReason},
+			{"no data", `{"code":-32000,"message":"execution reverted"}`, "", "0x"},
+			{"panic", `{"code":3,"message":"execution reverted","data":"0x4e487b710000000000000000000000000000000000000000000000000000000000000011"}`, "", "0x4e487b710000000000000000000000000000000000000000000000000000000000000011"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+					return nodetest.Error(r, tt.error)
+				}
+
+				_, err := client.Call(ctx, msg, *latest, nil)
+				require.Error(t, err)
+
+				revert, ok := errors.Cause(err).(*node.RevertError)
+				require.True(t, ok, "expected a revert error, got %v", err)
+				require.Equal(t, eth.Data(tt.data), revert.Data)
+
+				reason, ok := revert.Reason()
+				require.Equal(t, tt.reason != "", ok)
+				require.Equal(t, tt.reason, reason)
+			})
+		}
+
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Error(r, `{"code":3,"message":"execution reverted","data":"0x4e487b710000000000000000000000000000000000000000000000000000000000000011"}`)
+		}
+		_, err := client.Call(ctx, msg, *latest, nil)
+		code, ok := err.(*node.RevertError).PanicCode()
+		require.True(t, ok)
+		require.Equal(t, uint64(0x11), code)
+
+		// other errors aren't reverts
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Error(r, `{"code":-32000,"message":"header not found"}`)
+		}
+		_, err = client.Call(ctx, msg, *latest, nil)
+		require.Error(t, err)
+		_, ok = err.(*node.RevertError)
+		require.False(t, ok)
+	})
+}
+
+func TestRevertError_Reason(t *testing.T) {
+	word := func(hex string) string {
+		return strings.Repeat("0", 64-len(hex)) + hex
+	}
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"empty", ""},
+		{"offset out of range", "0x08c379a0" + word("40") + word("10")},
+		{"offset overflows", "0x08c379a0" + word("ffffffffffffffe0") + word("10")},
+		{"length out of range", "0x08c379a0" + word("20") + word("21")},
+		{"length overflows", "0x08c379a0" + word("20") + word("ffffffffffffffff")},
+		{"length too large", "0x08c379a0" + word("20") + word("1"+strings.Repeat("0", 32))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revert := node.RevertError{Data: eth.Data(tt.data)}
+			_, ok := revert.Reason()
+			require.False(t, ok)
+			_, ok = revert.PanicCode()
+			require.False(t, ok)
+			require.Contains(t, revert.Error(), "execution reverted")
+		})
+	}
+}
@@ -19,7 +19,10 @@ var (
 
 var _ Client = (*client)(nil)
 
-func NewClient(ctx context.Context, rawURL string) (Client, error) {
+// NewClient creates a Client connected to rawURL, the transport (HTTP, websocket or IPC) is chosen based on the URL scheme.
+func NewClient(ctx context.Context, rawURL string, opts ...Option) (Client, error) {
+	o := newOptions(opts)
+
 	parsedURL, err := url.Parse(rawURL)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not parse url")
@@ -29,9 +32,9 @@ func NewClient(ctx context.Context, rawURL string) (Client, error) {
 
 	switch parsedURL.Scheme {
 	case "http", "https":
-		transport, err = newHTTPTransport(ctx, parsedURL)
+		transport, err = newHTTPTransport(ctx, parsedURL, o)
 	case "wss", "ws":
-		transport, err = newWebsocketTransport(ctx, parsedURL)
+		transport, err = newWebsocketTransport(ctx, parsedURL, o)
 	default:
 		transport, err = newIPCTransport(ctx, parsedURL)
 	}
@@ -40,20 +43,28 @@ func NewClient(ctx context.Context, rawURL string) (Client, error) {
 		return nil, errors.Wrap(err, "could not create client transport")
 	}
 
+	wrapped := o.wrap(transport)
+	if h, ok := transport.(*httpTransport); ok {
+		// emulated subscriptions poll through the interceptors, so that retries, metrics etc. apply to them too
+		h.requester = wrapped
+	}
+
 	return &client{
-		transport: transport,
+		transport: wrapped,
 		rawURL:    rawURL,
 	}, nil
 }
 
-func NewCustomClient(requester Requester, subscriber Subscriber) (Client, error) {
+// NewCustomClient creates a Client which sends requests via requester and subscriptions via subscriber, which may be nil.
+func NewCustomClient(requester Requester, subscriber Subscriber, opts ...Option) (Client, error) {
+	o := newOptions(opts)
 	t, err := newCustomTransport(requester, subscriber)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create custom transport")
 	}
 
 	return &client{
-		transport: t,
+		transport: o.wrap(t),
 		rawURL:    "",
 	}, nil
 }
@@ -63,6 +74,7 @@ type transport interface {
 	Subscriber
 
 	IsBidirectional() bool
+	SupportsSubscriptions() bool
 }
 
 type client struct {
@@ -82,6 +94,10 @@ func (c *client) IsBidirectional() bool {
 	return c.transport.IsBidirectional()
 }
 
+func (c *client) SupportsSubscriptions() bool {
+	return c.transport.SupportsSubscriptions()
+}
+
 func (c *client) URL() string {
 	return c.rawURL
 }
@@ -137,6 +153,32 @@ func (c *client) GetTransactionCount(ctx context.Context, address eth.Address, n
 	return q.UInt64(), err
 }
 
+func (c *client) GetBalance(ctx context.Context, address eth.Address, numberOrTag eth.BlockNumberOrTag) (eth.Quantity, error) {
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "eth_getBalance",
+		Params: jsonrpc.MustParams(address, &numberOrTag),
+	}
+
+	applyContext(ctx, &request)
+	response, err := c.Request(ctx, &request)
+	if err != nil {
+		return eth.Quantity{}, errors.Wrap(err, "could not make request")
+	}
+
+	if response.Error != nil {
+		return eth.Quantity{}, errors.New(string(*response.Error))
+	}
+
+	q := eth.Quantity{}
+	err = json.Unmarshal(response.Result, &q)
+	if err != nil {
+		return eth.Quantity{}, errors.Wrap(err, "could not decode result")
+	}
+
+	return q, nil
+}
+
 func (c *client) NetVersion(ctx context.Context) (string, error) {
 	request := jsonrpc.Request{
 		ID:     jsonrpc.ID{Num: 1},
@@ -240,6 +282,329 @@ func (c *client) EstimateGas(ctx context.Context, msg eth.Transaction) (uint64,
 	return q.UInt64(), err
 }
 
+func (c *client) Call(ctx context.Context, msg eth.CallMsg, block eth.BlockSpecifier, overrides *eth.CallOverrides) (eth.Data, error) {
+	params := []interface{}{msg, &block}
+	if overrides != nil {
+		if err := checkStateOverride(overrides.State); err != nil {
+			return "", err
+		}
+
+		if overrides.State != nil || overrides.Block != nil {
+			state := overrides.State
+			if state == nil {
+				state = eth.StateOverride{}
+			}
+			params = append(params, state)
+		}
+
+		if overrides.Block != nil {
+			params = append(params, overrides.Block)
+		}
+	}
+
+	p, err := jsonrpc.MakeParams(params...)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid call params")
+	}
+
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "eth_call",
+		Params: p,
+	}
+
+	applyContext(ctx, &request)
+	response, err := c.Request(ctx, &request)
+	if err != nil {
+		return "", errors.Wrap(err, "could not make request")
+	}
+
+	if revert := revertError(response); revert != nil {
+		return "", revert
+	}
+
+	if response.Error != nil {
+		return "", errors.New(string(*response.Error))
+	}
+
+	d := eth.Data("")
+	err = json.Unmarshal(response.Result, &d)
+	if err != nil {
+		return "", errors.Wrap(err, "could not decode result")
+	}
+
+	return d, nil
+}
+
+// checkStateOverride returns an error if an account of state overrides both its state and its state diff
+func checkStateOverride(state eth.StateOverride) error {
+	for address, override := range state {
+		if override.State != nil && override.StateDiff != nil {
+			return errors.Errorf("state and stateDiff overrides of %s are mutually exclusive", address.String())
+		}
+	}
+
+	return nil
+}
+
+func (c *client) TraceTransaction(ctx context.Context, hash string, config eth.TraceConfig) (*eth.TraceResult, error) {
+	h, err := eth.NewHash(hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid hash")
+	}
+
+	p, err := jsonrpc.MakeParams(h, config)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid trace params")
+	}
+
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "debug_traceTransaction",
+		Params: p,
+	}
+
+	applyContext(ctx, &request)
+	response, err := c.Request(ctx, &request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not make request")
+	}
+
+	if response.Error != nil {
+		return nil, errors.New(string(*response.Error))
+	}
+
+	if len(response.Result) == 0 || bytes.Equal(response.Result, json.RawMessage(`null`)) {
+		return nil, ErrTransactionNotFound
+	}
+
+	return eth.DecodeTraceResult(config, response.Result)
+}
+
+func (c *client) TraceCall(ctx context.Context, msg eth.CallMsg, block eth.BlockSpecifier, config eth.TraceCallConfig) (*eth.TraceResult, error) {
+	if config.Overrides != nil {
+		if err := checkStateOverride(config.Overrides.State); err != nil {
+			return nil, err
+		}
+	}
+
+	p, err := jsonrpc.MakeParams(msg, &block, config)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid trace params")
+	}
+
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "debug_traceCall",
+		Params: p,
+	}
+
+	applyContext(ctx, &request)
+	response, err := c.Request(ctx, &request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not make request")
+	}
+
+	if response.Error != nil {
+		return nil, errors.New(string(*response.Error))
+	}
+
+	return eth.DecodeTraceResult(config.TraceConfig, response.Result)
+}
+
+func (c *client) TraceBlockByNumber(ctx context.Context, numberOrTag eth.BlockNumberOrTag, config eth.TraceConfig) ([]eth.TransactionTrace, error) {
+	p, err := jsonrpc.MakeParams(&numberOrTag, config)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid trace params")
+	}
+
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "debug_traceBlockByNumber",
+		Params: p,
+	}
+
+	applyContext(ctx, &request)
+	response, err := c.Request(ctx, &request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not make request")
+	}
+
+	if response.Error != nil {
+		return nil, errors.New(string(*response.Error))
+	}
+
+	if len(response.Result) == 0 || bytes.Equal(response.Result, json.RawMessage(`null`)) {
+		return nil, ErrBlockNotFound
+	}
+
+	raw := make([]struct {
+		TxHash *eth.Hash       `json:"txHash"`
+		Result json.RawMessage `json:"result"`
+		Error  string          `json:"error"`
+	}, 0)
+	err = json.Unmarshal(response.Result, &raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode traces result")
+	}
+
+	traces := make([]eth.TransactionTrace, len(raw))
+	for i := range raw {
+		traces[i] = eth.TransactionTrace{TxHash: raw[i].TxHash, Error: raw[i].Error}
+		if len(raw[i].Result) == 0 || bytes.Equal(raw[i].Result, json.RawMessage(`null`)) {
+			continue
+		}
+
+		traces[i].Result, err = eth.DecodeTraceResult(config, raw[i].Result)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode trace of transaction %d", i)
+		}
+	}
+
+	return traces, nil
+}
+
+func (c *client) TransactionTraces(ctx context.Context, hash string) ([]eth.Trace, error) {
+	h, err := eth.NewHash(hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid hash")
+	}
+
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "trace_transaction",
+		Params: jsonrpc.MustParams(h),
+	}
+
+	traces, err := c.requestTraces(ctx, &request)
+	if err == errNullResult {
+		return nil, ErrTransactionNotFound
+	}
+
+	return traces, err
+}
+
+func (c *client) BlockTraces(ctx context.Context, numberOrTag eth.BlockNumberOrTag) ([]eth.Trace, error) {
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "trace_block",
+		Params: jsonrpc.MustParams(&numberOrTag),
+	}
+
+	traces, err := c.requestTraces(ctx, &request)
+	if err == errNullResult {
+		return nil, ErrBlockNotFound
+	}
+
+	return traces, err
+}
+
+func (c *client) FilterTraces(ctx context.Context, filter eth.TraceFilter) ([]eth.Trace, error) {
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "trace_filter",
+		Params: jsonrpc.MustParams(filter),
+	}
+
+	traces, err := c.requestTraces(ctx, &request)
+	if err == errNullResult {
+		return []eth.Trace{}, nil
+	}
+
+	return traces, err
+}
+
+// errNullResult is returned by requestTraces when the result is null, which each method maps to its own error
+var errNullResult = errors.New("null result")
+
+func (c *client) requestTraces(ctx context.Context, request *jsonrpc.Request) ([]eth.Trace, error) {
+	applyContext(ctx, request)
+	response, err := c.Request(ctx, request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not make request")
+	}
+
+	if response.Error != nil {
+		return nil, errors.New(string(*response.Error))
+	}
+
+	if len(response.Result) == 0 || bytes.Equal(response.Result, json.RawMessage(`null`)) {
+		return nil, errNullResult
+	}
+
+	traces := make([]eth.Trace, 0)
+	err = json.Unmarshal(response.Result, &traces)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode traces result")
+	}
+
+	return traces, nil
+}
+
+func (c *client) ReplayBlockTransactions(ctx context.Context, numberOrTag eth.BlockNumberOrTag, options []eth.TraceOption) ([]eth.TraceReplay, error) {
+	if len(options) == 0 {
+		return nil, errors.New("at least one trace option is required")
+	}
+
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "trace_replayBlockTransactions",
+		Params: jsonrpc.MustParams(&numberOrTag, options),
+	}
+
+	applyContext(ctx, &request)
+	response, err := c.Request(ctx, &request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not make request")
+	}
+
+	if response.Error != nil {
+		return nil, errors.New(string(*response.Error))
+	}
+
+	if len(response.Result) == 0 || bytes.Equal(response.Result, json.RawMessage(`null`)) {
+		return nil, ErrBlockNotFound
+	}
+
+	replays := make([]eth.TraceReplay, 0)
+	err = json.Unmarshal(response.Result, &replays)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode replays result")
+	}
+
+	return replays, nil
+}
+
+func (c *client) ReplayCall(ctx context.Context, msg eth.CallMsg, options []eth.TraceOption, numberOrTag eth.BlockNumberOrTag) (*eth.TraceReplay, error) {
+	if len(options) == 0 {
+		return nil, errors.New("at least one trace option is required")
+	}
+
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "trace_call",
+		Params: jsonrpc.MustParams(msg, options, &numberOrTag),
+	}
+
+	applyContext(ctx, &request)
+	response, err := c.Request(ctx, &request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not make request")
+	}
+
+	if response.Error != nil {
+		return nil, errors.New(string(*response.Error))
+	}
+
+	replay := eth.TraceReplay{}
+	err = json.Unmarshal(response.Result, &replay)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode replay result")
+	}
+
+	return &replay, nil
+}
+
 func (c *client) SendRawTransaction(ctx context.Context, msg string) (string, error) {
 	request := jsonrpc.Request{
 		ID:     jsonrpc.ID{Num: 1},
@@ -309,6 +674,36 @@ func (c *client) GasPrice(ctx context.Context) (uint64, error) {
 	return q.UInt64(), err
 }
 
+func (c *client) FeeHistory(ctx context.Context, blockCount uint64, newestBlock eth.BlockNumberOrTag, rewardPercentiles []float64) (*eth.FeeHistory, error) {
+	if rewardPercentiles == nil {
+		rewardPercentiles = []float64{}
+	}
+
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "eth_feeHistory",
+		Params: jsonrpc.MustParams(eth.QuantityFromUInt64(blockCount), &newestBlock, rewardPercentiles),
+	}
+
+	applyContext(ctx, &request)
+	response, err := c.Request(ctx, &request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not make request")
+	}
+
+	if response.Error != nil {
+		return nil, errors.New(string(*response.Error))
+	}
+
+	history := eth.FeeHistory{}
+	err = json.Unmarshal(response.Result, &history)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode result")
+	}
+
+	return &history, nil
+}
+
 func (c *client) BlockByNumberOrTag(ctx context.Context, numberOrTag eth.BlockNumberOrTag, full bool) (*eth.Block, error) {
 	request := jsonrpc.Request{
 		ID:     jsonrpc.ID{Num: 1},
@@ -346,6 +741,41 @@ func (c *client) BlockByHash(ctx context.Context, hash string, full bool) (*eth.
 	return c.parseBlockResponse(response)
 }
 
+func (c *client) UncleByBlockHashAndIndex(ctx context.Context, hash string, index uint64) (*eth.Uncle, error) {
+	h, err := eth.NewHash(hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid hash")
+	}
+
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "eth_getUncleByBlockHashAndIndex",
+		Params: jsonrpc.MustParams(h, eth.QuantityFromUInt64(index)),
+	}
+
+	applyContext(ctx, &request)
+	response, err := c.Request(ctx, &request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not make request")
+	}
+
+	if response.Error != nil {
+		return nil, errors.New(string(*response.Error))
+	}
+
+	if len(response.Result) == 0 || bytes.Equal(response.Result, json.RawMessage(`null`)) {
+		return nil, ErrBlockNotFound
+	}
+
+	uncle := eth.Uncle{}
+	err = json.Unmarshal(response.Result, &uncle)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode uncle result")
+	}
+
+	return &uncle, nil
+}
+
 func (c *client) parseBlockResponse(response *jsonrpc.RawResponse) (*eth.Block, error) {
 	if response.Error != nil {
 		return nil, errors.New(string(*response.Error))
@@ -385,7 +815,7 @@ func (c *client) TransactionReceipt(ctx context.Context, hash string) (*eth.Tran
 
 	if len(response.Result) == 0 || bytes.Equal(response.Result, json.RawMessage(`null`)) {
 		// Then the transaction isn't recognized
-		return nil, errors.Errorf("receipt for transaction %s not found", hash)
+		return nil, errors.Wrapf(ErrTransactionNotFound, "no receipt for transaction %s", hash)
 	}
 
 	receipt := eth.TransactionReceipt{}
@@ -397,6 +827,41 @@ func (c *client) TransactionReceipt(ctx context.Context, hash string) (*eth.Tran
 	return &receipt, nil
 }
 
+func (c *client) GetProof(ctx context.Context, address eth.Address, storageKeys []eth.Data32, block eth.BlockSpecifier) (*eth.AccountResult, error) {
+	if storageKeys == nil {
+		storageKeys = []eth.Data32{}
+	}
+
+	params, err := jsonrpc.MakeParams(address, storageKeys, &block)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid proof params")
+	}
+
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "eth_getProof",
+		Params: params,
+	}
+
+	applyContext(ctx, &request)
+	response, err := c.Request(ctx, &request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not make request")
+	}
+
+	if response.Error != nil {
+		return nil, errors.New(string(*response.Error))
+	}
+
+	result := eth.AccountResult{}
+	err = json.Unmarshal(response.Result, &result)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode result")
+	}
+
+	return &result, nil
+}
+
 func (c *client) Logs(ctx context.Context, filter eth.LogFilter) ([]eth.Log, error) {
 	request := jsonrpc.Request{
 		ID:     jsonrpc.ID{Num: 1},
@@ -475,6 +940,40 @@ func (c *client) SubscribeNewPendingTransactions(ctx context.Context) (Subscript
 	return c.Subscribe(ctx, &request)
 }
 
+func (c *client) SubscribeLogs(ctx context.Context, filter eth.LogFilter) (LogsSubscription, error) {
+	request := jsonrpc.Request{
+		JSONRPC: "2.0",
+		ID:      jsonrpc.ID{Str: "logs", IsString: true},
+		Method:  "eth_subscribe",
+		Params:  jsonrpc.MustParams("logs", filter),
+	}
+
+	applyContext(ctx, &request)
+	sub, err := c.Subscribe(ctx, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	return newLogsSubscription(sub), nil
+}
+
+func (c *client) SubscribeSyncing(ctx context.Context) (SyncingSubscription, error) {
+	request := jsonrpc.Request{
+		JSONRPC: "2.0",
+		ID:      jsonrpc.ID{Str: "syncing", IsString: true},
+		Method:  "eth_subscribe",
+		Params:  jsonrpc.MustParams("syncing"),
+	}
+
+	applyContext(ctx, &request)
+	sub, err := c.Subscribe(ctx, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSyncingSubscription(sub), nil
+}
+
 func applyContext(ctx context.Context, request *jsonrpc.Request) {
 	if id := requestIDFromContext(ctx); id != nil {
 		request.ID = *id
@@ -29,6 +29,10 @@ func (t *customTransport) IsBidirectional() bool {
 	return t.subscriber != nil
 }
 
+func (t *customTransport) SupportsSubscriptions() bool {
+	return t.subscriber != nil
+}
+
 func newCustomTransport(requester Requester, subscriber Subscriber) (*customTransport, error) {
 	t := customTransport{
 		requester:  requester,
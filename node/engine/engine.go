@@ -0,0 +1,178 @@
+// Package engine implements a client for the engine API, which consensus clients use to drive execution clients.
+package engine
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+)
+
+// Client calls engine API methods through an authenticated node.Client.
+type Client struct {
+	client node.Client
+}
+
+// New returns an engine API Client on top of an existing node.Client, which must already be
+// authenticated, for example by creating it with node.WithTokenSource(NewJWTTokenSource(secret)).
+func New(client node.Client) *Client {
+	return &Client{client: client}
+}
+
+// Dial connects to the authenticated engine API endpoint of an execution client (usually on port 8551),
+// signing every request with a JWT derived from secret.  See LoadJWTSecret to read the secret from a file.
+func Dial(ctx context.Context, rawURL string, secret []byte, opts ...node.Option) (*Client, error) {
+	if len(secret) != JWTSecretLength {
+		return nil, errors.Errorf("invalid JWT secret length %d, expected %d bytes", len(secret), JWTSecretLength)
+	}
+
+	opts = append([]node.Option{node.WithTokenSource(NewJWTTokenSource(secret))}, opts...)
+	client, err := node.NewClient(ctx, rawURL, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(client), nil
+}
+
+// Node returns the underlying node.Client, which can be used for the eth_ methods also served by the engine API endpoint
+func (c *Client) Node() node.Client {
+	return c.client
+}
+
+// ExchangeCapabilities sends the list of engine API methods supported by the caller and returns those supported by
+// the execution client.
+func (c *Client) ExchangeCapabilities(ctx context.Context, capabilities []string) ([]string, error) {
+	result := make([]string, 0)
+	err := c.call(ctx, &result, "engine_exchangeCapabilities", capabilities)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ForkchoiceUpdatedV3 updates the execution client's view of the head, safe and finalized blocks, and starts
+// building a payload if attributes is not nil.
+func (c *Client) ForkchoiceUpdatedV3(ctx context.Context, state ForkchoiceState, attributes *PayloadAttributes) (*ForkchoiceUpdatedResult, error) {
+	if attributes != nil && attributes.Withdrawals == nil {
+		a := *attributes
+		a.Withdrawals = make([]Withdrawal, 0)
+		attributes = &a
+	}
+
+	result := ForkchoiceUpdatedResult{}
+	err := c.call(ctx, &result, "engine_forkchoiceUpdatedV3", state, attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// NewPayloadV3 asks the execution client to validate and import a Cancun execution payload.
+func (c *Client) NewPayloadV3(ctx context.Context, payload ExecutionPayload, versionedHashes []eth.Hash, parentBeaconBlockRoot eth.Hash) (*PayloadStatus, error) {
+	payload = normalizePayload(payload)
+	if versionedHashes == nil {
+		versionedHashes = make([]eth.Hash, 0)
+	}
+
+	result := PayloadStatus{}
+	err := c.call(ctx, &result, "engine_newPayloadV3", payload, versionedHashes, parentBeaconBlockRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// NewPayloadV4 asks the execution client to validate and import a Prague execution payload, along with
+// its EIP-7685 execution requests.
+func (c *Client) NewPayloadV4(ctx context.Context, payload ExecutionPayload, versionedHashes []eth.Hash, parentBeaconBlockRoot eth.Hash, executionRequests []eth.Data) (*PayloadStatus, error) {
+	payload = normalizePayload(payload)
+	if versionedHashes == nil {
+		versionedHashes = make([]eth.Hash, 0)
+	}
+
+	if executionRequests == nil {
+		executionRequests = make([]eth.Data, 0)
+	}
+
+	result := PayloadStatus{}
+	err := c.call(ctx, &result, "engine_newPayloadV4", payload, versionedHashes, parentBeaconBlockRoot, executionRequests)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetPayloadV3 retrieves a Cancun payload previously started by ForkchoiceUpdatedV3.
+func (c *Client) GetPayloadV3(ctx context.Context, id PayloadID) (*GetPayloadResult, error) {
+	result := GetPayloadResult{}
+	err := c.call(ctx, &result, "engine_getPayloadV3", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetPayloadV4 retrieves a Prague payload previously started by ForkchoiceUpdatedV3, including its execution requests.
+func (c *Client) GetPayloadV4(ctx context.Context, id PayloadID) (*GetPayloadResult, error) {
+	result := GetPayloadResult{}
+	err := c.call(ctx, &result, "engine_getPayloadV4", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// call sends a request and decodes its result into result.  JSONRPC errors are returned as *jsonrpc.Error
+// so that callers can inspect the engine API specific error codes.
+func (c *Client) call(ctx context.Context, result interface{}, method string, params ...interface{}) error {
+	p, err := jsonrpc.MakeParams(params...)
+	if err != nil {
+		return errors.Wrap(err, "could not encode params")
+	}
+
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: method,
+		Params: p,
+	}
+
+	response, err := c.client.Request(ctx, &request)
+	if err != nil {
+		return errors.Wrap(err, "could not make request")
+	}
+
+	if e := node.ResponseError(response); e != nil {
+		return e
+	}
+
+	err = json.Unmarshal(response.Result, result)
+	if err != nil {
+		return errors.Wrap(err, "could not decode result")
+	}
+
+	return nil
+}
+
+// normalizePayload makes sure lists that must always be present aren't encoded as null
+func normalizePayload(payload ExecutionPayload) ExecutionPayload {
+	if payload.Transactions == nil {
+		payload.Transactions = make([]eth.Data, 0)
+	}
+
+	if payload.Withdrawals == nil {
+		payload.Withdrawals = make([]Withdrawal, 0)
+	}
+
+	return payload
+}
@@ -0,0 +1,134 @@
+package engine_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node/engine"
+)
+
+func newEngineServer(t *testing.T, handle func(r *jsonrpc.Request) string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "Bearer ") || strings.Count(auth, ".") != 2 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		request := jsonrpc.Request{}
+		require.NoError(t, json.Unmarshal(body, &request))
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,` + handle(&request) + `}`))
+	}))
+}
+
+func TestClient_ForkchoiceUpdatedV3(t *testing.T) {
+	ctx := context.Background()
+	secret, err := engine.ParseJWTSecret(testSecret)
+	require.NoError(t, err)
+
+	server := newEngineServer(t, func(r *jsonrpc.Request) string {
+		require.Equal(t, "engine_forkchoiceUpdatedV3", r.Method)
+		require.Len(t, r.Params, 2)
+
+		attributes := engine.PayloadAttributes{}
+		require.NoError(t, json.Unmarshal(r.Params[1], &attributes))
+		require.NotNil(t, attributes.Withdrawals, "withdrawals must be an empty list, not null")
+		require.Contains(t, string(r.Params[1]), `"withdrawals":[]`)
+
+		return `"result":{"payloadStatus":{"status":"VALID","latestValidHash":"0x3559e851470f6e7bbed1db474980683e8c315bfce99b2a6ef47c057c04de7858","validationError":null},"payloadId":"0xa247243752eb10b4"}`
+	})
+	defer server.Close()
+
+	client, err := engine.Dial(ctx, server.URL, secret)
+	require.NoError(t, err)
+
+	head := eth.MustHash("0x3559e851470f6e7bbed1db474980683e8c315bfce99b2a6ef47c057c04de7858")
+	result, err := client.ForkchoiceUpdatedV3(ctx, engine.ForkchoiceState{
+		HeadBlockHash:      *head,
+		SafeBlockHash:      *head,
+		FinalizedBlockHash: *head,
+	}, &engine.PayloadAttributes{
+		Timestamp:             eth.QuantityFromUInt64(1700000012),
+		PrevRandao:            *eth.MustData32("0x0000000000000000000000000000000000000000000000000000000000000000"),
+		SuggestedFeeRecipient: *eth.MustAddress("0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b"),
+		ParentBeaconBlockRoot: *head,
+	})
+	require.NoError(t, err)
+	require.Equal(t, engine.StatusValid, result.PayloadStatus.Status)
+	require.Equal(t, head.String(), result.PayloadStatus.LatestValidHash.String())
+	require.Nil(t, result.PayloadStatus.ValidationError)
+	require.Equal(t, "0xa247243752eb10b4", result.PayloadID.String())
+}
+
+func TestClient_GetPayloadV4(t *testing.T) {
+	ctx := context.Background()
+	secret, err := engine.ParseJWTSecret(testSecret)
+	require.NoError(t, err)
+
+	server := newEngineServer(t, func(r *jsonrpc.Request) string {
+		switch r.Method {
+		case "engine_getPayloadV4":
+			return `"result":{
+				"executionPayload":{
+					"parentHash":"0x3559e851470f6e7bbed1db474980683e8c315bfce99b2a6ef47c057c04de7858",
+					"feeRecipient":"0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b",
+					"stateRoot":"0xca3149fa9e37db08d1cd49c9061db1002ef1cd58db2210f2115c8c989b2bdf45",
+					"receiptsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+					"logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+					"prevRandao":"0x0000000000000000000000000000000000000000000000000000000000000000",
+					"blockNumber":"0x1",
+					"gasLimit":"0x1c9c380",
+					"gasUsed":"0x0",
+					"timestamp":"0x5",
+					"extraData":"0x",
+					"baseFeePerGas":"0x7",
+					"blockHash":"0x6359b8381a370e2f54072a5784ddd78b6ed024991558c511d4452eb4f6ac898c",
+					"transactions":[],
+					"withdrawals":[{"index":"0x0","validatorIndex":"0x1","address":"0x0000000000000000000000000000000000001000","amount":"0x64"}],
+					"blobGasUsed":"0x0",
+					"excessBlobGas":"0x0"
+				},
+				"blockValue":"0x0",
+				"blobsBundle":{"commitments":[],"proofs":[],"blobs":[]},
+				"shouldOverrideBuilder":false,
+				"executionRequests":["0x00aabb"]
+			}`
+		default:
+			return `"error":{"code":-38001,"message":"Unknown payload"}`
+		}
+	})
+	defer server.Close()
+
+	client, err := engine.Dial(ctx, server.URL, secret)
+	require.NoError(t, err)
+
+	result, err := client.GetPayloadV4(ctx, *eth.MustData8("0xa247243752eb10b4"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), result.ExecutionPayload.BlockNumber.UInt64())
+	require.Len(t, result.ExecutionPayload.Withdrawals, 1)
+	require.Equal(t, uint64(100), result.ExecutionPayload.Withdrawals[0].Amount.UInt64())
+	require.Equal(t, []eth.Data{"0x00aabb"}, result.ExecutionRequests)
+
+	_, err = client.GetPayloadV3(ctx, *eth.MustData8("0xa247243752eb10b4"))
+	require.Error(t, err)
+	e, ok := err.(*jsonrpc.Error)
+	require.True(t, ok, "JSONRPC errors should be returned as *jsonrpc.Error")
+	require.Equal(t, engine.ErrCodeUnknownPayload, e.Code)
+}
+
+func TestDial_InvalidSecret(t *testing.T) {
+	_, err := engine.Dial(context.Background(), "http://localhost:8551", []byte("short"))
+	require.Error(t, err)
+}
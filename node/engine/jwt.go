@@ -0,0 +1,73 @@
+package engine
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/node"
+)
+
+// JWTSecretLength is the length in bytes of the shared secret used to authenticate engine API requests
+const JWTSecretLength = 32
+
+// LoadJWTSecret reads a hex encoded 32 byte secret, with or without the 0x prefix, from the file at path.
+// This is the format of the jwt.hex files written by execution and consensus clients.
+func LoadJWTSecret(path string) ([]byte, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read JWT secret file")
+	}
+
+	return ParseJWTSecret(string(b))
+}
+
+// ParseJWTSecret decodes a hex encoded 32 byte secret, with or without the 0x prefix.
+func ParseJWTSecret(value string) ([]byte, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "0x")
+	secret, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid JWT secret")
+	}
+
+	if len(secret) != JWTSecretLength {
+		return nil, errors.Errorf("invalid JWT secret length %d, expected %d bytes", len(secret), JWTSecretLength)
+	}
+
+	return secret, nil
+}
+
+// NewJWTToken returns an HS256 signed JWT with an iat (issued at) claim of now, as required by the engine API.
+func NewJWTToken(secret []byte, now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(map[string]int64{"iat": now.Unix()})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// NewJWTTokenSource returns a node.TokenSource that signs a fresh token for every request, since execution
+// clients reject tokens issued more than a minute before they're received.
+func NewJWTTokenSource(secret []byte) node.TokenSource {
+	return func(ctx context.Context) (string, error) {
+		return NewJWTToken(secret, time.Now())
+	}
+}
@@ -0,0 +1,105 @@
+package engine
+
+import (
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+)
+
+// PayloadID identifies a payload being built by the execution client, as returned by engine_forkchoiceUpdated
+type PayloadID = eth.Data8
+
+// Payload validation statuses returned in PayloadStatus.Status
+const (
+	StatusValid            = "VALID"
+	StatusInvalid          = "INVALID"
+	StatusSyncing          = "SYNCING"
+	StatusAccepted         = "ACCEPTED"
+	StatusInvalidBlockHash = "INVALID_BLOCK_HASH"
+)
+
+// Engine API specific JSONRPC error codes
+const (
+	ErrCodeUnknownPayload           jsonrpc.ErrorCode = -38001
+	ErrCodeInvalidForkchoiceState   jsonrpc.ErrorCode = -38002
+	ErrCodeInvalidPayloadAttributes jsonrpc.ErrorCode = -38003
+	ErrCodeTooLargeRequest          jsonrpc.ErrorCode = -38004
+	ErrCodeUnsupportedFork          jsonrpc.ErrorCode = -38005
+)
+
+// Withdrawal is a beacon chain withdrawal included in an execution payload (WithdrawalV1)
+type Withdrawal struct {
+	Index          eth.Quantity `json:"index"`
+	ValidatorIndex eth.Quantity `json:"validatorIndex"`
+	Address        eth.Address  `json:"address"`
+	Amount         eth.Quantity `json:"amount"`
+}
+
+// ExecutionPayload is the execution layer block exchanged over the engine API (ExecutionPayloadV3)
+type ExecutionPayload struct {
+	ParentHash    eth.Hash     `json:"parentHash"`
+	FeeRecipient  eth.Address  `json:"feeRecipient"`
+	StateRoot     eth.Data32   `json:"stateRoot"`
+	ReceiptsRoot  eth.Data32   `json:"receiptsRoot"`
+	LogsBloom     eth.Data256  `json:"logsBloom"`
+	PrevRandao    eth.Data32   `json:"prevRandao"`
+	BlockNumber   eth.Quantity `json:"blockNumber"`
+	GasLimit      eth.Quantity `json:"gasLimit"`
+	GasUsed       eth.Quantity `json:"gasUsed"`
+	Timestamp     eth.Quantity `json:"timestamp"`
+	ExtraData     eth.Data     `json:"extraData"`
+	BaseFeePerGas eth.Quantity `json:"baseFeePerGas"`
+	BlockHash     eth.Hash     `json:"blockHash"`
+	Transactions  []eth.Data   `json:"transactions"`
+
+	Withdrawals   []Withdrawal `json:"withdrawals"`
+	BlobGasUsed   eth.Quantity `json:"blobGasUsed"`
+	ExcessBlobGas eth.Quantity `json:"excessBlobGas"`
+}
+
+// ForkchoiceState is the ForkchoiceStateV1 structure passed to engine_forkchoiceUpdated
+type ForkchoiceState struct {
+	HeadBlockHash      eth.Hash `json:"headBlockHash"`
+	SafeBlockHash      eth.Hash `json:"safeBlockHash"`
+	FinalizedBlockHash eth.Hash `json:"finalizedBlockHash"`
+}
+
+// PayloadAttributes instructs the execution client to start building a payload on top of the new head
+// (PayloadAttributesV3)
+type PayloadAttributes struct {
+	Timestamp             eth.Quantity `json:"timestamp"`
+	PrevRandao            eth.Data32   `json:"prevRandao"`
+	SuggestedFeeRecipient eth.Address  `json:"suggestedFeeRecipient"`
+	Withdrawals           []Withdrawal `json:"withdrawals"`
+	ParentBeaconBlockRoot eth.Hash     `json:"parentBeaconBlockRoot"`
+}
+
+// PayloadStatus is the result of validating a payload (PayloadStatusV1)
+type PayloadStatus struct {
+	Status          string    `json:"status"`
+	LatestValidHash *eth.Hash `json:"latestValidHash"`
+	ValidationError *string   `json:"validationError"`
+}
+
+// ForkchoiceUpdatedResult is the result of engine_forkchoiceUpdated, PayloadID is only set when
+// payload attributes were supplied and the execution client started building a payload.
+type ForkchoiceUpdatedResult struct {
+	PayloadStatus PayloadStatus `json:"payloadStatus"`
+	PayloadID     *PayloadID    `json:"payloadId"`
+}
+
+// BlobsBundle holds the blobs of the transactions in a built payload along with their KZG commitments and proofs
+type BlobsBundle struct {
+	Commitments []eth.Data `json:"commitments"`
+	Proofs      []eth.Data `json:"proofs"`
+	Blobs       []eth.Data `json:"blobs"`
+}
+
+// GetPayloadResult is the result of engine_getPayloadV3 and engine_getPayloadV4, ExecutionRequests
+// is only included by V4 (Prague) and later.
+type GetPayloadResult struct {
+	ExecutionPayload      ExecutionPayload `json:"executionPayload"`
+	BlockValue            eth.Quantity     `json:"blockValue"`
+	BlobsBundle           *BlobsBundle     `json:"blobsBundle"`
+	ShouldOverrideBuilder bool             `json:"shouldOverrideBuilder"`
+	ExecutionRequests     []eth.Data       `json:"executionRequests,omitempty"`
+}
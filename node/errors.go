@@ -0,0 +1,146 @@
+package node
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+)
+
+// ResponseError decodes the error member of a response, or returns nil if the response was successful.
+// Only the code and message are decoded since the data member differs wildly between node implementations.
+func ResponseError(response *jsonrpc.RawResponse) *jsonrpc.Error {
+	if response == nil || response.Error == nil {
+		return nil
+	}
+
+	e := struct {
+		Code    jsonrpc.ErrorCode `json:"code"`
+		Message string            `json:"message"`
+	}{}
+
+	if err := json.Unmarshal(*response.Error, &e); err != nil {
+		// not a well-formed error object, but it is still an error
+		return jsonrpc.InternalError(string(*response.Error))
+	}
+
+	return jsonrpc.NewError(e.Code, e.Message)
+}
+
+// subscriptionKind returns the name of the subscription requested by an eth_subscribe request, e.g. newHeads
+func subscriptionKind(r *jsonrpc.Request) string {
+	kind := ""
+	if err := r.Params.UnmarshalSingleParam(0, &kind); err != nil {
+		return ""
+	}
+
+	return kind
+}
+
+// RevertError is returned by Call when the call reverted.  Data is the revert data returned by the contract,
+// which is empty when the node didn't return any (or the contract reverted without data).
+type RevertError struct {
+	Code    jsonrpc.ErrorCode
+	Message string
+	Data    eth.Data
+}
+
+func (e *RevertError) Error() string {
+	if reason, ok := e.Reason(); ok {
+		return "execution reverted: " + reason
+	}
+
+	if len(e.Data) > 2 {
+		return "execution reverted: " + e.Data.String()
+	}
+
+	return "execution reverted"
+}
+
+var (
+	errorSelector = []byte{0x08, 0xc3, 0x79, 0xa0} // Error(string)
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71} // Panic(uint256)
+)
+
+// Reason returns the message of a revert with the standard Error(string) data, as produced by require and revert
+func (e *RevertError) Reason() (string, bool) {
+	if len(e.Data) <= 2 {
+		return "", false
+	}
+
+	b := e.Data.Bytes()
+	if len(b) < 4+64 || !bytes.Equal(b[:4], errorSelector) {
+		return "", false
+	}
+
+	// the bounds are checked against what is left of b, as adding to offset or length could overflow
+	b = b[4:]
+	offset := new(big.Int).SetBytes(b[:32])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(b))-32 {
+		return "", false
+	}
+
+	start := offset.Uint64() + 32
+	length := new(big.Int).SetBytes(b[offset.Uint64():start])
+	if !length.IsUint64() || length.Uint64() > uint64(len(b))-start {
+		return "", false
+	}
+
+	return string(b[start : start+length.Uint64()]), true
+}
+
+// PanicCode returns the code of a revert with the Panic(uint256) data produced by failing asserts, arithmetic
+// overflows and the like
+func (e *RevertError) PanicCode() (uint64, bool) {
+	if len(e.Data) <= 2 {
+		return 0, false
+	}
+
+	b := e.Data.Bytes()
+	if len(b) != 4+32 || !bytes.Equal(b[:4], panicSelector) {
+		return 0, false
+	}
+
+	code := new(big.Int).SetBytes(b[4:])
+	if !code.IsUint64() {
+		return 0, false
+	}
+
+	return code.Uint64(), true
+}
+
+// revertError returns a RevertError if the error member of a response reports a reverted call, and nil otherwise.
+// Geth and erigon use code 3 with the data as a hex string, while nethermind and openethereum use other codes
+// with data like "Reverted 0x...".
+func revertError(response *jsonrpc.RawResponse) *RevertError {
+	if response == nil || response.Error == nil {
+		return nil
+	}
+
+	e := struct {
+		Code    jsonrpc.ErrorCode `json:"code"`
+		Message string            `json:"message"`
+		Data    interface{}       `json:"data"`
+	}{}
+
+	if err := json.Unmarshal(*response.Error, &e); err != nil {
+		return nil
+	}
+
+	data, _ := e.Data.(string)
+	if e.Code != 3 && !strings.Contains(strings.ToLower(e.Message+" "+data), "revert") {
+		return nil
+	}
+
+	revert := RevertError{Code: e.Code, Message: e.Message, Data: eth.Data("0x")}
+	if i := strings.Index(data, "0x"); i >= 0 {
+		if d, err := eth.NewData(strings.TrimSpace(data[i:])); err == nil {
+			revert.Data = *d
+		}
+	}
+
+	return &revert
+}
@@ -0,0 +1,324 @@
+// Package feeoracle suggests EIP-1559 fees from recent fee history, and predicts the base fee and blob base fee
+// of the next block.
+package feeoracle
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/node"
+)
+
+const (
+	// DefaultBlocks is the number of recent blocks whose priority fees are sampled unless configured otherwise
+	DefaultBlocks = 20
+	// DefaultBaseFeeMultiplier is how many times the predicted base fee maxFeePerGas allows for by default
+	DefaultBaseFeeMultiplier = 2
+)
+
+// EIP-1559 parameters
+const (
+	ElasticityMultiplier     = 2
+	BaseFeeChangeDenominator = 8
+)
+
+// Urgency selects how quickly a transaction should be included
+type Urgency int
+
+const (
+	// Slow is for transactions that can wait for a quiet block
+	Slow Urgency = iota
+	// Standard is for transactions that should be included in the next few blocks
+	Standard
+	// Fast is for transactions that should be included in the next block
+	Fast
+)
+
+func (u Urgency) String() string {
+	switch u {
+	case Slow:
+		return "slow"
+	case Standard:
+		return "standard"
+	case Fast:
+		return "fast"
+	}
+
+	return "unknown"
+}
+
+// BlobParams are the fork dependent EIP-4844 parameters the blob base fee is computed from, in blob gas
+type BlobParams struct {
+	Target         uint64
+	Max            uint64
+	UpdateFraction uint64
+
+	// BaseCost is the EIP-7918 BLOB_BASE_COST tying the blob base fee to the base fee, 0 before Osaka
+	BaseCost uint64
+}
+
+var (
+	// CancunBlobParams are the blob parameters introduced by the Cancun fork (3 blobs target, 6 max)
+	CancunBlobParams = BlobParams{Target: 393216, Max: 786432, UpdateFraction: 3338477}
+	// PragueBlobParams are the blob parameters of the Prague fork (6 blobs target, 9 max)
+	PragueBlobParams = BlobParams{Target: 786432, Max: 1179648, UpdateFraction: 5007716}
+	// OsakaBlobParams are the blob parameters of the Osaka fork, which adds the EIP-7918 reserve price
+	OsakaBlobParams = BlobParams{Target: 786432, Max: 1179648, UpdateFraction: 5007716, BaseCost: 8192}
+	// BPO1BlobParams are the blob parameters of the first blob parameter only fork (10 blobs target, 15 max)
+	BPO1BlobParams = BlobParams{Target: 1310720, Max: 1966080, UpdateFraction: 8346193, BaseCost: 8192}
+	// BPO2BlobParams are the blob parameters of the second blob parameter only fork (14 blobs target, 21 max)
+	BPO2BlobParams = BlobParams{Target: 1835008, Max: 2752512, UpdateFraction: 11684671, BaseCost: 8192}
+)
+
+// MinBlobBaseFee is the lowest possible blob base fee
+const MinBlobBaseFee = 1
+
+// Config configures an Oracle, the zero value is valid
+type Config struct {
+	// Blocks is the number of recent blocks whose priority fees are sampled, defaults to DefaultBlocks
+	Blocks uint64
+
+	// Percentiles are the percentiles of priority fees paid in each block used for Slow, Standard and Fast,
+	// defaults to 10, 50 and 90
+	Percentiles [3]float64
+
+	// BaseFeeMultiplier is how many times the predicted base fee maxFeePerGas (and maxFeePerBlobGas) allow
+	// for, defaults to DefaultBaseFeeMultiplier.  Each full block raises the base fee by 12.5%, so the default
+	// covers about six full blocks in a row.
+	BaseFeeMultiplier uint64
+
+	// MinPriorityFee is the lowest priority fee suggested, nil means no minimum
+	MinPriorityFee *big.Int
+
+	// BlobParams are used to predict the blob base fee when the node doesn't report it in eth_feeHistory, which
+	// is left unpredicted if nil.  They change with every fork, so must match the current fork of the chain.
+	BlobParams *BlobParams
+}
+
+// Estimate is a suggested fee for one urgency
+type Estimate struct {
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+
+	// MaxFeePerBlobGas is nil if the chain doesn't support blobs
+	MaxFeePerBlobGas *big.Int
+}
+
+// Fees are the suggestions of an Oracle
+type Fees struct {
+	// BaseFee is the predicted base fee of the next block
+	BaseFee *big.Int
+
+	// BlobBaseFee is the predicted blob base fee of the next block, nil if the chain doesn't support blobs
+	BlobBaseFee *big.Int
+
+	Slow     Estimate
+	Standard Estimate
+	Fast     Estimate
+}
+
+// Estimate returns the suggestion for urgency u
+func (f *Fees) Estimate(u Urgency) Estimate {
+	switch u {
+	case Slow:
+		return f.Slow
+	case Fast:
+		return f.Fast
+	}
+
+	return f.Standard
+}
+
+// Oracle suggests fees using eth_feeHistory and the latest block
+type Oracle struct {
+	client node.Client
+	config Config
+}
+
+// New returns an Oracle using client
+func New(client node.Client, config Config) *Oracle {
+	if config.Blocks == 0 {
+		config.Blocks = DefaultBlocks
+	}
+
+	if config.Percentiles == [3]float64{} {
+		config.Percentiles = [3]float64{10, 50, 90}
+	}
+
+	if config.BaseFeeMultiplier == 0 {
+		config.BaseFeeMultiplier = DefaultBaseFeeMultiplier
+	}
+
+	return &Oracle{client: client, config: config}
+}
+
+// Suggest returns fees for every urgency.  Priority fees are the median, across recent blocks, of the
+// configured percentile of priority fees paid in each block, ignoring empty blocks.  The maximum fees allow
+// for the predicted base fee of the next block times BaseFeeMultiplier, plus the priority fee.  The blob base fee
+// of the next block is the one reported by the node, or computed from the configured BlobParams if it isn't.
+func (o *Oracle) Suggest(ctx context.Context) (*Fees, error) {
+	latest, err := o.client.BlockByNumberOrTag(ctx, *eth.MustBlockNumberOrTag(eth.TagLatest.String()), false)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get latest block")
+	}
+
+	baseFee, err := NextBaseFee(latest)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := o.client.FeeHistory(ctx, o.config.Blocks, *eth.MustBlockNumberOrTag(eth.QuantityFromUInt64(latest.Number.UInt64()).String()), o.config.Percentiles[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get fee history")
+	}
+
+	fees := Fees{BaseFee: baseFee}
+	if latest.ExcessBlobGas != nil && latest.BlobGasUsed != nil {
+		if n := len(history.BaseFeePerBlobGas); n > 0 {
+			fees.BlobBaseFee = history.BaseFeePerBlobGas[n-1].Big()
+		} else if o.config.BlobParams != nil {
+			fees.BlobBaseFee = NextBlobBaseFee(latest, *o.config.BlobParams)
+		}
+	}
+
+	multiplier := new(big.Int).SetUint64(o.config.BaseFeeMultiplier)
+	for i, estimate := range []*Estimate{&fees.Slow, &fees.Standard, &fees.Fast} {
+		tip := priorityFee(history, i)
+		if o.config.MinPriorityFee != nil && tip.Cmp(o.config.MinPriorityFee) < 0 {
+			tip.Set(o.config.MinPriorityFee)
+		}
+
+		estimate.MaxPriorityFeePerGas = tip
+		estimate.MaxFeePerGas = new(big.Int).Mul(baseFee, multiplier)
+		estimate.MaxFeePerGas.Add(estimate.MaxFeePerGas, tip)
+		if fees.BlobBaseFee != nil {
+			estimate.MaxFeePerBlobGas = new(big.Int).Mul(fees.BlobBaseFee, multiplier)
+		}
+	}
+
+	// a faster urgency never pays less than a slower one, whatever the samples were
+	if fees.Standard.MaxPriorityFeePerGas.Cmp(fees.Slow.MaxPriorityFeePerGas) < 0 {
+		fees.Standard = fees.Slow
+	}
+
+	if fees.Fast.MaxPriorityFeePerGas.Cmp(fees.Standard.MaxPriorityFeePerGas) < 0 {
+		fees.Fast = fees.Standard
+	}
+
+	return &fees, nil
+}
+
+// priorityFee returns the median of the reward at percentile index i of the non-empty blocks of history
+func priorityFee(history *eth.FeeHistory, i int) *big.Int {
+	samples := make([]*big.Int, 0, len(history.Reward))
+	for b, rewards := range history.Reward {
+		if b < len(history.GasUsedRatio) && history.GasUsedRatio[b] == 0 {
+			continue
+		}
+
+		if i < len(rewards) {
+			samples = append(samples, rewards[i].Big())
+		}
+	}
+
+	if len(samples) == 0 {
+		return new(big.Int)
+	}
+
+	sort.Slice(samples, func(a, b int) bool { return samples[a].Cmp(samples[b]) < 0 })
+	return new(big.Int).Set(samples[len(samples)/2])
+}
+
+// NextBaseFee returns the base fee of the child of parent according to EIP-1559: it moves by up to 12.5%
+// towards keeping blocks half full.
+func NextBaseFee(parent *eth.Block) (*big.Int, error) {
+	if parent.BaseFeePerGas == nil {
+		return nil, errors.New("parent block has no base fee")
+	}
+
+	baseFee := parent.BaseFeePerGas.Big()
+	target := parent.GasLimit.UInt64() / ElasticityMultiplier
+	used := parent.GasUsed.UInt64()
+	if target == 0 || used == target {
+		return baseFee, nil
+	}
+
+	if used > target {
+		delta := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(used-target))
+		delta.Div(delta, new(big.Int).SetUint64(target))
+		delta.Div(delta, big.NewInt(BaseFeeChangeDenominator))
+		if delta.Sign() == 0 {
+			delta.SetInt64(1)
+		}
+
+		return delta.Add(baseFee, delta), nil
+	}
+
+	delta := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(target-used))
+	delta.Div(delta, new(big.Int).SetUint64(target))
+	delta.Div(delta, big.NewInt(BaseFeeChangeDenominator))
+
+	next := delta.Sub(baseFee, delta)
+	if next.Sign() < 0 {
+		next.SetInt64(0)
+	}
+
+	return next, nil
+}
+
+// NextExcessBlobGas returns the excess blob gas of the child of parent according to EIP-4844, and EIP-7918 if
+// params have a BaseCost
+func NextExcessBlobGas(parent *eth.Block, params BlobParams) uint64 {
+	excess, used := uint64(0), uint64(0)
+	if parent.ExcessBlobGas != nil {
+		excess = parent.ExcessBlobGas.UInt64()
+	}
+
+	if parent.BlobGasUsed != nil {
+		used = parent.BlobGasUsed.UInt64()
+	}
+
+	if excess+used < params.Target {
+		return 0
+	}
+
+	if params.BaseCost > 0 && params.Max > 0 && parent.BaseFeePerGas != nil {
+		// the blob base fee is below the reserve price, so excess blob gas only grows
+		reserve := new(big.Int).Mul(new(big.Int).SetUint64(params.BaseCost), parent.BaseFeePerGas.Big())
+		blobFee := new(big.Int).Mul(big.NewInt(eth.GasPerBlob), BlobBaseFee(excess, params))
+		if reserve.Cmp(blobFee) > 0 {
+			return excess + used*(params.Max-params.Target)/params.Max
+		}
+	}
+
+	return excess + used - params.Target
+}
+
+// NextBlobBaseFee returns the blob base fee of the child of parent according to EIP-4844
+func NextBlobBaseFee(parent *eth.Block, params BlobParams) *big.Int {
+	return BlobBaseFee(NextExcessBlobGas(parent, params), params)
+}
+
+// BlobBaseFee returns the blob base fee of a block with the given excess blob gas
+func BlobBaseFee(excessBlobGas uint64, params BlobParams) *big.Int {
+	return fakeExponential(big.NewInt(MinBlobBaseFee), new(big.Int).SetUint64(excessBlobGas), new(big.Int).SetUint64(params.UpdateFraction))
+}
+
+// fakeExponential approximates factor * e ** (numerator / denominator) using a Taylor expansion, as specified
+// by EIP-4844
+func fakeExponential(factor, numerator, denominator *big.Int) *big.Int {
+	output := new(big.Int)
+	accumulator := new(big.Int).Mul(factor, denominator)
+	divisor := new(big.Int)
+	for i := int64(1); accumulator.Sign() > 0; i++ {
+		output.Add(output, accumulator)
+		accumulator.Mul(accumulator, numerator)
+		accumulator.Div(accumulator, divisor.Mul(denominator, big.NewInt(i)))
+	}
+
+	return output.Div(output, denominator)
+}
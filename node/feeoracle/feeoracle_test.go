@@ -0,0 +1,157 @@
+package feeoracle_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node/feeoracle"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+)
+
+const blockTemplate = `{"baseFeePerGas":"0x%x","blobGasUsed":"0x%x","excessBlobGas":"0x%x","difficulty":"0x0","extraData":"0x","gasLimit":"0x%x","gasUsed":"0x%x","hash":"0x%064x","logsBloom":"0x%0512x","miner":"0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c","mixHash":"0x%064x","nonce":"0x0000000000000000","number":"0x%x","parentHash":"0x%064x","receiptsRoot":"0x%064x","sha3Uncles":"0x%064x","size":"0x220","stateRoot":"0x%064x","timestamp":"0x5b541449","totalDifficulty":"0x0","transactions":[],"transactionsRoot":"0x%064x","uncles":[]}`
+
+func block(t *testing.T, baseFee, gasLimit, gasUsed, blobGasUsed, excessBlobGas uint64) *eth.Block {
+	b := eth.Block{}
+	raw := fmt.Sprintf(blockTemplate, baseFee, blobGasUsed, excessBlobGas, gasLimit, gasUsed, 1, 0, 0, 100, 0, 0, 0, 0, 0)
+	require.NoError(t, json.Unmarshal([]byte(raw), &b))
+	return &b
+}
+
+func TestNextBaseFee(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseFee uint64
+		gasUsed uint64
+		want    int64
+	}{
+		{"full", 1000, 30000000, 1125},
+		{"empty", 1000, 0, 875},
+		{"target", 1000, 15000000, 1000},
+		{"three quarters", 1000, 22500000, 1062},
+		{"minimum increase", 1, 15000001, 2},
+		{"quarter", 1000, 7500000, 938},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := feeoracle.NextBaseFee(block(t, tt.baseFee, 30000000, tt.gasUsed, 0, 0))
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(tt.want), next)
+		})
+	}
+
+	_, err := feeoracle.NextBaseFee(&eth.Block{})
+	require.Error(t, err)
+}
+
+func TestBlobBaseFee(t *testing.T) {
+	// vectors from the EIP-4844 fake_exponential tests
+	tests := []struct {
+		excess   uint64
+		fraction uint64
+		want     int64
+	}{
+		{0, 1, 1},
+		{2, 1, 6},
+		{4, 2, 6},
+		{3, 1, 16},
+		{4, 1, 49},
+		{5, 2, 11},
+		{50000000, 2225652, 5709098764},
+	}
+
+	for _, tt := range tests {
+		fee := feeoracle.BlobBaseFee(tt.excess, feeoracle.BlobParams{UpdateFraction: tt.fraction})
+		require.Equal(t, big.NewInt(tt.want), fee, "excess %d fraction %d", tt.excess, tt.fraction)
+	}
+
+	params := feeoracle.CancunBlobParams
+	require.Equal(t, uint64(0), feeoracle.NextExcessBlobGas(block(t, 1, 1, 0, 131072, 0), params))
+	require.Equal(t, uint64(131072), feeoracle.NextExcessBlobGas(block(t, 1, 1, 0, 393216, 131072), params))
+	require.Equal(t, uint64(393216), feeoracle.NextExcessBlobGas(block(t, 1, 1, 0, 786432, 0), params))
+	require.Equal(t, big.NewInt(1), feeoracle.NextBlobBaseFee(block(t, 1, 1, 0, 393216, 0), params))
+	require.Equal(t, big.NewInt(22), feeoracle.NextBlobBaseFee(block(t, 1, 1, 0, 786432, 10000000), params))
+
+	// below the EIP-7918 reserve price excess blob gas grows by a third of the blob gas used with 6 of 9 blobs
+	params = feeoracle.OsakaBlobParams
+	require.Equal(t, uint64(1000000+1179648/3), feeoracle.NextExcessBlobGas(block(t, 1e9, 1, 0, 1179648, 1000000), params))
+	require.Equal(t, uint64(1000000+1179648-786432), feeoracle.NextExcessBlobGas(block(t, 1, 1, 0, 1179648, 1000000), params))
+}
+
+func TestOracle_Suggest(t *testing.T) {
+	var params jsonrpc.Params
+	blobFees := `"baseFeePerBlobGas": ["0x1", "0x1", "0x1", "0x1", "0x1", "0x13"],`
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		result := ""
+		switch r.Method {
+		case "eth_getBlockByNumber":
+			result = fmt.Sprintf(blockTemplate, 1000, 786432, 10000000, 30000000, 30000000, 1, 0, 0, 100, 0, 0, 0, 0, 0)
+		case "eth_feeHistory":
+			params = r.Params
+			result = `{
+				"oldestBlock": "0x60",
+				"baseFeePerGas": ["0x3e8", "0x3e8", "0x3e8", "0x3e8", "0x3e8", "0x465"],
+				"gasUsedRatio": [0.5, 0, 0.9, 0.4, 1],
+				"reward": [["0x1", "0xa", "0x64"], ["0x0", "0x0", "0x0"], ["0x2", "0x14", "0xc8"], ["0x3", "0x5", "0x12c"], ["0x4", "0x1e", "0x190"]],
+				` + blobFees + `
+				"blobGasUsedRatio": [0, 0, 0.5, 1, 1]
+			}`
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+
+		return nodetest.Result(r, result), nil
+	})
+
+	client := nodetest.NewClient(t, requester, nil)
+
+	history, err := client.FeeHistory(context.Background(), 5, *eth.MustBlockNumberOrTag("latest"), []float64{10, 50, 90})
+	require.NoError(t, err)
+	require.Equal(t, 5, history.Blocks())
+	require.Equal(t, uint64(0x60), history.OldestBlock.UInt64())
+	require.Len(t, history.BaseFeePerGas, 6)
+	require.Len(t, history.BaseFeePerBlobGas, 6)
+	require.Equal(t, []float64{0, 0, 0.5, 1, 1}, history.BlobGasUsedRatio)
+	require.Equal(t, uint64(200), history.Reward[2][2].UInt64())
+	require.JSONEq(t, `"0x5"`, string(params[0]))
+	require.JSONEq(t, `"latest"`, string(params[1]))
+	require.JSONEq(t, `[10, 50, 90]`, string(params[2]))
+
+	oracle := feeoracle.New(client, feeoracle.Config{Blocks: 5, MinPriorityFee: big.NewInt(4)})
+	fees, err := oracle.Suggest(context.Background())
+	require.NoError(t, err)
+	require.JSONEq(t, `"0x5"`, string(params[0]))
+	require.JSONEq(t, `"0x64"`, string(params[1]))
+
+	require.Equal(t, big.NewInt(1125), fees.BaseFee)
+	require.Equal(t, big.NewInt(19), fees.BlobBaseFee, "the blob base fee reported by the node is used")
+
+	// the empty block is ignored and the slow tip is raised to the minimum
+	require.Equal(t, big.NewInt(4), fees.Slow.MaxPriorityFeePerGas)
+	require.Equal(t, big.NewInt(20), fees.Standard.MaxPriorityFeePerGas)
+	require.Equal(t, big.NewInt(300), fees.Fast.MaxPriorityFeePerGas)
+	require.Equal(t, big.NewInt(2*1125+300), fees.Fast.MaxFeePerGas)
+	require.Equal(t, big.NewInt(38), fees.Fast.MaxFeePerBlobGas)
+	require.Equal(t, fees.Standard, fees.Estimate(feeoracle.Standard))
+	require.Equal(t, "fast", feeoracle.Fast.String())
+
+	// without a blob base fee from the node it's only predicted with configured blob params
+	blobFees = ""
+	fees, err = oracle.Suggest(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, fees.BlobBaseFee)
+	require.Nil(t, fees.Fast.MaxFeePerBlobGas)
+
+	oracle = feeoracle.New(client, feeoracle.Config{Blocks: 5, BlobParams: &feeoracle.PragueBlobParams})
+	fees, err = oracle.Suggest(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(7), fees.BlobBaseFee)
+	require.Equal(t, big.NewInt(14), fees.Fast.MaxFeePerBlobGas)
+}
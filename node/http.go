@@ -2,8 +2,11 @@ package node
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -14,16 +17,41 @@ import (
 	"github.com/pkg/errors"
 )
 
-func newHTTPTransport(ctx context.Context, parsedURL *url.URL) (transport, error) {
+// maxErrorBodySize is the maximum number of bytes of a response body included in an HTTPError
+const maxErrorBodySize = 512
+
+func newHTTPTransport(ctx context.Context, parsedURL *url.URL, o *options) (transport, error) {
 	return &httpTransport{
-		rawURL: parsedURL.String(),
+		ctx:            ctx,
+		rawURL:         parsedURL.String(),
+		client:         o.httpClient,
+		tlsConfig:      o.tlsConfig,
+		timeout:        o.httpTimeout,
+		requestTimeout: o.requestTimeout,
+		headers:        o.headers.Clone(),
+		tokenSource:    o.tokenSource,
+		gzip:           o.gzip,
+		pollInterval:   o.pollInterval,
 	}, nil
 }
 
 type httpTransport struct {
+	// ctx bounds the lifetime of emulated subscriptions
+	ctx    context.Context
 	rawURL string
 	client *http.Client
 	once   sync.Once
+
+	tlsConfig      *tls.Config
+	timeout        time.Duration
+	requestTimeout time.Duration
+	headers        http.Header
+	tokenSource    TokenSource
+	gzip           bool
+	pollInterval   time.Duration
+
+	// requester is used to poll emulated subscriptions, it is set to the intercepted transport by NewClient
+	requester Requester
 }
 
 func (t *httpTransport) Request(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
@@ -32,6 +60,12 @@ func (t *httpTransport) Request(ctx context.Context, r *jsonrpc.Request) (*jsonr
 		return nil, errors.Wrap(err, "could not decode request json")
 	}
 
+	if t.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.requestTimeout)
+		defer cancel()
+	}
+
 	body, err := t.dispatchBytes(ctx, b)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not dispatch request")
@@ -46,33 +80,84 @@ func (t *httpTransport) Request(ctx context.Context, r *jsonrpc.Request) (*jsonr
 	return &jr, nil
 }
 
+// Subscribe emulates subscriptions by polling filters, see NewPollingSubscriber
 func (t *httpTransport) Subscribe(ctx context.Context, r *jsonrpc.Request) (Subscription, error) {
-	return nil, errors.New("subscriptions not supported over HTTP")
+	var requester Requester = t
+	if t.requester != nil {
+		requester = t.requester
+	}
+
+	return newPollingSubscription(ctx, t.ctx, requester, r, t.pollInterval)
 }
 
 func (t *httpTransport) IsBidirectional() bool {
 	return false
 }
 
+func (t *httpTransport) SupportsSubscriptions() bool {
+	return true
+}
+
 func (t *httpTransport) dispatchBytes(ctx context.Context, input []byte) ([]byte, error) {
 	t.once.Do(func() {
+		if t.client != nil {
+			// a custom client was supplied, use it as is
+			return
+		}
+
 		// Since this client is only ever used to access a single endpoint,
 		// we allow all the idle connections to point that host
 		tr := http.DefaultTransport.(*http.Transport).Clone()
 		tr.MaxIdleConnsPerHost = tr.MaxIdleConns
+		if t.tlsConfig != nil {
+			tr.TLSClientConfig = t.tlsConfig
+		}
+
+		timeout := t.timeout
+		if timeout == 0 {
+			timeout = 120 * time.Second
+		}
+
 		t.client = &http.Client{
-			Timeout:   120 * time.Second,
+			Timeout:   timeout,
 			Transport: tr,
 		}
 	})
 
+	if t.gzip {
+		buf := bytes.Buffer{}
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(input); err != nil {
+			return nil, errors.Wrap(err, "could not compress request body")
+		}
+		if err := w.Close(); err != nil {
+			return nil, errors.Wrap(err, "could not compress request body")
+		}
+		input = buf.Bytes()
+	}
+
 	r, err := http.NewRequest(http.MethodPost, t.rawURL, bytes.NewReader(input))
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create http.Request")
 	}
 
 	r = r.WithContext(ctx)
-	r.Header.Add("Content-Type", "application/json")
+	for key, values := range t.headers {
+		for _, value := range values {
+			r.Header.Add(key, value)
+		}
+	}
+	r.Header.Set("Content-Type", "application/json")
+	if t.tokenSource != nil {
+		token, err := t.tokenSource(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate authorization token")
+		}
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	if t.gzip {
+		r.Header.Set("Content-Encoding", "gzip")
+	}
 
 	resp, err := t.client.Do(r)
 	if err != nil {
@@ -86,5 +171,35 @@ func (t *httpTransport) dispatchBytes(ctx context.Context, input []byte) ([]byte
 		return nil, errors.Wrap(err, "error reading body")
 	}
 
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if len(body) > maxErrorBodySize {
+			body = body[:maxErrorBodySize]
+		}
+
+		return nil, &HTTPError{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header,
+			Body:       body,
+		}
+	}
+
 	return body, nil
 }
+
+// HTTPError is returned by HTTP based clients when the server responds with a non-2xx status code.
+// Use errors.Cause to retrieve it from errors returned by Client methods.
+type HTTPError struct {
+	StatusCode int
+	Header     http.Header
+
+	// Body holds at most the first 512 bytes of the response body
+	Body []byte
+}
+
+func (e *HTTPError) Error() string {
+	if len(e.Body) == 0 {
+		return fmt.Sprintf("unexpected HTTP status %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+
+	return fmt.Sprintf("unexpected HTTP status %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), string(e.Body))
+}
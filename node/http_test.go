@@ -0,0 +1,105 @@
+package node_test
+
+import (
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/node"
+)
+
+func TestHTTPTransport_Options(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "user" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		require.Equal(t, "abc", r.Header.Get("X-Api-Key"))
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.Equal(t, "gzip", r.Header.Get("Content-Encoding"))
+
+		gz, err := gzip.NewReader(r.Body)
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(gz)
+		require.NoError(t, err)
+		require.Contains(t, string(body), `"eth_blockNumber"`)
+
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`))
+	}))
+	defer server.Close()
+
+	client, err := node.NewClient(ctx, server.URL,
+		node.WithBasicAuth("user", "secret"),
+		node.WithHeader("X-Api-Key", "abc"),
+		node.WithGzip(),
+		node.WithHTTPClient(server.Client()),
+	)
+	require.NoError(t, err)
+
+	num, err := client.BlockNumber(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), num)
+
+	// without credentials the server's status code is surfaced as a typed error
+	unauthorized, err := node.NewClient(ctx, server.URL)
+	require.NoError(t, err)
+
+	_, err = unauthorized.BlockNumber(ctx)
+	require.Error(t, err)
+	httpErr, ok := errors.Cause(err).(*node.HTTPError)
+	require.True(t, ok, "error should be caused by an HTTPError")
+	require.Equal(t, http.StatusUnauthorized, httpErr.StatusCode)
+}
+
+func TestHTTPTransport_ErrorBody(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("<html>" + strings.Repeat("bad gateway ", 1000) + "</html>"))
+	}))
+	defer server.Close()
+
+	client, err := node.NewClient(ctx, server.URL)
+	require.NoError(t, err)
+
+	_, err = client.BlockNumber(ctx)
+	require.Error(t, err)
+	httpErr, ok := errors.Cause(err).(*node.HTTPError)
+	require.True(t, ok, "error should be caused by an HTTPError")
+	require.Equal(t, http.StatusBadGateway, httpErr.StatusCode)
+	require.Len(t, httpErr.Body, 512)
+	require.True(t, strings.HasPrefix(string(httpErr.Body), "<html>bad gateway"))
+	require.Contains(t, httpErr.Error(), "502 Bad Gateway")
+}
+
+func TestHTTPTransport_RequestTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+
+	client, err := node.NewClient(ctx, server.URL, node.WithRequestTimeout(50*time.Millisecond))
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = client.BlockNumber(ctx)
+	require.Error(t, err)
+	require.True(t, time.Since(start) < time.Second, "request should time out quickly")
+}
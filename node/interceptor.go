@@ -0,0 +1,154 @@
+package node
+
+import (
+	"context"
+	"sync"
+
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+)
+
+// RequestFunc has the same signature as Requester.Request and represents the next step of an interceptor chain.
+type RequestFunc func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error)
+
+// SubscribeFunc has the same signature as Subscriber.Subscribe and represents the next step of an interceptor chain.
+type SubscribeFunc func(ctx context.Context, r *jsonrpc.Request) (Subscription, error)
+
+// RequestInterceptor is invoked for every request sent through a Client.  It must call next to continue the chain
+// (possibly with a modified request) or return a response or error of its own to short-circuit it.
+type RequestInterceptor func(ctx context.Context, r *jsonrpc.Request, next RequestFunc) (*jsonrpc.RawResponse, error)
+
+// SubscribeInterceptor is invoked for every subscription request sent through a Client.  It must call next to
+// continue the chain or return a subscription or error of its own to short-circuit it.
+type SubscribeInterceptor func(ctx context.Context, r *jsonrpc.Request, next SubscribeFunc) (Subscription, error)
+
+// NotificationInterceptor is invoked for every notification received on a subscription created through a Client,
+// along with the eth_subscribe request that created it.  It returns the notification to deliver to the next
+// interceptor, which may be the same or a modified notification, or nil to drop the notification entirely.
+type NotificationInterceptor func(ctx context.Context, r *jsonrpc.Request, sub Subscription, n *jsonrpc.Notification) *jsonrpc.Notification
+
+// Interceptor bundles the hooks for a single middleware, any of which may be left nil.
+type Interceptor struct {
+	Request      RequestInterceptor
+	Subscribe    SubscribeInterceptor
+	Notification NotificationInterceptor
+}
+
+type interceptedTransport struct {
+	transport
+
+	request       RequestFunc
+	subscribe     SubscribeFunc
+	notifications []NotificationInterceptor
+}
+
+func newInterceptedTransport(t transport, interceptors []Interceptor) *interceptedTransport {
+	it := interceptedTransport{
+		transport: t,
+		request:   t.Request,
+		subscribe: t.Subscribe,
+	}
+
+	// build the chains inside out, so that the first interceptor is the outermost one
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		if ic := interceptors[i].Request; ic != nil {
+			next := it.request
+			it.request = func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+				return ic(ctx, r, next)
+			}
+		}
+
+		if ic := interceptors[i].Subscribe; ic != nil {
+			next := it.subscribe
+			it.subscribe = func(ctx context.Context, r *jsonrpc.Request) (Subscription, error) {
+				return ic(ctx, r, next)
+			}
+		}
+	}
+
+	for i := range interceptors {
+		if ic := interceptors[i].Notification; ic != nil {
+			it.notifications = append(it.notifications, ic)
+		}
+	}
+
+	return &it
+}
+
+func (t *interceptedTransport) Request(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+	return t.request(ctx, r)
+}
+
+func (t *interceptedTransport) Subscribe(ctx context.Context, r *jsonrpc.Request) (Subscription, error) {
+	sub, err := t.subscribe(ctx, r)
+	if err != nil || sub == nil || len(t.notifications) == 0 {
+		return sub, err
+	}
+
+	return newInterceptedSubscription(ctx, r, sub, t.notifications), nil
+}
+
+// interceptedSubscription passes every notification from the underlying subscription through the
+// notification interceptors before delivering it on its own channel.
+type interceptedSubscription struct {
+	Subscription
+
+	notificationsCh chan *jsonrpc.Notification
+	doneCh          chan struct{}
+	once            sync.Once
+}
+
+func newInterceptedSubscription(ctx context.Context, r *jsonrpc.Request, sub Subscription, interceptors []NotificationInterceptor) *interceptedSubscription {
+	s := interceptedSubscription{
+		Subscription:    sub,
+		notificationsCh: make(chan *jsonrpc.Notification),
+		doneCh:          make(chan struct{}),
+	}
+
+	go func() {
+		defer close(s.notificationsCh)
+
+		for {
+			var n *jsonrpc.Notification
+			select {
+			case received, ok := <-sub.Ch():
+				if !ok {
+					return
+				}
+				n = received
+			case <-s.doneCh:
+				return
+			}
+
+			for _, ic := range interceptors {
+				if n = ic(ctx, r, &s, n); n == nil {
+					break
+				}
+			}
+
+			if n == nil {
+				continue
+			}
+
+			select {
+			case s.notificationsCh <- n:
+			case <-s.doneCh:
+				return
+			}
+		}
+	}()
+
+	return &s
+}
+
+func (s *interceptedSubscription) Ch() <-chan *jsonrpc.Notification {
+	return s.notificationsCh
+}
+
+func (s *interceptedSubscription) Unsubscribe(ctx context.Context) error {
+	// stop forwarding even if unsubscribing fails, as nothing will read the notifications anymore
+	s.once.Do(func() {
+		close(s.doneCh)
+	})
+
+	return s.Subscription.Unsubscribe(ctx)
+}
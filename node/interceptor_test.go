@@ -0,0 +1,204 @@
+package node_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+)
+
+type fakeSubscription struct {
+	id string
+	ch chan *jsonrpc.Notification
+}
+
+func (s *fakeSubscription) Response() *jsonrpc.RawResponse        { return nil }
+func (s *fakeSubscription) ID() string                            { return s.id }
+func (s *fakeSubscription) Ch() <-chan *jsonrpc.Notification      { return s.ch }
+func (s *fakeSubscription) Unsubscribe(ctx context.Context) error { close(s.ch); return nil }
+
+type recordingMetrics struct {
+	mu        sync.Mutex
+	counters  map[string]int
+	durations map[string]int
+}
+
+func (m *recordingMetrics) IncCounter(name string, labels map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters[name+"/"+labels["method"]+"/"+labels["code"]]++
+}
+
+func (m *recordingMetrics) ObserveDuration(name string, d time.Duration, labels map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.durations[name+"/"+labels["method"]]++
+}
+
+func TestInterceptors_Request(t *testing.T) {
+	ctx := context.Background()
+
+	var seen []string
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		seen = append(seen, "transport:"+r.Method)
+		if r.Method == "eth_chainId" {
+			return nodetest.Error(r, `{"code":-32005,"message":"limit exceeded"}`), nil
+		}
+		return nodetest.Result(r, `"0x10"`), nil
+	})
+
+	tracer := func(name string) node.Interceptor {
+		return node.Interceptor{
+			Request: func(ctx context.Context, r *jsonrpc.Request, next node.RequestFunc) (*jsonrpc.RawResponse, error) {
+				seen = append(seen, name+":"+r.Method)
+				return next(ctx, r)
+			},
+		}
+	}
+
+	metrics := &recordingMetrics{counters: map[string]int{}, durations: map[string]int{}}
+	var logged []string
+	logger := node.LoggerFunc(func(msg string, keyvals ...interface{}) {
+		logged = append(logged, msg)
+	})
+
+	client := nodetest.NewClient(t, requester, nil, node.WithInterceptors(
+		tracer("first"),
+		tracer("second"),
+		node.NewMetricsInterceptor(metrics),
+		node.NewLoggingInterceptor(logger),
+	))
+
+	num, err := client.BlockNumber(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), num)
+	require.Equal(t, []string{"first:eth_blockNumber", "second:eth_blockNumber", "transport:eth_blockNumber"}, seen)
+
+	_, err = client.ChainId(ctx)
+	require.Error(t, err)
+
+	require.Equal(t, 1, metrics.counters[node.MetricRequests+"/eth_blockNumber/"])
+	require.Equal(t, 1, metrics.counters[node.MetricRequests+"/eth_chainId/"])
+	require.Equal(t, 1, metrics.counters[node.MetricRequestErrors+"/eth_chainId/-32005"])
+	require.Equal(t, 1, metrics.durations[node.MetricRequestDuration+"/eth_blockNumber"])
+	require.Equal(t, []string{"[DEBUG] request", "[WARN] request returned error"}, logged)
+}
+
+func TestInterceptors_ShortCircuit(t *testing.T) {
+	ctx := context.Background()
+
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		t.Fatal("transport should not be reached")
+		return nil, nil
+	})
+
+	cache := node.Interceptor{
+		Request: func(ctx context.Context, r *jsonrpc.Request, next node.RequestFunc) (*jsonrpc.RawResponse, error) {
+			return nodetest.Result(r, `"0x1"`), nil
+		},
+	}
+
+	client := nodetest.NewClient(t, requester, nil, node.WithInterceptors(cache))
+
+	id, err := client.ChainId(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "0x1", id)
+}
+
+func TestInterceptors_Notifications(t *testing.T) {
+	ctx := context.Background()
+
+	sub := &fakeSubscription{id: "0xabc", ch: make(chan *jsonrpc.Notification)}
+	subscriber := nodetest.SubscriberFunc(func(ctx context.Context, r *jsonrpc.Request) (node.Subscription, error) {
+		return sub, nil
+	})
+
+	metrics := &recordingMetrics{counters: map[string]int{}, durations: map[string]int{}}
+	dropOdd := node.Interceptor{
+		Notification: func(ctx context.Context, r *jsonrpc.Request, s node.Subscription, n *jsonrpc.Notification) *jsonrpc.Notification {
+			require.Equal(t, "0xabc", s.ID())
+			if string(n.Params) == `"odd"` {
+				return nil
+			}
+			return n
+		},
+	}
+
+	client := nodetest.NewClient(t, nil, subscriber, node.WithInterceptors(node.NewMetricsInterceptor(metrics), dropOdd))
+
+	s, err := client.SubscribeNewHeads(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "0xabc", s.ID())
+
+	go func() {
+		for _, p := range []string{`"even"`, `"odd"`, `"even"`} {
+			sub.ch <- &jsonrpc.Notification{Method: "eth_subscription", Params: []byte(p)}
+		}
+		_ = sub.Unsubscribe(ctx)
+	}()
+
+	received := 0
+	for n := range s.Ch() {
+		require.Equal(t, `"even"`, string(n.Params))
+		received++
+	}
+
+	require.Equal(t, 2, received)
+	require.Equal(t, 1, metrics.counters[node.MetricSubscriptions+"/eth_subscribe/"])
+	require.Equal(t, 3, metrics.counters[node.MetricNotifications+"/eth_subscription/"])
+}
+
+// stuckSubscription never delivers notifications and fails to unsubscribe
+type stuckSubscription struct {
+	fakeSubscription
+}
+
+func (s *stuckSubscription) Unsubscribe(ctx context.Context) error {
+	return errors.New("connection lost")
+}
+
+func TestInterceptors_Failures(t *testing.T) {
+	ctx := context.Background()
+
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		return nil, nil
+	})
+
+	failing := true
+	subscriber := nodetest.SubscriberFunc(func(ctx context.Context, r *jsonrpc.Request) (node.Subscription, error) {
+		if failing {
+			return nil, errors.New("connection refused")
+		}
+		return &stuckSubscription{fakeSubscription{id: "0xabc", ch: make(chan *jsonrpc.Notification)}}, nil
+	})
+
+	metrics := &recordingMetrics{counters: map[string]int{}, durations: map[string]int{}}
+	logger := node.LoggerFunc(func(msg string, keyvals ...interface{}) {})
+	client := nodetest.NewClient(t, requester, subscriber, node.WithInterceptors(node.NewMetricsInterceptor(metrics), node.NewLoggingInterceptor(logger)))
+
+	// a transport returning neither a response nor an error mustn't crash the interceptors
+	_, _ = client.Request(ctx, &jsonrpc.Request{ID: jsonrpc.ID{Num: 1}, Method: "eth_chainId"})
+	require.Equal(t, 1, metrics.counters[node.MetricRequests+"/eth_chainId/"])
+
+	_, err := client.SubscribeNewHeads(ctx)
+	require.Error(t, err)
+	require.Equal(t, 0, metrics.counters[node.MetricSubscriptions+"/eth_subscribe/"])
+	require.Equal(t, 1, metrics.counters[node.MetricRequestErrors+"/eth_subscribe/"])
+
+	failing = false
+	sub, err := client.SubscribeNewHeads(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, metrics.counters[node.MetricSubscriptions+"/eth_subscribe/"])
+
+	// the notifications channel is closed even though unsubscribing failed
+	require.Error(t, sub.Unsubscribe(ctx))
+	_, ok := <-sub.Ch()
+	require.False(t, ok)
+}
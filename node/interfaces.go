@@ -40,15 +40,50 @@ type Client interface {
 	// EstimateGas returns the estimate gas
 	EstimateGas(ctx context.Context, msg eth.Transaction) (uint64, error)
 
+	// Call executes msg against the state of block without creating a transaction, applying overrides if not
+	// nil, and returns its output.  A reverted call returns a *RevertError.
+	Call(ctx context.Context, msg eth.CallMsg, block eth.BlockSpecifier, overrides *eth.CallOverrides) (eth.Data, error)
+
+	// TraceTransaction replays the transaction with hash and returns the result of the tracer of config
+	TraceTransaction(ctx context.Context, hash string, config eth.TraceConfig) (*eth.TraceResult, error)
+
+	// TraceCall executes msg against the state of block like Call and returns the result of the tracer of config
+	TraceCall(ctx context.Context, msg eth.CallMsg, block eth.BlockSpecifier, config eth.TraceCallConfig) (*eth.TraceResult, error)
+
+	// TraceBlockByNumber replays the transactions of a block and returns the result of the tracer of config for each
+	TraceBlockByNumber(ctx context.Context, numberOrTag eth.BlockNumberOrTag, config eth.TraceConfig) ([]eth.TransactionTrace, error)
+
+	// TransactionTraces returns the trace_transaction traces of the actions of the transaction with hash
+	TransactionTraces(ctx context.Context, hash string) ([]eth.Trace, error)
+
+	// BlockTraces returns the trace_block traces of the actions and rewards of a block
+	BlockTraces(ctx context.Context, numberOrTag eth.BlockNumberOrTag) ([]eth.Trace, error)
+
+	// FilterTraces returns the trace_filter traces matching filter
+	FilterTraces(ctx context.Context, filter eth.TraceFilter) ([]eth.Trace, error)
+
+	// ReplayBlockTransactions replays the transactions of a block and returns the outputs selected by options
+	ReplayBlockTransactions(ctx context.Context, numberOrTag eth.BlockNumberOrTag, options []eth.TraceOption) ([]eth.TraceReplay, error)
+
+	// ReplayCall executes msg against the state of a block with trace_call and returns the outputs selected by options
+	ReplayCall(ctx context.Context, msg eth.CallMsg, options []eth.TraceOption, numberOrTag eth.BlockNumberOrTag) (*eth.TraceReplay, error)
+
 	// MaxPriorityFeePerGas (EIP1559) returns the suggested tip for block
 	MaxPriorityFeePerGas(ctx context.Context) (uint64, error)
 
 	// GasPrice (Legacy) returns the suggested gas price
 	GasPrice(ctx context.Context) (uint64, error)
 
+	// FeeHistory returns the base fees, gas used ratios and priority fees at the given percentiles of the
+	// blockCount blocks up to newestBlock
+	FeeHistory(ctx context.Context, blockCount uint64, newestBlock eth.BlockNumberOrTag, rewardPercentiles []float64) (*eth.FeeHistory, error)
+
 	// GetTransactionCount get the pending nonce for public address
 	GetTransactionCount(ctx context.Context, address eth.Address, numberOrTag eth.BlockNumberOrTag) (uint64, error)
 
+	// GetBalance returns the balance in wei of address at the given block
+	GetBalance(ctx context.Context, address eth.Address, numberOrTag eth.BlockNumberOrTag) (eth.Quantity, error)
+
 	// SendRawTransaction will send the raw signed transaction return tx hash or error
 	SendRawTransaction(ctx context.Context, msg string) (string, error)
 
@@ -61,6 +96,9 @@ type Client interface {
 	// BlockByHash can be used to get a block by its hash
 	BlockByHash(ctx context.Context, hash string, full bool) (*eth.Block, error)
 
+	// UncleByBlockHashAndIndex can be used to get the uncle at index of the block with hash
+	UncleByBlockHashAndIndex(ctx context.Context, hash string, index uint64) (*eth.Uncle, error)
+
 	// TransactionByHash can be used to get transaction by its hash
 	TransactionByHash(ctx context.Context, hash string) (*eth.Transaction, error)
 
@@ -70,6 +108,17 @@ type Client interface {
 	// SubscribeNewPendingTransactions initiates a subscription for newPendingTransaction events
 	SubscribeNewPendingTransactions(ctx context.Context) (Subscription, error)
 
+	// SubscribeLogs initiates a subscription for logs matching filter, delivering decoded logs including those
+	// removed by a reorg
+	SubscribeLogs(ctx context.Context, filter eth.LogFilter) (LogsSubscription, error)
+
+	// SubscribeSyncing initiates a subscription for changes to the node's sync status
+	SubscribeSyncing(ctx context.Context) (SyncingSubscription, error)
+
+	// GetProof returns the account and storage values of address at block along with their Merkle proofs, which
+	// can be checked against the state root of the block with AccountResult.Verify
+	GetProof(ctx context.Context, address eth.Address, storageKeys []eth.Data32, block eth.BlockSpecifier) (*eth.AccountResult, error)
+
 	// TransactionReceipt can be used to get a TransactionReceipt for a particular transaction
 	TransactionReceipt(ctx context.Context, hash string) (*eth.TransactionReceipt, error)
 
@@ -78,6 +127,9 @@ type Client interface {
 
 	// IsBidirectional returns true if the under laying transport supports bidirectional features such as subscriptions
 	IsBidirectional() bool
+
+	// SupportsSubscriptions returns true if Subscribe can be used, either natively or emulated by polling over HTTP
+	SupportsSubscriptions() bool
 }
 
 type Subscription interface {
@@ -86,3 +138,19 @@ type Subscription interface {
 	Ch() <-chan *jsonrpc.Notification
 	Unsubscribe(ctx context.Context) error
 }
+
+// LogsSubscription is a logs subscription whose notifications are decoded into eth.Log values
+type LogsSubscription interface {
+	Response() *jsonrpc.RawResponse
+	ID() string
+	Ch() <-chan eth.Log
+	Unsubscribe(ctx context.Context) error
+}
+
+// SyncingSubscription is a syncing subscription whose notifications are decoded into eth.SyncStatus values
+type SyncingSubscription interface {
+	Response() *jsonrpc.RawResponse
+	ID() string
+	Ch() <-chan eth.SyncStatus
+	Unsubscribe(ctx context.Context) error
+}
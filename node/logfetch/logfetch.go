@@ -0,0 +1,318 @@
+// Package logfetch fetches the logs of large block ranges by splitting them into chunks that nodes and
+// providers accept, bisecting chunks that still exceed their limits.
+package logfetch
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+)
+
+const (
+	// DefaultChunkSize is the number of blocks requested per eth_getLogs call unless configured otherwise
+	DefaultChunkSize = 2000
+	// DefaultConcurrency is the number of eth_getLogs calls made in parallel unless configured otherwise
+	DefaultConcurrency = 4
+)
+
+// Config configures a Fetcher, the zero value is valid
+type Config struct {
+	// ChunkSize is the number of blocks requested at once before any bisection, defaults to DefaultChunkSize
+	ChunkSize uint64
+
+	// Concurrency bounds the number of chunks fetched in parallel, defaults to DefaultConcurrency
+	Concurrency int
+}
+
+// Fetcher fetches logs through a node.Client, splitting block ranges as needed
+type Fetcher struct {
+	client node.Client
+	config Config
+}
+
+// New returns a Fetcher using client
+func New(client node.Client, config Config) *Fetcher {
+	if config.ChunkSize == 0 {
+		config.ChunkSize = DefaultChunkSize
+	}
+
+	if config.Concurrency <= 0 {
+		config.Concurrency = DefaultConcurrency
+	}
+
+	return &Fetcher{client: client, config: config}
+}
+
+// Logs returns the logs matching filter ordered by block number and log index, without duplicates.  Tags
+// in FromBlock and ToBlock are resolved to block numbers first, and missing ones default to latest.  The range
+// is split into chunks, and chunks rejected for returning too many results or covering too many blocks are
+// bisected until they succeed or only cover a single block.
+func (f *Fetcher) Logs(ctx context.Context, filter eth.LogFilter) ([]eth.Log, error) {
+	if filter.BlockHash != nil {
+		return f.client.Logs(ctx, filter)
+	}
+
+	from, err := f.resolve(ctx, filter.FromBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := f.resolve(ctx, filter.ToBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if from > to {
+		return []eth.Log{}, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		results  []eth.Log
+	)
+
+	sem := make(chan struct{}, f.config.Concurrency)
+	for start := from; start <= to; start += f.config.ChunkSize {
+		end := start + f.config.ChunkSize - 1
+		if end > to || end < start {
+			end = to
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(start, end uint64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			logs, err := f.fetch(ctx, filter, start, end)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+
+			results = append(results, logs...)
+		}(start, end)
+
+		if end == to {
+			break
+		}
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return merge(results), nil
+}
+
+// fetch returns the logs of blocks start through end, bisecting the range on limit errors
+func (f *Fetcher) fetch(ctx context.Context, filter eth.LogFilter, start, end uint64) ([]eth.Log, error) {
+	filter.FromBlock = blockNumber(start)
+	filter.ToBlock = blockNumber(end)
+
+	logs, err := f.client.Logs(ctx, filter)
+	if err == nil {
+		return logs, nil
+	}
+
+	if start == end || !IsLimitError(err) {
+		return nil, errors.Wrapf(err, "could not get logs for blocks %d to %d", start, end)
+	}
+
+	mid := start + (end-start)/2
+	left, err := f.fetch(ctx, filter, start, mid)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := f.fetch(ctx, filter, mid+1, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(left, right...), nil
+}
+
+// resolve returns the block number a BlockNumberOrTag refers to, nil meaning latest
+func (f *Fetcher) resolve(ctx context.Context, b *eth.BlockNumberOrTag) (uint64, error) {
+	if q, ok := b.Quantity(); ok {
+		return q.UInt64(), nil
+	}
+
+	tag, ok := b.Tag()
+	if !ok {
+		tag = eth.TagLatest
+	}
+
+	switch tag {
+	case eth.TagEarliest:
+		return 0, nil
+	case eth.TagLatest:
+		return f.client.BlockNumber(ctx)
+	}
+
+	block, err := f.client.BlockByNumberOrTag(ctx, *eth.MustBlockNumberOrTag(tag.String()), false)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not resolve %s block", tag)
+	}
+
+	if block.Number == nil {
+		return 0, errors.Errorf("%s block has no number", tag)
+	}
+
+	return block.Number.UInt64(), nil
+}
+
+// limitCodes are the JSONRPC error codes of limit errors, which providers also use for rate limits so they're
+// only limit errors when their message doesn't mention a rate limit
+var limitCodes = map[jsonrpc.ErrorCode]bool{
+	jsonrpc.ErrCodeLimitExceeded: true,
+}
+
+// errorCode matches the code of a JSONRPC error object returned as the text of an error
+var errorCode = regexp.MustCompile(`"code"\s*:\s*(-?\d+)`)
+
+// rateLimitMessages are fragments of the (lower cased) errors of rate limits sharing a limit code
+var rateLimitMessages = []string{
+	"rate limit",
+	"request rate",
+	"request count",
+	"too many requests",
+	"slow down",
+}
+
+// limitMessages are fragments of the (lower cased) errors returned by nodes and providers when an eth_getLogs
+// request matches too many logs or spans too many blocks, whatever their code
+var limitMessages = []string{
+	"query returned more than", // geth, erigon and infura: query returned more than 10000 results
+	"query exceeds max",        // erigon: query exceeds max block range / max results
+	"exceed maximum block range",
+	"block range is too wide",
+	"block range too large",
+	"range is too large",
+	"range limit",     // besu: requested range exceeds maximum RPC range limit
+	"is limited to a", // quicknode: eth_getLogs is limited to a 10,000 range
+	"response size exceeded",
+	"too many results",
+	"too many blocks",
+	"max results",
+}
+
+// IsLimitError returns true if err looks like a node or provider refusing an eth_getLogs request because it
+// matches too many logs or spans too many blocks, in which case a smaller range should be requested.  Errors are
+// recognized by their message, or by the -32005 limit exceeded code.  Rate limits are not limit errors, they should be retried with a backoff instead, see node.NewRetryInterceptor.
+func IsLimitError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, fragment := range limitMessages {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+
+	if !limitCodes[code(err)] {
+		return false
+	}
+
+	for _, fragment := range rateLimitMessages {
+		if strings.Contains(msg, fragment) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// code returns the JSONRPC error code of err, which is either a jsonrpc.Error or has the text of an error object
+func code(err error) jsonrpc.ErrorCode {
+	if e, ok := errors.Cause(err).(*jsonrpc.Error); ok {
+		return e.Code
+	}
+
+	match := errorCode.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0
+	}
+
+	c, _ := strconv.Atoi(match[1])
+	return jsonrpc.ErrorCode(c)
+}
+
+// merge sorts logs by block number and log index and drops duplicates
+func merge(logs []eth.Log) []eth.Log {
+	sort.SliceStable(logs, func(i, j int) bool {
+		bi, bj := quantity(logs[i].BlockNumber), quantity(logs[j].BlockNumber)
+		if bi != bj {
+			return bi < bj
+		}
+		return quantity(logs[i].LogIndex) < quantity(logs[j].LogIndex)
+	})
+
+	out := make([]eth.Log, 0, len(logs))
+	seen := make(map[string]struct{}, len(logs))
+	for _, l := range logs {
+		key := ""
+		if l.BlockHash != nil {
+			key = l.BlockHash.String()
+		}
+		key += "/" + eth.QuantityFromUInt64(quantity(l.LogIndex)).String()
+
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		out = append(out, l)
+	}
+
+	return out
+}
+
+func quantity(q *eth.Quantity) uint64 {
+	if q == nil {
+		return 0
+	}
+
+	return q.UInt64()
+}
+
+func blockNumber(number uint64) *eth.BlockNumberOrTag {
+	return eth.MustBlockNumberOrTag(eth.QuantityFromUInt64(number).String())
+}
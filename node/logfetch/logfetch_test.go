@@ -0,0 +1,129 @@
+package logfetch_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/logfetch"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+)
+
+const logTemplate = `{"address":"0x8b406b4708a45f115347fc2d020735196f994c5f","blockHash":"0x%064x","blockNumber":"0x%x","data":"0x","logIndex":"0x%x","removed":false,"topics":[],"transactionHash":"0x9cd71724c1bad4c8e09a52b5bc1d8f037d5c08f4b78626236110ce5e6e1e8cfb","transactionIndex":"0x0"}`
+
+// newClient serves two logs per block and rejects eth_getLogs ranges of more than maxRange blocks
+func newClient(t *testing.T, maxRange uint64, ranges *[]string) node.Client {
+	mu := sync.Mutex{}
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		switch r.Method {
+		case "eth_blockNumber":
+			return nodetest.Result(r, `"0x64"`), nil
+		case "eth_getBlockByNumber":
+			return nodetest.Result(r, `{"number":"0x50","hash":"0x2cdf35a15eaab70f694b1ef15b6375793848336e00b76b0551082b1fb6130ccd","transactions":[],"uncles":[]}`), nil
+		case "eth_getLogs":
+			filter := eth.LogFilter{}
+			require.NoError(t, r.Params.UnmarshalSingleParam(0, &filter))
+			from, _ := filter.FromBlock.Quantity()
+			to, _ := filter.ToBlock.Quantity()
+
+			mu.Lock()
+			*ranges = append(*ranges, fmt.Sprintf("%d-%d", from.UInt64(), to.UInt64()))
+			mu.Unlock()
+
+			if to.UInt64()-from.UInt64()+1 > maxRange {
+				return nodetest.Error(r, `{"code":-32005,"message":"query returned more than 10000 results"}`), nil
+			}
+
+			logs := make([]string, 0)
+			for n := from.UInt64(); n <= to.UInt64(); n++ {
+				// returned in reverse order to check that results are sorted
+				logs = append(logs, fmt.Sprintf(logTemplate, n, n, 2*n+1), fmt.Sprintf(logTemplate, n, n, 2*n))
+			}
+			return nodetest.Result(r, "["+strings.Join(logs, ",")+"]"), nil
+		}
+
+		return nodetest.Result(r, "null"), nil
+	})
+
+	client := nodetest.NewClient(t, requester, nil)
+	return client
+}
+
+func TestFetcher_Logs(t *testing.T) {
+	ctx := context.Background()
+
+	var ranges []string
+	fetcher := logfetch.New(newClient(t, 3, &ranges), logfetch.Config{ChunkSize: 10, Concurrency: 2})
+
+	logs, err := fetcher.Logs(ctx, eth.LogFilter{
+		FromBlock: eth.MustBlockNumberOrTag("0x5a"),
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 2*11, "blocks 90 to 100 (latest)")
+
+	for i, l := range logs {
+		require.Equal(t, uint64(90+i/2), l.BlockNumber.UInt64())
+		require.Equal(t, uint64(180+i), l.LogIndex.UInt64())
+	}
+
+	require.Contains(t, ranges, "90-99")
+	require.Contains(t, ranges, "90-94", "rejected chunks are bisected")
+	require.Contains(t, ranges, "100-100")
+
+	ranges = nil
+	logs, err = fetcher.Logs(ctx, eth.LogFilter{
+		FromBlock: eth.MustBlockNumberOrTag("finalized"),
+		ToBlock:   eth.MustBlockNumberOrTag("0x51"),
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 4, "finalized resolves to block 80")
+	require.Equal(t, []string{"80-81"}, ranges)
+}
+
+func TestFetcher_Logs_SingleBlockLimit(t *testing.T) {
+	var ranges []string
+	fetcher := logfetch.New(newClient(t, 0, &ranges), logfetch.Config{})
+
+	_, err := fetcher.Logs(context.Background(), eth.LogFilter{
+		FromBlock: eth.MustBlockNumberOrTag("0x1"),
+		ToBlock:   eth.MustBlockNumberOrTag("0x2"),
+	})
+	require.Error(t, err, "a single block that exceeds the limit can't be split further")
+	require.True(t, logfetch.IsLimitError(err))
+}
+
+func TestIsLimitError(t *testing.T) {
+	limits := []string{
+		`{"code":-32005,"message":"query returned more than 10000 results"}`,
+		`{"code":-32602,"message":"Log response size exceeded. You can make eth_getLogs requests with up to a 2K block range and no limit on the response size"}`,
+		`{"code":-32000,"message":"exceed maximum block range: 5000"}`,
+		`{"code":-32000,"message":"query exceeds max block range 1000"}`,
+		`{"code":-32600,"message":"block range is too wide"}`,
+		`{"code":-32005,"message":"Requested range exceeds maximum RPC range limit"}`,
+		`{"code":-32005,"message":"limit exceeded"}`,
+		`{"code": -32005, "message": "too much data"}`,
+	}
+
+	for _, limit := range limits {
+		require.True(t, logfetch.IsLimitError(errors.New(limit)), limit)
+	}
+
+	require.True(t, logfetch.IsLimitError(errors.Wrap(jsonrpc.NewError(-32005, "query returned more than 10000 results"), "wrapped")))
+	require.True(t, logfetch.IsLimitError(errors.Wrap(jsonrpc.NewError(jsonrpc.ErrCodeLimitExceeded, "limit exceeded"), "wrapped")))
+
+	// rate limits share the -32005 code but must be retried rather than split
+	require.False(t, logfetch.IsLimitError(errors.Wrap(jsonrpc.NewError(-32005, "slow down"), "wrapped")))
+	require.False(t, logfetch.IsLimitError(errors.New(`{"code":-32005,"message":"too many requests"}`)))
+	require.False(t, logfetch.IsLimitError(errors.New(`{"code":-32000,"message":"limit exceeded"}`)))
+	require.False(t, logfetch.IsLimitError(errors.New(`{"code":-32005,"message":"daily request count exceeded, request rate limited"}`)))
+	require.False(t, logfetch.IsLimitError(errors.New(`{"code":-32000,"message":"header not found"}`)))
+	require.False(t, logfetch.IsLimitError(nil))
+}
@@ -0,0 +1,87 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+)
+
+// Logger is the minimal structured logger used by NewLoggingInterceptor.  The keyvals are alternating
+// keys and values, for example: logger.Log("request", "method", "eth_blockNumber", "duration", d)
+type Logger interface {
+	Log(msg string, keyvals ...interface{})
+}
+
+// LoggerFunc can be used to convert a function into a Logger
+type LoggerFunc func(msg string, keyvals ...interface{})
+
+func (f LoggerFunc) Log(msg string, keyvals ...interface{}) {
+	f(msg, keyvals...)
+}
+
+// NewStdLogger returns a Logger that writes to the passed in *log.Logger, or the standard logger
+// if it is nil, formatting the keyvals as key=value pairs.
+func NewStdLogger(l *log.Logger) Logger {
+	return LoggerFunc(func(msg string, keyvals ...interface{}) {
+		b := strings.Builder{}
+		b.WriteString(msg)
+		for i := 0; i < len(keyvals); i += 2 {
+			var v interface{} = "(MISSING)"
+			if i+1 < len(keyvals) {
+				v = keyvals[i+1]
+			}
+			b.WriteString(fmt.Sprintf(" %v=%v", keyvals[i], v))
+		}
+
+		if l == nil {
+			log.Print(b.String())
+			return
+		}
+		l.Print(b.String())
+	})
+}
+
+// NewLoggingInterceptor returns an Interceptor that logs every request with its duration and outcome, every
+// subscription request, and every subscription notification received.
+func NewLoggingInterceptor(logger Logger) Interceptor {
+	return Interceptor{
+		Request: func(ctx context.Context, r *jsonrpc.Request, next RequestFunc) (*jsonrpc.RawResponse, error) {
+			start := time.Now()
+			response, err := next(ctx, r)
+			keyvals := []interface{}{"method", r.Method, "id", r.ID.String(), "duration", time.Since(start)}
+
+			switch {
+			case err != nil:
+				logger.Log("[WARN] request failed", append(keyvals, "error", err)...)
+			case response != nil && response.Error != nil:
+				e := ResponseError(response)
+				logger.Log("[WARN] request returned error", append(keyvals, "code", e.Code, "error", e.Message)...)
+			default:
+				logger.Log("[DEBUG] request", keyvals...)
+			}
+
+			return response, err
+		},
+		Subscribe: func(ctx context.Context, r *jsonrpc.Request, next SubscribeFunc) (Subscription, error) {
+			start := time.Now()
+			sub, err := next(ctx, r)
+			keyvals := []interface{}{"method", r.Method, "type", subscriptionKind(r), "duration", time.Since(start)}
+
+			if err != nil {
+				logger.Log("[WARN] subscribe failed", append(keyvals, "error", err)...)
+				return sub, err
+			}
+
+			logger.Log("[DEBUG] subscribed", append(keyvals, "subscription", sub.ID())...)
+			return sub, err
+		},
+		Notification: func(ctx context.Context, r *jsonrpc.Request, sub Subscription, n *jsonrpc.Notification) *jsonrpc.Notification {
+			logger.Log("[DEBUG] notification", "method", n.Method, "type", subscriptionKind(r), "subscription", sub.ID())
+			return n
+		},
+	}
+}
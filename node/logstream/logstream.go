@@ -0,0 +1,496 @@
+// Package logstream delivers every log matching a filter once it is buried under a number of confirmations,
+// backfilling history before following the head of the chain, and retracting logs that a reorg removed
+// after they had been delivered.
+package logstream
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/logfetch"
+	"github.com/INFURA/go-ethlibs/node/tracker"
+)
+
+// DefaultBatchSize is the number of blocks backfilled between checkpoints
+const DefaultBatchSize = 1000
+
+// maxRangeAttempts is how many times a range is fetched again when its logs don't match the canonical chain
+const maxRangeAttempts = 3
+
+var errNotCanonical = errors.New("log does not belong to the canonical block")
+
+// Config configures a Streamer
+type Config struct {
+	// Filter selects the logs to deliver.  FromBlock is where streaming starts when the store has no checkpoint,
+	// and defaults to the most recent confirmed block.  ToBlock is ignored and BlockHash must not be set.
+	Filter eth.LogFilter
+
+	// Confirmations is the number of blocks that must be built on top of a block before its logs are delivered
+	Confirmations uint64
+
+	// Store persists the checkpoint, defaults to NewMemoryStore()
+	Store CheckpointStore
+
+	// BatchSize is the number of blocks backfilled between checkpoints, defaults to DefaultBatchSize.  Batches
+	// are fetched with a logfetch.Fetcher, which splits them further if the node rejects them.
+	BatchSize uint64
+
+	// WindowSize is the number of recent blocks tracked to detect reorgs, defaults to tracker.DefaultWindowSize
+	WindowSize int
+}
+
+// Streamer delivers logs matching a filter in block order.  Logs removed by a reorg after being delivered
+// are delivered again with Removed set, in reverse order, before the logs of the new canonical blocks.
+//
+// The checkpoint is saved once the logs of a block (or of a batch of blocks while backfilling) have been
+// received from the Logs channel, so after a crash the logs received since the last save are delivered again.
+type Streamer struct {
+	client  node.Client
+	config  Config
+	tracker *tracker.Tracker
+	fetcher *logfetch.Fetcher
+	logs    chan eth.Log
+
+	checkpoint *Checkpoint
+	next       uint64
+	delivered  map[uint64]deliveredBlock
+	removed    map[eth.Hash]eth.NewHeadsResult
+}
+
+type deliveredBlock struct {
+	hash   eth.Hash
+	parent eth.Hash
+	logs   []eth.Log
+}
+
+// New returns a Streamer reading from client, see Run.
+func New(client node.Client, config Config) (*Streamer, error) {
+	if config.Filter.BlockHash != nil {
+		return nil, errors.New("log filters with a block hash cannot be streamed")
+	}
+
+	if config.Store == nil {
+		config.Store = NewMemoryStore()
+	}
+
+	if config.BatchSize == 0 {
+		config.BatchSize = DefaultBatchSize
+	}
+
+	if config.WindowSize <= 0 {
+		config.WindowSize = tracker.DefaultWindowSize
+	}
+
+	return &Streamer{
+		client:    client,
+		config:    config,
+		tracker:   tracker.New(client, tracker.Config{WindowSize: config.WindowSize, DisableFinality: true}),
+		fetcher:   logfetch.New(client, logfetch.Config{}),
+		logs:      make(chan eth.Log),
+		delivered: make(map[uint64]deliveredBlock),
+		removed:   make(map[eth.Hash]eth.NewHeadsResult),
+	}, nil
+}
+
+// Logs returns the channel logs are delivered on, which is closed when Run returns
+func (s *Streamer) Logs() <-chan eth.Log {
+	return s.logs
+}
+
+// Run resumes from the saved checkpoint, backfills the logs up to the most recent confirmed block and then
+// follows the head of the chain until ctx is done or an error occurs.
+func (s *Streamer) Run(ctx context.Context) error {
+	defer close(s.logs)
+
+	if err := s.start(ctx); err != nil {
+		return err
+	}
+
+	head, err := s.client.BlockNumber(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head block number")
+	}
+
+	if err := s.advance(ctx, head); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.tracker.Run(ctx)
+	}()
+
+	for {
+		select {
+		case err := <-errCh:
+			return err
+		case event := <-s.tracker.Events():
+			switch event.Type {
+			case tracker.EventRemoved:
+				for _, block := range event.Blocks {
+					s.removed[block.Hash] = block
+				}
+
+				if s.checkpoint != nil && event.Blocks[len(event.Blocks)-1].Number.UInt64() <= s.checkpoint.Number {
+					if err := s.reconcile(ctx); err != nil {
+						return err
+					}
+				}
+			case tracker.EventNewHead:
+				if err := s.advance(ctx, event.Blocks[0].Number.UInt64()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// start loads the checkpoint or works out where to start from the filter
+func (s *Streamer) start(ctx context.Context) error {
+	checkpoint, err := s.config.Store.Load(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not load checkpoint")
+	}
+
+	if checkpoint != nil {
+		s.checkpoint = checkpoint
+		s.next = checkpoint.Number + 1
+		return nil
+	}
+
+	from := uint64(0)
+	if q, ok := s.config.Filter.FromBlock.Quantity(); ok {
+		from = q.UInt64()
+	} else if tag, ok := s.config.Filter.FromBlock.Tag(); !ok || tag != eth.TagEarliest {
+		head, err := s.client.BlockNumber(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get head block number")
+		}
+
+		if head > s.config.Confirmations {
+			from = head - s.config.Confirmations
+		}
+	}
+
+	s.next = from
+	if from == 0 {
+		return nil
+	}
+
+	hash, err := s.canonicalHash(ctx, from-1)
+	if err != nil {
+		return err
+	}
+
+	s.checkpoint = &Checkpoint{Number: from - 1, Hash: hash}
+	return nil
+}
+
+// advance delivers the logs of every block confirmed by head that hasn't been delivered yet
+func (s *Streamer) advance(ctx context.Context, head uint64) error {
+	if head < s.config.Confirmations {
+		return nil
+	}
+
+	confirmed := head - s.config.Confirmations
+	for s.next <= confirmed {
+		if _, ok := s.tracker.BlockByNumber(s.next); ok {
+			if err := s.deliverBlock(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := s.reconcile(ctx); err != nil {
+			return err
+		}
+
+		to := s.next + s.config.BatchSize - 1
+		if to > confirmed {
+			to = confirmed
+		}
+
+		if err := s.deliverRange(ctx, to); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliverBlock delivers the logs of block s.next, which must be in the tracker's window, by its hash
+func (s *Streamer) deliverBlock(ctx context.Context) error {
+	block, _ := s.tracker.BlockByNumber(s.next)
+	if s.checkpoint != nil && block.ParentHash != s.checkpoint.Hash {
+		// the checkpoint isn't an ancestor of the canonical chain anymore, step back until it is
+		return s.retract(ctx)
+	}
+
+	filter := s.filter()
+	filter.BlockHash = &block.Hash
+
+	logs, err := s.client.Logs(ctx, filter)
+	if err != nil {
+		return errors.Wrapf(err, "could not get logs for block %s", block.Hash.String())
+	}
+
+	if err := s.emit(ctx, logs, false); err != nil {
+		return err
+	}
+
+	s.delivered[s.next] = deliveredBlock{hash: block.Hash, parent: block.ParentHash, logs: logs}
+	return s.save(ctx, Checkpoint{Number: s.next, Hash: block.Hash})
+}
+
+// deliverRange delivers the logs of blocks s.next through to, splitting the range if the node rejects it.
+// The range is fetched again if the node returned logs of blocks that aren't canonical, e.g. during a reorg.
+func (s *Streamer) deliverRange(ctx context.Context, to uint64) error {
+	filter := s.filter()
+	filter.FromBlock = blockNumber(s.next)
+	filter.ToBlock = blockNumber(to)
+
+	var logs []eth.Log
+	var blocks map[uint64]deliveredBlock
+	for attempt := 1; ; attempt++ {
+		var err error
+		logs, err = s.fetcher.Logs(ctx, filter)
+		if err != nil {
+			return errors.Wrapf(err, "could not get logs for blocks %d to %d", s.next, to)
+		}
+
+		blocks, err = s.canonicalBlocks(ctx, logs, to)
+		if err == nil {
+			break
+		}
+
+		if errors.Cause(err) != errNotCanonical || attempt == maxRangeAttempts {
+			return err
+		}
+	}
+
+	if err := s.emit(ctx, logs, false); err != nil {
+		return err
+	}
+
+	for _, l := range logs {
+		number := l.BlockNumber.UInt64()
+		d := blocks[number]
+		d.logs = append(d.logs, l)
+		blocks[number] = d
+	}
+
+	for number, d := range blocks {
+		s.delivered[number] = d
+	}
+
+	return s.save(ctx, Checkpoint{Number: to, Hash: blocks[to].hash})
+}
+
+// canonicalBlocks looks up the canonical blocks of logs and of block to, returning errNotCanonical if any
+// log belongs to a different block than the canonical one with its number
+func (s *Streamer) canonicalBlocks(ctx context.Context, logs []eth.Log, to uint64) (map[uint64]deliveredBlock, error) {
+	numbers := []uint64{to}
+	for _, l := range logs {
+		numbers = append(numbers, l.BlockNumber.UInt64())
+	}
+
+	blocks := make(map[uint64]deliveredBlock)
+	for _, number := range numbers {
+		if _, ok := blocks[number]; ok {
+			continue
+		}
+
+		hash, parent, err := s.canonicalBlock(ctx, number)
+		if err != nil {
+			return nil, err
+		}
+
+		blocks[number] = deliveredBlock{hash: hash, parent: parent}
+	}
+
+	for _, l := range logs {
+		number := l.BlockNumber.UInt64()
+		if l.BlockHash == nil || *l.BlockHash != blocks[number].hash {
+			return nil, errors.Wrapf(errNotCanonical, "block %d", number)
+		}
+	}
+
+	return blocks, nil
+}
+
+// reconcile retracts delivered blocks until the checkpoint is part of the canonical chain again
+func (s *Streamer) reconcile(ctx context.Context) error {
+	for s.checkpoint != nil {
+		hash, err := s.canonicalHash(ctx, s.checkpoint.Number)
+		if err != nil {
+			return err
+		}
+
+		if hash == s.checkpoint.Hash {
+			return nil
+		}
+
+		if err := s.retract(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// retract delivers the logs of the checkpoint block again as removed and moves the checkpoint to its parent
+func (s *Streamer) retract(ctx context.Context) error {
+	checkpoint := *s.checkpoint
+
+	logs := s.delivered[checkpoint.Number].logs
+	if d, ok := s.delivered[checkpoint.Number]; !ok || d.hash != checkpoint.Hash {
+		// the logs were delivered before a restart, or as part of a range without being recorded, so ask the
+		// node for them, which only works as long as it still has the block that was reorged out
+		filter := s.filter()
+		filter.BlockHash = &checkpoint.Hash
+
+		var err error
+		logs, err = s.client.Logs(ctx, filter)
+		if err != nil {
+			log.Printf("[WARN] could not get logs to retract for block %s: %v", checkpoint.Hash.String(), err)
+			logs = nil
+		}
+	}
+
+	retracted := make([]eth.Log, len(logs))
+	for i := range logs {
+		retracted[len(logs)-1-i] = logs[i]
+	}
+
+	if err := s.emit(ctx, retracted, true); err != nil {
+		return err
+	}
+
+	if checkpoint.Number == 0 {
+		delete(s.delivered, checkpoint.Number)
+		s.checkpoint = nil
+		s.next = 0
+		return nil
+	}
+
+	parent, err := s.parentHash(ctx, checkpoint)
+	if err != nil {
+		return err
+	}
+
+	delete(s.delivered, checkpoint.Number)
+	s.next = checkpoint.Number
+	return s.save(ctx, Checkpoint{Number: checkpoint.Number - 1, Hash: parent})
+}
+
+// parentHash returns the parent of the checkpoint block, which has been reorged out, from the delivery record
+// or the blocks removed from the tracker's window.  The node is only asked when neither has it, e.g. after a
+// restart, as it may no longer serve a block that isn't canonical.
+func (s *Streamer) parentHash(ctx context.Context, checkpoint Checkpoint) (eth.Hash, error) {
+	if d, ok := s.delivered[checkpoint.Number]; ok && d.hash == checkpoint.Hash && d.parent != "" {
+		return d.parent, nil
+	}
+
+	if block, ok := s.removed[checkpoint.Hash]; ok {
+		return block.ParentHash, nil
+	}
+
+	if block, ok := s.tracker.BlockByHash(checkpoint.Hash); ok {
+		return block.ParentHash, nil
+	}
+
+	block, err := s.client.BlockByHash(ctx, checkpoint.Hash.String(), false)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not get reorged block %s", checkpoint.Hash.String())
+	}
+
+	return block.ParentHash, nil
+}
+
+func (s *Streamer) emit(ctx context.Context, logs []eth.Log, removed bool) error {
+	if !removed {
+		sort.SliceStable(logs, func(i, j int) bool {
+			bi, bj := logs[i].BlockNumber.UInt64(), logs[j].BlockNumber.UInt64()
+			if bi != bj {
+				return bi < bj
+			}
+			return logs[i].LogIndex.UInt64() < logs[j].LogIndex.UInt64()
+		})
+	}
+
+	for _, l := range logs {
+		l.Removed = removed
+		select {
+		case s.logs <- l:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// save records checkpoint as the last delivered block
+func (s *Streamer) save(ctx context.Context, checkpoint Checkpoint) error {
+	s.checkpoint = &checkpoint
+	s.next = checkpoint.Number + 1
+
+	// only blocks that can still be reorged out by the tracker's window need to be remembered
+	for number := range s.delivered {
+		if number+uint64(s.config.WindowSize) <= checkpoint.Number {
+			delete(s.delivered, number)
+		}
+	}
+
+	for hash, block := range s.removed {
+		if block.Number.UInt64()+uint64(s.config.WindowSize) <= checkpoint.Number {
+			delete(s.removed, hash)
+		}
+	}
+
+	if err := s.config.Store.Save(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save checkpoint")
+	}
+
+	return nil
+}
+
+// canonicalHash returns the hash of the canonical block with the given number
+func (s *Streamer) canonicalHash(ctx context.Context, number uint64) (eth.Hash, error) {
+	hash, _, err := s.canonicalBlock(ctx, number)
+	return hash, err
+}
+
+// canonicalBlock returns the hash and parent hash of the canonical block with the given number
+func (s *Streamer) canonicalBlock(ctx context.Context, number uint64) (eth.Hash, eth.Hash, error) {
+	if block, ok := s.tracker.BlockByNumber(number); ok {
+		return block.Hash, block.ParentHash, nil
+	}
+
+	block, err := s.client.BlockByNumber(ctx, number, false)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "could not get block %d", number)
+	}
+
+	return *block.Hash, block.ParentHash, nil
+}
+
+// filter returns a copy of the configured filter without any block range
+func (s *Streamer) filter() eth.LogFilter {
+	return eth.LogFilter{
+		Address: s.config.Filter.Address,
+		Topics:  s.config.Filter.Topics,
+	}
+}
+
+func blockNumber(number uint64) *eth.BlockNumberOrTag {
+	return eth.MustBlockNumberOrTag(eth.QuantityFromUInt64(number).String())
+}
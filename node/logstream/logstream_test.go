@@ -0,0 +1,230 @@
+package logstream_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/logstream"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+)
+
+const blockTemplate = `{"difficulty":"0x0","extraData":"0x","gasLimit":"0x1c9c380","gasUsed":"0x0","hash":"%s","logsBloom":"0x%0512x","miner":"0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c","mixHash":"0x%064x","nonce":"0x0000000000000000","number":"0x%x","parentHash":"%s","receiptsRoot":"0x%064x","sha3Uncles":"0x%064x","size":"0x220","stateRoot":"0x%064x","timestamp":"0x5b541449","totalDifficulty":"0x0","transactions":[],"transactionsRoot":"0x%064x","uncles":[]}`
+
+const logTemplate = `{"address":"0x8b406b4708a45f115347fc2d020735196f994c5f","blockHash":"%s","blockNumber":"0x%x","data":"0x","logIndex":"0x0","removed":false,"topics":[],"transactionHash":"0x9cd71724c1bad4c8e09a52b5bc1d8f037d5c08f4b78626236110ce5e6e1e8cfb","transactionIndex":"0x0"}`
+
+type subscription struct {
+	ch   chan *jsonrpc.Notification
+	once sync.Once
+}
+
+func (s *subscription) Response() *jsonrpc.RawResponse   { return nil }
+func (s *subscription) ID() string                       { return "0x1" }
+func (s *subscription) Ch() <-chan *jsonrpc.Notification { return s.ch }
+func (s *subscription) Unsubscribe(ctx context.Context) error {
+	s.once.Do(func() { close(s.ch) })
+	return nil
+}
+
+// chain is the original chain, fork 0, plus a fork 1 that branches off after block branch
+type chain struct {
+	mu     sync.Mutex
+	branch uint64
+	fork   int
+	head   uint64
+
+	// pruned makes the node forget blocks as soon as they are reorged out
+	pruned bool
+
+	// stale is the number of ranged eth_getLogs requests still to be answered with logs of an abandoned fork
+	stale int
+}
+
+func (c *chain) forkOf(number uint64, fork int) int {
+	if number <= c.branch {
+		return 0
+	}
+	return fork
+}
+
+func (c *chain) block(number uint64, fork int) string {
+	parent := fmt.Sprintf("0x%064x", 0)
+	if number > 0 {
+		parent = nodetest.Hash(number-1, c.forkOf(number-1, fork))
+	}
+
+	return fmt.Sprintf(blockTemplate, nodetest.Hash(number, fork), 0, 0, number, parent, 0, 0, 0, 0)
+}
+
+func (c *chain) client(t *testing.T, sub *subscription) node.Client {
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		result := "null"
+		switch r.Method {
+		case "eth_blockNumber":
+			result = fmt.Sprintf(`"0x%x"`, c.head)
+		case "eth_getBlockByNumber":
+			q := eth.Quantity{}
+			require.NoError(t, r.Params.UnmarshalSingleParam(0, &q))
+			result = c.block(q.UInt64(), c.forkOf(q.UInt64(), c.fork))
+		case "eth_getBlockByHash":
+			h := ""
+			require.NoError(t, r.Params.UnmarshalSingleParam(0, &h))
+			number, fork, err := nodetest.ParseHash(h)
+			require.NoError(t, err)
+			if !c.pruned || fork == c.forkOf(number, c.fork) {
+				result = c.block(number, fork)
+			}
+		case "eth_getLogs":
+			filter := eth.LogFilter{}
+			require.NoError(t, r.Params.UnmarshalSingleParam(0, &filter))
+			result = "["
+			if filter.BlockHash != nil {
+				number, fork, err := nodetest.ParseHash(filter.BlockHash.String())
+				require.NoError(t, err)
+				result += fmt.Sprintf(logTemplate, nodetest.Hash(number, fork), number)
+			} else {
+				stale := c.stale > 0
+				if stale {
+					c.stale--
+				}
+
+				from, _ := filter.FromBlock.Quantity()
+				to, _ := filter.ToBlock.Quantity()
+				for n := from.UInt64(); n <= to.UInt64(); n++ {
+					if n > from.UInt64() {
+						result += ","
+					}
+
+					fork := c.forkOf(n, c.fork)
+					if stale {
+						fork = 9
+					}
+					result += fmt.Sprintf(logTemplate, nodetest.Hash(n, fork), n)
+				}
+			}
+			result += "]"
+		}
+
+		return nodetest.Result(r, result), nil
+	})
+
+	subscriber := nodetest.SubscriberFunc(func(ctx context.Context, r *jsonrpc.Request) (node.Subscription, error) {
+		return sub, nil
+	})
+
+	client := nodetest.NewClient(t, requester, subscriber)
+	return client
+}
+
+// setHead moves the head of the chain and returns the matching newHeads notification
+func (c *chain) setHead(number uint64, fork int) *jsonrpc.Notification {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.head = number
+	c.fork = fork
+	params := `{"subscription":"0x1","result":` + c.block(number, c.forkOf(number, fork)) + `}`
+	return &jsonrpc.Notification{JSONRPC: "2.0", Method: "eth_subscription", Params: jsonrpc.NotificationParams(params)}
+}
+
+func describe(l eth.Log) string {
+	if l.Removed {
+		return "-" + l.BlockHash.String()
+	}
+	return "+" + l.BlockHash.String()
+}
+
+func TestStreamer_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the node never serves reorged blocks, so retracting mustn't depend on it
+	c := &chain{branch: 3, pruned: true, stale: 1}
+	sub := &subscription{ch: make(chan *jsonrpc.Notification)}
+	client := c.client(t, sub)
+	c.setHead(5, 0)
+
+	store := logstream.NewMemoryStore()
+	streamer, err := logstream.New(client, logstream.Config{
+		Filter:        eth.LogFilter{FromBlock: eth.MustBlockNumberOrTag("0x1")},
+		Confirmations: 2,
+		Store:         store,
+		BatchSize:     2,
+	})
+	require.NoError(t, err)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- streamer.Run(ctx)
+	}()
+
+	next := func() string {
+		return describe(<-streamer.Logs())
+	}
+
+	// backfill of blocks 1 to 3 confirmed by head 5, after the logs of a stale fork were discarded
+	require.Equal(t, "+"+nodetest.Hash(1, 0), next())
+	require.Equal(t, "+"+nodetest.Hash(2, 0), next())
+	require.Equal(t, "+"+nodetest.Hash(3, 0), next())
+
+	sub.ch <- c.setHead(6, 0)
+	require.Equal(t, "+"+nodetest.Hash(4, 0), next())
+
+	// fork 1 replaces blocks 4 to 6, so the log of block 4 is retracted
+	sub.ch <- c.setHead(7, 1)
+	require.Equal(t, "-"+nodetest.Hash(4, 0), next())
+	require.Equal(t, "+"+nodetest.Hash(4, 1), next())
+	require.Equal(t, "+"+nodetest.Hash(5, 1), next())
+
+	cancel()
+	for range streamer.Logs() {
+	}
+	require.Error(t, <-errCh)
+
+	checkpoint, err := store.Load(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), checkpoint.Number)
+	require.Equal(t, nodetest.Hash(5, 1), checkpoint.Hash.String())
+}
+
+func TestStreamer_ResumeAfterReorg(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the streamer stopped after delivering block 5 of fork 0, which was reorged out while it wasn't running
+	c := &chain{branch: 3, fork: 1}
+	sub := &subscription{ch: make(chan *jsonrpc.Notification)}
+	client := c.client(t, sub)
+	c.setHead(8, 1)
+
+	store := logstream.NewMemoryStore()
+	require.NoError(t, store.Save(ctx, logstream.Checkpoint{Number: 5, Hash: *eth.MustHash(nodetest.Hash(5, 0))}))
+
+	streamer, err := logstream.New(client, logstream.Config{Confirmations: 2, Store: store})
+	require.NoError(t, err)
+
+	go func() {
+		_ = streamer.Run(ctx)
+	}()
+
+	expected := []string{
+		"-" + nodetest.Hash(5, 0),
+		"-" + nodetest.Hash(4, 0),
+		"+" + nodetest.Hash(4, 1),
+		"+" + nodetest.Hash(5, 1),
+		"+" + nodetest.Hash(6, 1),
+	}
+
+	for _, e := range expected {
+		require.Equal(t, e, describe(<-streamer.Logs()))
+	}
+}
@@ -0,0 +1,112 @@
+package logstream
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+)
+
+// Checkpoint identifies the last block whose logs have been delivered
+type Checkpoint struct {
+	Number uint64   `json:"number"`
+	Hash   eth.Hash `json:"hash"`
+}
+
+// CheckpointStore persists the progress of a Streamer so it can resume after a restart
+type CheckpointStore interface {
+	// Load returns the saved checkpoint, or nil if there is none
+	Load(ctx context.Context) (*Checkpoint, error)
+
+	// Save replaces the saved checkpoint
+	Save(ctx context.Context, checkpoint Checkpoint) error
+}
+
+// NewMemoryStore returns a CheckpointStore that only keeps the checkpoint in memory
+func NewMemoryStore() CheckpointStore {
+	return &memoryStore{}
+}
+
+type memoryStore struct {
+	mu         sync.Mutex
+	checkpoint *Checkpoint
+}
+
+func (m *memoryStore) Load(ctx context.Context) (*Checkpoint, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.checkpoint == nil {
+		return nil, nil
+	}
+
+	cp := *m.checkpoint
+	return &cp, nil
+}
+
+func (m *memoryStore) Save(ctx context.Context, checkpoint Checkpoint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.checkpoint = &checkpoint
+	return nil
+}
+
+// NewFileStore returns a CheckpointStore that keeps the checkpoint as JSON in the file at path.  The file is
+// replaced atomically on every save.
+func NewFileStore(path string) CheckpointStore {
+	return &fileStore{path: path}
+}
+
+type fileStore struct {
+	path string
+}
+
+func (f *fileStore) Load(ctx context.Context) (*Checkpoint, error) {
+	b, err := ioutil.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read checkpoint")
+	}
+
+	checkpoint := Checkpoint{}
+	if err := json.Unmarshal(b, &checkpoint); err != nil {
+		return nil, errors.Wrap(err, "could not decode checkpoint")
+	}
+
+	return &checkpoint, nil
+}
+
+func (f *fileStore) Save(ctx context.Context, checkpoint Checkpoint) error {
+	b, err := json.Marshal(&checkpoint)
+	if err != nil {
+		return errors.Wrap(err, "could not encode checkpoint")
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(f.path), filepath.Base(f.path)+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "could not create checkpoint file")
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "could not write checkpoint")
+	}
+
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "could not write checkpoint")
+	}
+
+	return errors.Wrap(os.Rename(tmp.Name(), f.path), "could not replace checkpoint")
+}
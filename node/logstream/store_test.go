@@ -0,0 +1,35 @@
+package logstream_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/node/logstream"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+)
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "logstream")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store := logstream.NewFileStore(filepath.Join(dir, "checkpoint.json"))
+
+	checkpoint, err := store.Load(ctx)
+	require.NoError(t, err)
+	require.Nil(t, checkpoint, "a missing file means there is no checkpoint")
+
+	saved := logstream.Checkpoint{Number: 42, Hash: *eth.MustHash(nodetest.Hash(42, 0))}
+	require.NoError(t, store.Save(ctx, saved))
+
+	checkpoint, err = store.Load(ctx)
+	require.NoError(t, err)
+	require.Equal(t, saved, *checkpoint)
+}
@@ -467,3 +467,7 @@ func (t *loopingTransport) Subscribe(ctx context.Context, r *jsonrpc.Request) (S
 func (t *loopingTransport) IsBidirectional() bool {
 	return true
 }
+
+func (t *loopingTransport) SupportsSubscriptions() bool {
+	return true
+}
@@ -0,0 +1,73 @@
+package node
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+)
+
+// Metric names recorded by NewMetricsInterceptor
+const (
+	MetricRequests        = "jsonrpc_requests_total"
+	MetricRequestErrors   = "jsonrpc_request_errors_total"
+	MetricRequestDuration = "jsonrpc_request_duration"
+	MetricSubscriptions   = "jsonrpc_subscriptions_total"
+	MetricNotifications   = "jsonrpc_notifications_total"
+)
+
+// Metrics is a generic sink for counters and latencies, so that NewMetricsInterceptor can be
+// backed by prometheus, statsd, expvar or anything else with a thin adapter.
+type Metrics interface {
+	// IncCounter increments the counter identified by name and labels by one
+	IncCounter(name string, labels map[string]string)
+
+	// ObserveDuration records a single latency observation for the metric identified by name and labels
+	ObserveDuration(name string, d time.Duration, labels map[string]string)
+}
+
+// NewMetricsInterceptor returns an Interceptor that records request counts, latencies and errors, as well as
+// successful subscription and notification counts.  All metrics are labelled with the JSONRPC "method", errors also
+// include "kind" (either "transport" or "jsonrpc") and the JSONRPC error "code" when applicable, while
+// subscription metrics include the subscription "type" (e.g. newHeads).
+func NewMetricsInterceptor(m Metrics) Interceptor {
+	return Interceptor{
+		Request: func(ctx context.Context, r *jsonrpc.Request, next RequestFunc) (*jsonrpc.RawResponse, error) {
+			start := time.Now()
+			response, err := next(ctx, r)
+			labels := map[string]string{"method": r.Method}
+
+			m.IncCounter(MetricRequests, labels)
+			m.ObserveDuration(MetricRequestDuration, time.Since(start), labels)
+
+			switch {
+			case err != nil:
+				m.IncCounter(MetricRequestErrors, map[string]string{"method": r.Method, "kind": "transport"})
+			case response != nil && response.Error != nil:
+				e := ResponseError(response)
+				m.IncCounter(MetricRequestErrors, map[string]string{
+					"method": r.Method,
+					"kind":   "jsonrpc",
+					"code":   strconv.Itoa(int(e.Code)),
+				})
+			}
+
+			return response, err
+		},
+		Subscribe: func(ctx context.Context, r *jsonrpc.Request, next SubscribeFunc) (Subscription, error) {
+			sub, err := next(ctx, r)
+			if err != nil {
+				m.IncCounter(MetricRequestErrors, map[string]string{"method": r.Method, "kind": "transport"})
+				return sub, err
+			}
+
+			m.IncCounter(MetricSubscriptions, map[string]string{"method": r.Method, "type": subscriptionKind(r)})
+			return sub, err
+		},
+		Notification: func(ctx context.Context, r *jsonrpc.Request, sub Subscription, n *jsonrpc.Notification) *jsonrpc.Notification {
+			m.IncCounter(MetricNotifications, map[string]string{"method": n.Method, "type": subscriptionKind(r)})
+			return n
+		},
+	}
+}
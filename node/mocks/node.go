@@ -173,6 +173,36 @@ func (mr *MockClientMockRecorder) BlockNumber(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockNumber", reflect.TypeOf((*MockClient)(nil).BlockNumber), ctx)
 }
 
+// BlockTraces mocks base method.
+func (m *MockClient) BlockTraces(ctx context.Context, numberOrTag eth.BlockNumberOrTag) ([]eth.Trace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockTraces", ctx, numberOrTag)
+	ret0, _ := ret[0].([]eth.Trace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockTraces indicates an expected call of BlockTraces.
+func (mr *MockClientMockRecorder) BlockTraces(ctx, numberOrTag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockTraces", reflect.TypeOf((*MockClient)(nil).BlockTraces), ctx, numberOrTag)
+}
+
+// Call mocks base method.
+func (m *MockClient) Call(ctx context.Context, msg eth.CallMsg, block eth.BlockSpecifier, overrides *eth.CallOverrides) (eth.Data, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Call", ctx, msg, block, overrides)
+	ret0, _ := ret[0].(eth.Data)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Call indicates an expected call of Call.
+func (mr *MockClientMockRecorder) Call(ctx, msg, block, overrides interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockClient)(nil).Call), ctx, msg, block, overrides)
+}
+
 // ChainId mocks base method.
 func (m *MockClient) ChainId(ctx context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -203,6 +233,36 @@ func (mr *MockClientMockRecorder) EstimateGas(ctx, msg interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockClient)(nil).EstimateGas), ctx, msg)
 }
 
+// FeeHistory mocks base method.
+func (m *MockClient) FeeHistory(ctx context.Context, blockCount uint64, newestBlock eth.BlockNumberOrTag, rewardPercentiles []float64) (*eth.FeeHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, blockCount, newestBlock, rewardPercentiles)
+	ret0, _ := ret[0].(*eth.FeeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FeeHistory indicates an expected call of FeeHistory.
+func (mr *MockClientMockRecorder) FeeHistory(ctx, blockCount, newestBlock, rewardPercentiles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockClient)(nil).FeeHistory), ctx, blockCount, newestBlock, rewardPercentiles)
+}
+
+// FilterTraces mocks base method.
+func (m *MockClient) FilterTraces(ctx context.Context, filter eth.TraceFilter) ([]eth.Trace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterTraces", ctx, filter)
+	ret0, _ := ret[0].([]eth.Trace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterTraces indicates an expected call of FilterTraces.
+func (mr *MockClientMockRecorder) FilterTraces(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterTraces", reflect.TypeOf((*MockClient)(nil).FilterTraces), ctx, filter)
+}
+
 // GasPrice mocks base method.
 func (m *MockClient) GasPrice(ctx context.Context) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -218,6 +278,36 @@ func (mr *MockClientMockRecorder) GasPrice(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GasPrice", reflect.TypeOf((*MockClient)(nil).GasPrice), ctx)
 }
 
+// GetBalance mocks base method.
+func (m *MockClient) GetBalance(ctx context.Context, address eth.Address, numberOrTag eth.BlockNumberOrTag) (eth.Quantity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, address, numberOrTag)
+	ret0, _ := ret[0].(eth.Quantity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockClientMockRecorder) GetBalance(ctx, address, numberOrTag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockClient)(nil).GetBalance), ctx, address, numberOrTag)
+}
+
+// GetProof mocks base method.
+func (m *MockClient) GetProof(ctx context.Context, address eth.Address, storageKeys []eth.Data32, block eth.BlockSpecifier) (*eth.AccountResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProof", ctx, address, storageKeys, block)
+	ret0, _ := ret[0].(*eth.AccountResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProof indicates an expected call of GetProof.
+func (mr *MockClientMockRecorder) GetProof(ctx, address, storageKeys, block interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProof", reflect.TypeOf((*MockClient)(nil).GetProof), ctx, address, storageKeys, block)
+}
+
 // GetTransactionCount mocks base method.
 func (m *MockClient) GetTransactionCount(ctx context.Context, address eth.Address, numberOrTag eth.BlockNumberOrTag) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -292,6 +382,36 @@ func (mr *MockClientMockRecorder) NetVersion(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetVersion", reflect.TypeOf((*MockClient)(nil).NetVersion), ctx)
 }
 
+// ReplayBlockTransactions mocks base method.
+func (m *MockClient) ReplayBlockTransactions(ctx context.Context, numberOrTag eth.BlockNumberOrTag, options []eth.TraceOption) ([]eth.TraceReplay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayBlockTransactions", ctx, numberOrTag, options)
+	ret0, _ := ret[0].([]eth.TraceReplay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayBlockTransactions indicates an expected call of ReplayBlockTransactions.
+func (mr *MockClientMockRecorder) ReplayBlockTransactions(ctx, numberOrTag, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayBlockTransactions", reflect.TypeOf((*MockClient)(nil).ReplayBlockTransactions), ctx, numberOrTag, options)
+}
+
+// ReplayCall mocks base method.
+func (m *MockClient) ReplayCall(ctx context.Context, msg eth.CallMsg, options []eth.TraceOption, numberOrTag eth.BlockNumberOrTag) (*eth.TraceReplay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayCall", ctx, msg, options, numberOrTag)
+	ret0, _ := ret[0].(*eth.TraceReplay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayCall indicates an expected call of ReplayCall.
+func (mr *MockClientMockRecorder) ReplayCall(ctx, msg, options, numberOrTag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayCall", reflect.TypeOf((*MockClient)(nil).ReplayCall), ctx, msg, options, numberOrTag)
+}
+
 // Request mocks base method.
 func (m *MockClient) Request(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
 	m.ctrl.T.Helper()
@@ -337,6 +457,21 @@ func (mr *MockClientMockRecorder) Subscribe(ctx, r interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockClient)(nil).Subscribe), ctx, r)
 }
 
+// SubscribeLogs mocks base method.
+func (m *MockClient) SubscribeLogs(ctx context.Context, filter eth.LogFilter) (node.LogsSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeLogs", ctx, filter)
+	ret0, _ := ret[0].(node.LogsSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeLogs indicates an expected call of SubscribeLogs.
+func (mr *MockClientMockRecorder) SubscribeLogs(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeLogs", reflect.TypeOf((*MockClient)(nil).SubscribeLogs), ctx, filter)
+}
+
 // SubscribeNewHeads mocks base method.
 func (m *MockClient) SubscribeNewHeads(ctx context.Context) (node.Subscription, error) {
 	m.ctrl.T.Helper()
@@ -367,6 +502,80 @@ func (mr *MockClientMockRecorder) SubscribeNewPendingTransactions(ctx interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeNewPendingTransactions", reflect.TypeOf((*MockClient)(nil).SubscribeNewPendingTransactions), ctx)
 }
 
+// SubscribeSyncing mocks base method.
+func (m *MockClient) SubscribeSyncing(ctx context.Context) (node.SyncingSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeSyncing", ctx)
+	ret0, _ := ret[0].(node.SyncingSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeSyncing indicates an expected call of SubscribeSyncing.
+func (mr *MockClientMockRecorder) SubscribeSyncing(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeSyncing", reflect.TypeOf((*MockClient)(nil).SubscribeSyncing), ctx)
+}
+
+// SupportsSubscriptions mocks base method.
+func (m *MockClient) SupportsSubscriptions() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportsSubscriptions")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SupportsSubscriptions indicates an expected call of SupportsSubscriptions.
+func (mr *MockClientMockRecorder) SupportsSubscriptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsSubscriptions", reflect.TypeOf((*MockClient)(nil).SupportsSubscriptions))
+}
+
+// TraceBlockByNumber mocks base method.
+func (m *MockClient) TraceBlockByNumber(ctx context.Context, numberOrTag eth.BlockNumberOrTag, config eth.TraceConfig) ([]eth.TransactionTrace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceBlockByNumber", ctx, numberOrTag, config)
+	ret0, _ := ret[0].([]eth.TransactionTrace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceBlockByNumber indicates an expected call of TraceBlockByNumber.
+func (mr *MockClientMockRecorder) TraceBlockByNumber(ctx, numberOrTag, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceBlockByNumber", reflect.TypeOf((*MockClient)(nil).TraceBlockByNumber), ctx, numberOrTag, config)
+}
+
+// TraceCall mocks base method.
+func (m *MockClient) TraceCall(ctx context.Context, msg eth.CallMsg, block eth.BlockSpecifier, config eth.TraceCallConfig) (*eth.TraceResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceCall", ctx, msg, block, config)
+	ret0, _ := ret[0].(*eth.TraceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceCall indicates an expected call of TraceCall.
+func (mr *MockClientMockRecorder) TraceCall(ctx, msg, block, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceCall", reflect.TypeOf((*MockClient)(nil).TraceCall), ctx, msg, block, config)
+}
+
+// TraceTransaction mocks base method.
+func (m *MockClient) TraceTransaction(ctx context.Context, hash string, config eth.TraceConfig) (*eth.TraceResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceTransaction", ctx, hash, config)
+	ret0, _ := ret[0].(*eth.TraceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceTransaction indicates an expected call of TraceTransaction.
+func (mr *MockClientMockRecorder) TraceTransaction(ctx, hash, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceTransaction", reflect.TypeOf((*MockClient)(nil).TraceTransaction), ctx, hash, config)
+}
+
 // TransactionByHash mocks base method.
 func (m *MockClient) TransactionByHash(ctx context.Context, hash string) (*eth.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -397,6 +606,21 @@ func (mr *MockClientMockRecorder) TransactionReceipt(ctx, hash interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockClient)(nil).TransactionReceipt), ctx, hash)
 }
 
+// TransactionTraces mocks base method.
+func (m *MockClient) TransactionTraces(ctx context.Context, hash string) ([]eth.Trace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionTraces", ctx, hash)
+	ret0, _ := ret[0].([]eth.Trace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactionTraces indicates an expected call of TransactionTraces.
+func (mr *MockClientMockRecorder) TransactionTraces(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionTraces", reflect.TypeOf((*MockClient)(nil).TransactionTraces), ctx, hash)
+}
+
 // URL mocks base method.
 func (m *MockClient) URL() string {
 	m.ctrl.T.Helper()
@@ -411,6 +635,21 @@ func (mr *MockClientMockRecorder) URL() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URL", reflect.TypeOf((*MockClient)(nil).URL))
 }
 
+// UncleByBlockHashAndIndex mocks base method.
+func (m *MockClient) UncleByBlockHashAndIndex(ctx context.Context, hash string, index uint64) (*eth.Uncle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UncleByBlockHashAndIndex", ctx, hash, index)
+	ret0, _ := ret[0].(*eth.Uncle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UncleByBlockHashAndIndex indicates an expected call of UncleByBlockHashAndIndex.
+func (mr *MockClientMockRecorder) UncleByBlockHashAndIndex(ctx, hash, index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UncleByBlockHashAndIndex", reflect.TypeOf((*MockClient)(nil).UncleByBlockHashAndIndex), ctx, hash, index)
+}
+
 // MockSubscription is a mock of Subscription interface.
 type MockSubscription struct {
 	ctrl     *gomock.Controller
@@ -489,3 +728,161 @@ func (mr *MockSubscriptionMockRecorder) Unsubscribe(ctx interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockSubscription)(nil).Unsubscribe), ctx)
 }
+
+// MockLogsSubscription is a mock of LogsSubscription interface.
+type MockLogsSubscription struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogsSubscriptionMockRecorder
+}
+
+// MockLogsSubscriptionMockRecorder is the mock recorder for MockLogsSubscription.
+type MockLogsSubscriptionMockRecorder struct {
+	mock *MockLogsSubscription
+}
+
+// NewMockLogsSubscription creates a new mock instance.
+func NewMockLogsSubscription(ctrl *gomock.Controller) *MockLogsSubscription {
+	mock := &MockLogsSubscription{ctrl: ctrl}
+	mock.recorder = &MockLogsSubscriptionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogsSubscription) EXPECT() *MockLogsSubscriptionMockRecorder {
+	return m.recorder
+}
+
+// Ch mocks base method.
+func (m *MockLogsSubscription) Ch() <-chan eth.Log {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ch")
+	ret0, _ := ret[0].(<-chan eth.Log)
+	return ret0
+}
+
+// Ch indicates an expected call of Ch.
+func (mr *MockLogsSubscriptionMockRecorder) Ch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ch", reflect.TypeOf((*MockLogsSubscription)(nil).Ch))
+}
+
+// ID mocks base method.
+func (m *MockLogsSubscription) ID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ID indicates an expected call of ID.
+func (mr *MockLogsSubscriptionMockRecorder) ID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ID", reflect.TypeOf((*MockLogsSubscription)(nil).ID))
+}
+
+// Response mocks base method.
+func (m *MockLogsSubscription) Response() *jsonrpc.RawResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Response")
+	ret0, _ := ret[0].(*jsonrpc.RawResponse)
+	return ret0
+}
+
+// Response indicates an expected call of Response.
+func (mr *MockLogsSubscriptionMockRecorder) Response() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Response", reflect.TypeOf((*MockLogsSubscription)(nil).Response))
+}
+
+// Unsubscribe mocks base method.
+func (m *MockLogsSubscription) Unsubscribe(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockLogsSubscriptionMockRecorder) Unsubscribe(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockLogsSubscription)(nil).Unsubscribe), ctx)
+}
+
+// MockSyncingSubscription is a mock of SyncingSubscription interface.
+type MockSyncingSubscription struct {
+	ctrl     *gomock.Controller
+	recorder *MockSyncingSubscriptionMockRecorder
+}
+
+// MockSyncingSubscriptionMockRecorder is the mock recorder for MockSyncingSubscription.
+type MockSyncingSubscriptionMockRecorder struct {
+	mock *MockSyncingSubscription
+}
+
+// NewMockSyncingSubscription creates a new mock instance.
+func NewMockSyncingSubscription(ctrl *gomock.Controller) *MockSyncingSubscription {
+	mock := &MockSyncingSubscription{ctrl: ctrl}
+	mock.recorder = &MockSyncingSubscriptionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSyncingSubscription) EXPECT() *MockSyncingSubscriptionMockRecorder {
+	return m.recorder
+}
+
+// Ch mocks base method.
+func (m *MockSyncingSubscription) Ch() <-chan eth.SyncStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ch")
+	ret0, _ := ret[0].(<-chan eth.SyncStatus)
+	return ret0
+}
+
+// Ch indicates an expected call of Ch.
+func (mr *MockSyncingSubscriptionMockRecorder) Ch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ch", reflect.TypeOf((*MockSyncingSubscription)(nil).Ch))
+}
+
+// ID mocks base method.
+func (m *MockSyncingSubscription) ID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ID indicates an expected call of ID.
+func (mr *MockSyncingSubscriptionMockRecorder) ID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ID", reflect.TypeOf((*MockSyncingSubscription)(nil).ID))
+}
+
+// Response mocks base method.
+func (m *MockSyncingSubscription) Response() *jsonrpc.RawResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Response")
+	ret0, _ := ret[0].(*jsonrpc.RawResponse)
+	return ret0
+}
+
+// Response indicates an expected call of Response.
+func (mr *MockSyncingSubscriptionMockRecorder) Response() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Response", reflect.TypeOf((*MockSyncingSubscription)(nil).Response))
+}
+
+// Unsubscribe mocks base method.
+func (m *MockSyncingSubscription) Unsubscribe(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockSyncingSubscriptionMockRecorder) Unsubscribe(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockSyncingSubscription)(nil).Unsubscribe), ctx)
+}
@@ -0,0 +1,176 @@
+package multicall
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+)
+
+const word = 32
+
+// Encode returns the calldata of an aggregate3 call for calls, or of an aggregate3Value call if any of them
+// sends a value, along with the total value to send.
+func Encode(calls []Call) (eth.Data, *big.Int) {
+	total := new(big.Int)
+	for _, call := range calls {
+		if call.Value != nil {
+			total.Add(total, call.Value)
+		}
+	}
+
+	withValue := total.Sign() > 0
+	selector, headWords := aggregate3Selector, 3
+	if withValue {
+		selector, headWords = aggregate3ValueSelector, 4
+	}
+
+	tuples := make([][]byte, len(calls))
+	for i, call := range calls {
+		data := bytesOf(call.Data)
+		tuple := make([]byte, 0, (headWords+1)*word+padded(len(data)))
+		tuple = append(tuple, leftPad(call.Target.Bytes())...)
+		tuple = append(tuple, boolWord(call.AllowFailure)...)
+		if withValue {
+			value := call.Value
+			if value == nil {
+				value = new(big.Int)
+			}
+			tuple = append(tuple, leftPad(value.Bytes())...)
+		}
+		tuple = append(tuple, uintWord(uint64(headWords*word))...)
+		tuple = append(tuple, uintWord(uint64(len(data)))...)
+		tuple = append(tuple, rightPad(data)...)
+		tuples[i] = tuple
+	}
+
+	out := append([]byte{}, selector...)
+	out = append(out, uintWord(word)...)
+	out = append(out, uintWord(uint64(len(calls)))...)
+
+	offset := uint64(len(calls) * word)
+	for _, tuple := range tuples {
+		out = append(out, uintWord(offset)...)
+		offset += uint64(len(tuple))
+	}
+
+	for _, tuple := range tuples {
+		out = append(out, tuple...)
+	}
+
+	return eth.Data("0x" + hex.EncodeToString(out)), total
+}
+
+// Decode decodes the (bool success, bytes returnData)[] returned by aggregate3 and aggregate3Value
+func Decode(output eth.Data) ([]Result, error) {
+	b := bytesOf(output)
+
+	base, err := readUint(b, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := readUint(b, base)
+	if err != nil {
+		return nil, err
+	}
+
+	// every result takes at least four words, which also bounds n before allocating
+	heads := base + word
+	if n > uint64(len(b))/(4*word) {
+		return nil, errors.Errorf("invalid result count %d", n)
+	}
+
+	results := make([]Result, n)
+	for i := uint64(0); i < n; i++ {
+		offset, err := readUint(b, heads+i*word)
+		if err != nil {
+			return nil, err
+		}
+
+		tuple := heads + offset
+		success, err := readUint(b, tuple)
+		if err != nil {
+			return nil, err
+		}
+
+		dataOffset, err := readUint(b, tuple+word)
+		if err != nil {
+			return nil, err
+		}
+
+		length, err := readUint(b, tuple+dataOffset)
+		if err != nil {
+			return nil, err
+		}
+
+		start := tuple + dataOffset + word
+		if start+length < start || start+length > uint64(len(b)) {
+			return nil, errors.Errorf("result %d is out of bounds", i)
+		}
+
+		results[i] = Result{
+			Success:    success == 1,
+			ReturnData: eth.Data("0x" + hex.EncodeToString(b[start:start+length])),
+		}
+	}
+
+	return results, nil
+}
+
+// encodedSize returns an upper bound of the bytes call adds to the calldata of an aggregate call
+func encodedSize(call Call) int {
+	return word + 5*word + padded(len(bytesOf(call.Data)))
+}
+
+func readUint(b []byte, at uint64) (uint64, error) {
+	if at+word < at || at+word > uint64(len(b)) {
+		return 0, errors.Errorf("offset %d is out of bounds", at)
+	}
+
+	v := new(big.Int).SetBytes(b[at : at+word])
+	if !v.IsUint64() || v.Uint64() > uint64(len(b)) {
+		return 0, errors.Errorf("value at offset %d is out of range", at)
+	}
+
+	return v.Uint64(), nil
+}
+
+func padded(n int) int {
+	return (n + word - 1) / word * word
+}
+
+func leftPad(b []byte) []byte {
+	out := make([]byte, word)
+	copy(out[word-len(b):], b)
+	return out
+}
+
+func rightPad(b []byte) []byte {
+	out := make([]byte, padded(len(b)))
+	copy(out, b)
+	return out
+}
+
+func uintWord(v uint64) []byte {
+	return leftPad(new(big.Int).SetUint64(v).Bytes())
+}
+
+func boolWord(v bool) []byte {
+	if v {
+		return uintWord(1)
+	}
+
+	return uintWord(0)
+}
+
+// bytesOf returns the bytes of d, treating an empty string like 0x
+func bytesOf(d eth.Data) []byte {
+	if len(d) < 2 {
+		return nil
+	}
+
+	return d.Bytes()
+}
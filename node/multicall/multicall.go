@@ -0,0 +1,223 @@
+// Package multicall batches eth_call requests through the Multicall3 contract, so that hundreds of reads cost
+// a handful of round trips.
+package multicall
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/node"
+)
+
+const (
+	// DefaultAddress is where Multicall3 is deployed on most chains
+	DefaultAddress = "0xcA11bde05977b3631167028862bE2a173976CA11"
+	// DefaultMaxCalldata is the largest calldata, in bytes, of a single aggregate call unless configured otherwise
+	DefaultMaxCalldata = 64 * 1024
+)
+
+var (
+	aggregate3Selector      = []byte{0x82, 0xad, 0x56, 0xcb} // aggregate3((address,bool,bytes)[])
+	aggregate3ValueSelector = []byte{0x17, 0x4d, 0xea, 0x71} // aggregate3Value((address,bool,uint256,bytes)[])
+)
+
+// Call is a single call of a batch
+type Call struct {
+	Target eth.Address
+
+	// AllowFailure lets the other calls of the batch succeed when this one reverts, otherwise the whole batch
+	// fails
+	AllowFailure bool
+
+	// Value is sent along with the call, nil meaning none.  Batches with values use aggregate3Value.
+	Value *big.Int
+
+	Data eth.Data
+
+	// Gas is an estimate of the gas used by the call, only used to split batches when Config.MaxGas is set
+	Gas uint64
+}
+
+// Result is the outcome of a single call
+type Result struct {
+	Success    bool
+	ReturnData eth.Data
+}
+
+// Config configures a Multicall, the zero value is valid
+type Config struct {
+	// Address is the Multicall3 contract, defaults to DefaultAddress
+	Address *eth.Address
+
+	// From is the sender of the calls, nil meaning the node's default
+	From *eth.Address
+
+	// MaxCalldata is the largest calldata of a single aggregate call in bytes, defaults to DefaultMaxCalldata
+	MaxCalldata int
+
+	// MaxGas is the largest sum of Call.Gas in a single aggregate call, 0 meaning no limit
+	MaxGas uint64
+}
+
+// Multicall executes batches of calls through Multicall3
+type Multicall struct {
+	client node.Client
+	config Config
+
+	mu       sync.Mutex
+	deployed *bool
+}
+
+// New returns a Multicall using client
+func New(client node.Client, config Config) *Multicall {
+	if config.Address == nil {
+		config.Address = eth.MustAddress(DefaultAddress)
+	}
+
+	if config.MaxCalldata <= 0 {
+		config.MaxCalldata = DefaultMaxCalldata
+	}
+
+	return &Multicall{client: client, config: config}
+}
+
+// Aggregate executes calls against the state of block and returns their results in the same order.  Calls are
+// split into several aggregate calls when they exceed MaxCalldata or MaxGas.
+//
+// If the contract isn't deployed on the chain, which shows as an empty result, calls are made one by one
+// instead, from then on.  Either way, a call failing without AllowFailure fails the whole batch it is part of.
+func (m *Multicall) Aggregate(ctx context.Context, calls []Call, block eth.BlockSpecifier) ([]Result, error) {
+	results := make([]Result, 0, len(calls))
+	for _, batch := range m.split(calls) {
+		var (
+			r   []Result
+			err error
+		)
+
+		if m.isDeployed() {
+			r, err = m.aggregate(ctx, batch, block)
+			if err == errNotDeployed {
+				m.setDeployed(false)
+			} else if err != nil {
+				return nil, err
+			}
+		}
+
+		if !m.isDeployed() {
+			r, err = m.individually(ctx, batch, block)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		results = append(results, r...)
+	}
+
+	return results, nil
+}
+
+var errNotDeployed = errors.New("multicall contract is not deployed")
+
+func (m *Multicall) aggregate(ctx context.Context, calls []Call, block eth.BlockSpecifier) ([]Result, error) {
+	data, value := Encode(calls)
+
+	msg := eth.CallMsg{From: m.config.From, To: m.config.Address, Data: &data}
+	if value.Sign() > 0 {
+		q := eth.QuantityFromBigInt(value)
+		msg.Value = &q
+	}
+
+	out, err := m.client.Call(ctx, msg, block, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "aggregate call failed")
+	}
+
+	if len(bytesOf(out)) == 0 {
+		return nil, errNotDeployed
+	}
+
+	m.setDeployed(true)
+
+	results, err := Decode(out)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) != len(calls) {
+		return nil, errors.Errorf("expected %d results, got %d", len(calls), len(results))
+	}
+
+	return results, nil
+}
+
+// individually makes every call on its own, failing like Multicall3 would when a call that doesn't allow
+// failure reverts
+func (m *Multicall) individually(ctx context.Context, calls []Call, block eth.BlockSpecifier) ([]Result, error) {
+	results := make([]Result, len(calls))
+	for i, call := range calls {
+		target, data := call.Target, call.Data
+		msg := eth.CallMsg{From: m.config.From, To: &target, Data: &data}
+		if call.Value != nil && call.Value.Sign() > 0 {
+			q := eth.QuantityFromBigInt(call.Value)
+			msg.Value = &q
+		}
+
+		out, err := m.client.Call(ctx, msg, block, nil)
+		if revert, ok := errors.Cause(err).(*node.RevertError); ok {
+			if !call.AllowFailure {
+				return nil, errors.Wrapf(revert, "call %d to %s failed", i, target.String())
+			}
+
+			results[i] = Result{Success: false, ReturnData: revert.Data}
+			continue
+		}
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "call %d to %s failed", i, target.String())
+		}
+
+		results[i] = Result{Success: true, ReturnData: out}
+	}
+
+	return results, nil
+}
+
+// split groups calls into batches that respect MaxCalldata and MaxGas, every batch holding at least one call
+func (m *Multicall) split(calls []Call) [][]Call {
+	batches := make([][]Call, 0, 1)
+	start, size, gas := 0, 4+64, uint64(0)
+	for i, call := range calls {
+		callSize := encodedSize(call)
+		if i > start && (size+callSize > m.config.MaxCalldata || (m.config.MaxGas > 0 && gas+call.Gas > m.config.MaxGas)) {
+			batches = append(batches, calls[start:i])
+			start, size, gas = i, 4+64, 0
+		}
+
+		size += callSize
+		gas += call.Gas
+	}
+
+	if start < len(calls) {
+		batches = append(batches, calls[start:])
+	}
+
+	return batches
+}
+
+func (m *Multicall) isDeployed() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.deployed == nil || *m.deployed
+}
+
+func (m *Multicall) setDeployed(deployed bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.deployed = &deployed
+}
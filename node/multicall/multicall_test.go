@@ -0,0 +1,226 @@
+package multicall_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/multicall"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+)
+
+func words(w ...string) string {
+	out := ""
+	for _, s := range w {
+		out += fmt.Sprintf("%064s", s)
+	}
+	return out
+}
+
+func TestEncode(t *testing.T) {
+	target := *eth.MustAddress("0x0000000000000000000000000000000000000001")
+	data, value := multicall.Encode([]multicall.Call{{Target: target, AllowFailure: true, Data: "0x12345678"}})
+	require.Equal(t, 0, value.Sign())
+	require.Equal(t, "0x82ad56cb"+words("20", "1", "20", "1", "1", "60", "4")+"12345678"+strings.Repeat("0", 56), data.String())
+
+	data, value = multicall.Encode([]multicall.Call{
+		{Target: target, Value: big.NewInt(5), Data: "0x"},
+		{Target: target, AllowFailure: true, Data: "0xaa"},
+	})
+	require.Equal(t, int64(5), value.Int64())
+	require.Equal(t, "0x174dea71"+words("20", "2", "40", "e0", "1", "0", "5", "80", "0", "1", "1", "0", "80", "1")+"aa"+strings.Repeat("0", 62), data.String())
+}
+
+func TestDecode(t *testing.T) {
+	output := eth.Data("0x" + words("20", "2", "40", "c0", "1", "40", "2") + "beef" + strings.Repeat("0", 60) + words("0", "40", "0"))
+	results, err := multicall.Decode(output)
+	require.NoError(t, err)
+	require.Equal(t, []multicall.Result{{Success: true, ReturnData: "0xbeef"}, {Success: false, ReturnData: "0x"}}, results)
+
+	_, err = multicall.Decode(output[:len(output)-64])
+	require.Error(t, err)
+
+	_, err = multicall.Decode(eth.Data("0x" + words("20", "ffffffff")))
+	require.Error(t, err)
+}
+
+// fakeMulticall answers aggregate3 calls at address by executing the calls itself: a call succeeds with its
+// calldata reversed, unless the target is failing, which reverts.
+type fakeMulticall struct {
+	t        *testing.T
+	address  *eth.Address
+	deployed bool
+	failing  eth.Address
+	calls    []eth.CallMsg
+}
+
+func (f *fakeMulticall) client() node.Client {
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		require.Equal(f.t, "eth_call", r.Method)
+		msg := eth.CallMsg{}
+		require.NoError(f.t, r.Params.UnmarshalSingleParam(0, &msg))
+		f.calls = append(f.calls, msg)
+
+		if strings.EqualFold(msg.To.String(), f.address.String()) {
+			if !f.deployed {
+				return nodetest.Result(r, `"0x"`), nil
+			}
+			return f.aggregate(r, msg.Data.Bytes())
+		}
+
+		if strings.EqualFold(msg.To.String(), f.failing.String()) {
+			return nodetest.Error(r, `{"code":3,"message":"execution reverted","data":"0x"}`), nil
+		}
+
+		return nodetest.Result(r, `"0x`+reversed(msg.Data.Bytes())+`"`), nil
+	})
+
+	client := nodetest.NewClient(f.t, requester, nil)
+	return client
+}
+
+func (f *fakeMulticall) aggregate(r *jsonrpc.Request, calldata []byte) (*jsonrpc.RawResponse, error) {
+	require.Equal(f.t, "82ad56cb", hex.EncodeToString(calldata[:4]))
+	b := calldata[4:]
+	word := func(at int) int { return int(new(big.Int).SetBytes(b[at : at+32]).Int64()) }
+
+	n := word(32)
+	heads := make([]string, n)
+	tails := ""
+	offset := n * 32
+	for i := 0; i < n; i++ {
+		tuple := 64 + word(64+i*32)
+		target := hex.EncodeToString(b[tuple+12 : tuple+32])
+		allowFailure := word(tuple+32) == 1
+		data := tuple + word(tuple+64)
+		input := b[data+32 : data+32+word(data)]
+
+		result := ""
+		if strings.EqualFold("0x"+target, f.failing.String()) {
+			if !allowFailure {
+				return nodetest.Error(r, `{"code":3,"message":"execution reverted: Multicall3: call failed","data":"0x"}`), nil
+			}
+			result = words("0", "40", "0")
+		} else {
+			out := reversed(input)
+			result = words("1", "40", fmt.Sprintf("%x", len(input))) + out + strings.Repeat("0", (64-len(out)%64)%64)
+		}
+
+		heads[i] = words(fmt.Sprintf("%x", offset))
+		offset += len(result) / 2
+		tails += result
+	}
+
+	output := "0x" + words("20", fmt.Sprintf("%x", n)) + strings.Join(heads, "") + tails
+	return nodetest.Result(r, `"`+output+`"`), nil
+}
+
+func reversed(b []byte) string {
+	out := make([]byte, len(b))
+	for i := range b {
+		out[len(b)-1-i] = b[i]
+	}
+	return hex.EncodeToString(out)
+}
+
+func calls(n int, failing eth.Address) []multicall.Call {
+	out := make([]multicall.Call, n)
+	for i := range out {
+		out[i] = multicall.Call{
+			Target: *eth.MustAddress(fmt.Sprintf("0x%040x", i+16)),
+			Data:   eth.Data(fmt.Sprintf("0x70a08231%064x", i)),
+			Gas:    30000,
+		}
+	}
+
+	out[1].Target = failing
+	out[1].AllowFailure = true
+	return out
+}
+
+func TestMulticall_Aggregate(t *testing.T) {
+	ctx := context.Background()
+	latest := *eth.MustBlockSpecifier("latest")
+	address := eth.MustAddress("0x000000000000000000000000000000000000ca11")
+	failing := *eth.MustAddress("0x00000000000000000000000000000000000000ff")
+
+	check := func(t *testing.T, results []multicall.Result, calls []multicall.Call) {
+		require.Len(t, results, len(calls))
+		for i, result := range results {
+			if i == 1 {
+				require.False(t, result.Success)
+				continue
+			}
+			require.True(t, result.Success)
+			require.Equal(t, "0x"+reversed(calls[i].Data.Bytes()), result.ReturnData.String())
+		}
+	}
+
+	t.Run("single batch", func(t *testing.T) {
+		f := &fakeMulticall{t: t, address: address, deployed: true, failing: failing}
+		m := multicall.New(f.client(), multicall.Config{Address: address})
+
+		c := calls(10, failing)
+		results, err := m.Aggregate(ctx, c, latest)
+		require.NoError(t, err)
+		require.Len(t, f.calls, 1)
+		check(t, results, c)
+	})
+
+	t.Run("split by calldata and gas", func(t *testing.T) {
+		f := &fakeMulticall{t: t, address: address, deployed: true, failing: failing}
+		m := multicall.New(f.client(), multicall.Config{Address: address, MaxCalldata: 1024})
+
+		c := calls(25, failing)
+		results, err := m.Aggregate(ctx, c, latest)
+		require.NoError(t, err)
+		require.True(t, len(f.calls) > 1)
+		for _, call := range f.calls {
+			require.True(t, len(call.Data.Bytes()) <= 1024)
+		}
+		check(t, results, c)
+
+		f.calls = nil
+		m = multicall.New(f.client(), multicall.Config{Address: address, MaxGas: 100000})
+		results, err = m.Aggregate(ctx, c, latest)
+		require.NoError(t, err)
+		require.Len(t, f.calls, 9)
+		check(t, results, c)
+	})
+
+	t.Run("not deployed", func(t *testing.T) {
+		f := &fakeMulticall{t: t, address: address, failing: failing}
+		m := multicall.New(f.client(), multicall.Config{Address: address})
+
+		c := calls(5, failing)
+		results, err := m.Aggregate(ctx, c, latest)
+		require.NoError(t, err)
+		require.Len(t, f.calls, 6)
+		check(t, results, c)
+
+		// the contract isn't tried again
+		f.calls = nil
+		_, err = m.Aggregate(ctx, c, latest)
+		require.NoError(t, err)
+		require.Len(t, f.calls, 5)
+	})
+
+	t.Run("failure not allowed", func(t *testing.T) {
+		c := calls(5, failing)
+		c[1].AllowFailure = false
+
+		for _, deployed := range []bool{true, false} {
+			f := &fakeMulticall{t: t, address: address, deployed: deployed, failing: failing}
+			_, err := multicall.New(f.client(), multicall.Config{Address: address}).Aggregate(ctx, c, latest)
+			require.Error(t, err)
+		}
+	})
+}
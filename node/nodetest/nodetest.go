@@ -0,0 +1,72 @@
+// Package nodetest provides fake node transports for testing code built on top of node.Client.
+package nodetest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+)
+
+// RequesterFunc is a node.Requester answering requests with a function
+type RequesterFunc func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error)
+
+func (f RequesterFunc) Request(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+	return f(ctx, r)
+}
+
+// SubscriberFunc is a node.Subscriber answering subscriptions with a function
+type SubscriberFunc func(ctx context.Context, r *jsonrpc.Request) (node.Subscription, error)
+
+func (f SubscriberFunc) Subscribe(ctx context.Context, r *jsonrpc.Request) (node.Subscription, error) {
+	return f(ctx, r)
+}
+
+// NewClient returns a node.Client making its requests with requester and its subscriptions with subscriber, either
+// of which may be nil, and fails t if the client can't be created.
+func NewClient(t testing.TB, requester node.Requester, subscriber node.Subscriber, opts ...node.Option) node.Client {
+	t.Helper()
+
+	client, err := node.NewCustomClient(requester, subscriber, opts...)
+	if err != nil {
+		t.Fatalf("could not create client: %v", err)
+	}
+
+	return client
+}
+
+// Result returns a successful response to r with the JSON encoded result
+func Result(r *jsonrpc.Request, result string) *jsonrpc.RawResponse {
+	return &jsonrpc.RawResponse{JSONRPC: "2.0", ID: r.ID, Result: json.RawMessage(result)}
+}
+
+// Error returns a failed response to r with the JSON encoded error object e
+func Error(r *jsonrpc.Request, e string) *jsonrpc.RawResponse {
+	raw := json.RawMessage(e)
+	return &jsonrpc.RawResponse{JSONRPC: "2.0", ID: r.ID, Error: &raw}
+}
+
+// Hash returns the hash of block number on the given fork of a fake chain, fork 0 being the original chain, so
+// that blocks with the same number on different forks have different hashes.
+func Hash(number uint64, fork int) string {
+	return fmt.Sprintf("0x%062x%02x", number, fork)
+}
+
+// ParseHash returns the block number and fork of a hash returned by Hash
+func ParseHash(hash string) (uint64, int, error) {
+	number, fork := uint64(0), 0
+	if len(hash) != 66 {
+		return 0, 0, errors.Errorf("invalid hash %s", hash)
+	}
+
+	if _, err := fmt.Sscanf(hash[2:], "%062x%02x", &number, &fork); err != nil {
+		return 0, 0, errors.Wrapf(err, "invalid hash %s", hash)
+	}
+
+	return number, fork, nil
+}
@@ -0,0 +1,43 @@
+package nodetest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+)
+
+func TestHash(t *testing.T) {
+	h := nodetest.Hash(1000, 2)
+	require.NotNil(t, eth.MustHash(h))
+	require.NotEqual(t, h, nodetest.Hash(1000, 0))
+
+	number, fork, err := nodetest.ParseHash(h)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), number)
+	require.Equal(t, 2, fork)
+
+	_, _, err = nodetest.ParseHash("0x1234")
+	require.Error(t, err)
+}
+
+func TestNewClient(t *testing.T) {
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		if r.Method == "eth_blockNumber" {
+			return nodetest.Result(r, `"0x2a"`), nil
+		}
+		return nodetest.Error(r, `{"code":-32601,"message":"the method does not exist"}`), nil
+	})
+
+	client := nodetest.NewClient(t, requester, nil)
+	number, err := client.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), number)
+
+	_, err = client.ChainId(context.Background())
+	require.Error(t, err)
+}
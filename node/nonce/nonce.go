@@ -0,0 +1,299 @@
+// Package nonce hands out transaction nonces for accounts shared by several senders, without the races of
+// asking the node for the pending transaction count before every send.
+package nonce
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/node"
+)
+
+// Store persists the next nonce of every account so that nonces aren't handed out twice across restarts
+type Store interface {
+	// Load returns the next nonce saved for address, ok is false if nothing was saved
+	Load(ctx context.Context, address eth.Address) (next uint64, ok bool, err error)
+
+	// Save records the next nonce for address
+	Save(ctx context.Context, address eth.Address, next uint64) error
+}
+
+// NewMemoryStore returns a Store that only keeps nonces in memory
+func NewMemoryStore() Store {
+	return &memoryStore{nonces: make(map[string]uint64)}
+}
+
+type memoryStore struct {
+	mu     sync.Mutex
+	nonces map[string]uint64
+}
+
+func (m *memoryStore) Load(ctx context.Context, address eth.Address) (uint64, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	next, ok := m.nonces[strings.ToLower(address.String())]
+	return next, ok, nil
+}
+
+func (m *memoryStore) Save(ctx context.Context, address eth.Address, next uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nonces[strings.ToLower(address.String())] = next
+	return nil
+}
+
+// Manager hands out nonces atomically per address.  The first nonce of an address is the larger of the node's
+// pending transaction count and the nonce saved in the Store.
+type Manager struct {
+	client node.Client
+	store  Store
+
+	mu       sync.Mutex
+	accounts map[string]*account
+}
+
+type account struct {
+	mu       sync.Mutex
+	address  eth.Address
+	loaded   bool
+	next     uint64
+	released []uint64
+	reserved map[uint64]struct{}
+}
+
+// Reservation is a nonce handed out by a Manager, which must be either committed once the transaction using
+// it was accepted by the node, or released if sending it failed.
+type Reservation struct {
+	Address eth.Address
+	Nonce   uint64
+
+	manager *Manager
+	account *account
+	done    bool
+}
+
+// NewManager returns a Manager that syncs with client and persists nonces to store, which defaults to
+// NewMemoryStore() when nil.
+func NewManager(client node.Client, store Store) *Manager {
+	if store == nil {
+		store = NewMemoryStore()
+	}
+
+	return &Manager{
+		client:   client,
+		store:    store,
+		accounts: make(map[string]*account),
+	}
+}
+
+// Reserve hands out the next nonce for address.  Nonces released earlier are handed out again first, lowest
+// first, so that they don't leave gaps.
+func (m *Manager) Reserve(ctx context.Context, address eth.Address) (*Reservation, error) {
+	a := m.account(address)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.loaded {
+		if err := m.sync(ctx, a); err != nil {
+			return nil, err
+		}
+	}
+
+	var nonce uint64
+	if len(a.released) > 0 {
+		nonce = a.released[0]
+		a.released = a.released[1:]
+	} else {
+		nonce = a.next
+		a.next++
+		if err := m.store.Save(ctx, address, a.next); err != nil {
+			a.next--
+			return nil, errors.Wrap(err, "could not save nonce")
+		}
+	}
+
+	a.reserved[nonce] = struct{}{}
+	return &Reservation{Address: address, Nonce: nonce, manager: m, account: a}, nil
+}
+
+// Commit marks the nonce as used, once the node has accepted the transaction.
+func (r *Reservation) Commit() {
+	a := r.account
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if r.done {
+		return
+	}
+
+	r.done = true
+	delete(a.reserved, r.Nonce)
+}
+
+// Release returns the nonce after sending the transaction failed with sendErr.  If the node reported the nonce
+// as already used ("nonce too low") the account is resynced from the node, and if it reported the transaction
+// as already known the nonce is committed instead.  Otherwise the nonce is handed out again by the next Reserve.
+func (r *Reservation) Release(ctx context.Context, sendErr error) error {
+	if IsAlreadyKnown(sendErr) {
+		r.Commit()
+		return nil
+	}
+
+	a := r.account
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if r.done {
+		return nil
+	}
+
+	r.done = true
+	delete(a.reserved, r.Nonce)
+
+	if IsNonceTooLow(sendErr) {
+		return r.manager.sync(ctx, a)
+	}
+
+	if r.Nonce+1 == a.next {
+		a.next--
+		for len(a.released) > 0 && a.released[len(a.released)-1]+1 == a.next {
+			a.released = a.released[:len(a.released)-1]
+			a.next--
+		}
+
+		if err := r.manager.store.Save(ctx, a.address, a.next); err != nil {
+			return errors.Wrap(err, "could not save nonce")
+		}
+
+		return nil
+	}
+
+	a.released = append(a.released, r.Nonce)
+	sort.Slice(a.released, func(i, j int) bool { return a.released[i] < a.released[j] })
+	return nil
+}
+
+// Resync reloads the next nonce of address from the node, keeping the local value if it is higher, and
+// drops released nonces the node has already seen used.
+func (m *Manager) Resync(ctx context.Context, address eth.Address) error {
+	a := m.account(address)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return m.sync(ctx, a)
+}
+
+// Gaps returns the nonces below the next nonce of address that the node hasn't seen and that aren't currently
+// reserved.  Transactions with higher nonces can't be mined until the lowest gap is filled, which the next
+// Reserve does for released nonces.
+func (m *Manager) Gaps(ctx context.Context, address eth.Address) ([]uint64, error) {
+	a := m.account(address)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.loaded {
+		if err := m.sync(ctx, a); err != nil {
+			return nil, err
+		}
+	}
+
+	pending, err := m.client.GetTransactionCount(ctx, address, *eth.MustBlockNumberOrTag(eth.TagPending.String()))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get pending transaction count")
+	}
+
+	gaps := make([]uint64, 0)
+	for nonce := pending; nonce < a.next; nonce++ {
+		if _, ok := a.reserved[nonce]; !ok {
+			gaps = append(gaps, nonce)
+		}
+	}
+
+	return gaps, nil
+}
+
+func (m *Manager) account(address eth.Address) *account {
+	key := strings.ToLower(address.String())
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	a, ok := m.accounts[key]
+	if !ok {
+		a = &account{address: address, reserved: make(map[uint64]struct{})}
+		m.accounts[key] = a
+	}
+
+	return a
+}
+
+// sync loads the next nonce from the node and the store, a.mu must be held
+func (m *Manager) sync(ctx context.Context, a *account) error {
+	pending, err := m.client.GetTransactionCount(ctx, a.address, *eth.MustBlockNumberOrTag(eth.TagPending.String()))
+	if err != nil {
+		return errors.Wrap(err, "could not get pending transaction count")
+	}
+
+	stored, ok, err := m.store.Load(ctx, a.address)
+	if err != nil {
+		return errors.Wrap(err, "could not load nonce")
+	}
+
+	next := pending
+	if ok && stored > next {
+		next = stored
+	}
+
+	if a.next > next {
+		next = a.next
+	}
+
+	released := a.released[:0]
+	for _, nonce := range a.released {
+		if nonce >= pending {
+			released = append(released, nonce)
+		}
+	}
+
+	a.released = released
+	a.next = next
+	a.loaded = true
+
+	if err := m.store.Save(ctx, a.address, next); err != nil {
+		return errors.Wrap(err, "could not save nonce")
+	}
+
+	return nil
+}
+
+// IsNonceTooLow returns true if err is a node rejecting a transaction because its nonce was already used
+func IsNonceTooLow(err error) bool {
+	return matches(err, "nonce too low", "nonce is too low", "oldnonce")
+}
+
+// IsAlreadyKnown returns true if err is a node rejecting a transaction because it already has it
+func IsAlreadyKnown(err error) bool {
+	return matches(err, "already known", "alreadyknown", "known transaction", "already imported")
+}
+
+func matches(err error, fragments ...string) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, fragment := range fragments {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
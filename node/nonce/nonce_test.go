@@ -0,0 +1,132 @@
+package nonce_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+	"github.com/INFURA/go-ethlibs/node/nonce"
+)
+
+// newClient returns a client whose pending transaction count is *pending
+func newClient(t *testing.T, mu *sync.Mutex, pending *uint64) node.Client {
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		require.Equal(t, "eth_getTransactionCount", r.Method)
+		require.JSONEq(t, `"pending"`, string(r.Params[1]))
+
+		mu.Lock()
+		defer mu.Unlock()
+		return nodetest.Result(r, fmt.Sprintf(`"0x%x"`, *pending)), nil
+	})
+
+	client := nodetest.NewClient(t, requester, nil)
+	return client
+}
+
+var address = *eth.MustAddress("0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b")
+
+func TestManager_Reserve(t *testing.T) {
+	ctx := context.Background()
+	mu := sync.Mutex{}
+	pending := uint64(5)
+	store := nonce.NewMemoryStore()
+	m := nonce.NewManager(newClient(t, &mu, &pending), store)
+
+	// concurrent senders never get the same nonce
+	wg := sync.WaitGroup{}
+	seen := sync.Map{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r, err := m.Reserve(ctx, address)
+			require.NoError(t, err)
+			_, loaded := seen.LoadOrStore(r.Nonce, true)
+			require.False(t, loaded, "nonce %d handed out twice", r.Nonce)
+			r.Commit()
+		}()
+	}
+	wg.Wait()
+
+	next, ok, err := store.Load(ctx, address)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(25), next)
+
+	// a released nonce in the middle is handed out again first
+	a, err := m.Reserve(ctx, address)
+	require.NoError(t, err)
+	b, err := m.Reserve(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), a.Nonce)
+	require.Equal(t, uint64(26), b.Nonce)
+
+	require.NoError(t, a.Release(ctx, errors.New("connection reset")))
+	c, err := m.Reserve(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), c.Nonce)
+
+	// releasing the highest nonce rewinds
+	require.NoError(t, b.Release(ctx, errors.New("connection reset")))
+	d, err := m.Reserve(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, uint64(26), d.Nonce)
+}
+
+func TestManager_Resync(t *testing.T) {
+	ctx := context.Background()
+	mu := sync.Mutex{}
+	pending := uint64(3)
+	m := nonce.NewManager(newClient(t, &mu, &pending), nil)
+
+	r, err := m.Reserve(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), r.Nonce)
+
+	// another sender used nonces 3 to 9
+	mu.Lock()
+	pending = 10
+	mu.Unlock()
+
+	require.NoError(t, r.Release(ctx, errors.New(`{"code":-32000,"message":"nonce too low"}`)))
+	r, err = m.Reserve(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), r.Nonce)
+
+	// already known transactions keep their nonce
+	require.NoError(t, r.Release(ctx, errors.New(`{"code":-32000,"message":"already known"}`)))
+	r, err = m.Reserve(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), r.Nonce)
+	r.Commit()
+}
+
+func TestManager_Gaps(t *testing.T) {
+	ctx := context.Background()
+	mu := sync.Mutex{}
+	pending := uint64(0)
+
+	store := nonce.NewMemoryStore()
+	require.NoError(t, store.Save(ctx, address, 4), "a previous run handed out nonces 0 to 3")
+
+	m := nonce.NewManager(newClient(t, &mu, &pending), store)
+	r, err := m.Reserve(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), r.Nonce, "restarts don't reuse nonces")
+
+	mu.Lock()
+	pending = 2
+	mu.Unlock()
+
+	gaps, err := m.Gaps(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3}, gaps, "nonce 4 is reserved so it isn't a gap")
+}
@@ -0,0 +1,130 @@
+package node
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"net/http"
+	"time"
+)
+
+// Option can be passed to NewClient or NewCustomClient to customize the behavior of the returned Client.
+type Option func(*options)
+
+type options struct {
+	interceptors []Interceptor
+
+	// transport options, see newHTTPTransport and newWebsocketTransport
+	headers        http.Header
+	tokenSource    TokenSource
+	httpClient     *http.Client
+	tlsConfig      *tls.Config
+	httpTimeout    time.Duration
+	requestTimeout time.Duration
+	gzip           bool
+	pollInterval   time.Duration
+}
+
+func newOptions(opts []Option) *options {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &o
+}
+
+// WithInterceptors adds interceptors to the chain that sits between the Client and its transport.  Interceptors
+// are invoked in the order they are passed in, so the first interceptor sees each request before all the others.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(o *options) {
+		o.interceptors = append(o.interceptors, interceptors...)
+	}
+}
+
+// WithHeader adds a header to every HTTP request, or to the handshake of websocket connections.
+func WithHeader(key, value string) Option {
+	return func(o *options) {
+		if o.headers == nil {
+			o.headers = http.Header{}
+		}
+		o.headers.Add(key, value)
+	}
+}
+
+// WithBearerToken authenticates HTTP requests and websocket handshakes with an Authorization: Bearer header.
+func WithBearerToken(token string) Option {
+	return WithHeader("Authorization", "Bearer "+token)
+}
+
+// WithBasicAuth authenticates HTTP requests and websocket handshakes with HTTP basic authentication.
+func WithBasicAuth(username, password string) Option {
+	credentials := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	return WithHeader("Authorization", "Basic "+credentials)
+}
+
+// TokenSource returns a bearer token used to authenticate a single request.
+type TokenSource func(ctx context.Context) (string, error)
+
+// WithTokenSource authenticates every HTTP request, or the handshake of websocket connections, with a
+// freshly generated Authorization: Bearer header.  Use it for short-lived tokens such as engine API JWTs.
+func WithTokenSource(ts TokenSource) Option {
+	return func(o *options) {
+		o.tokenSource = ts
+	}
+}
+
+// WithHTTPClient makes HTTP clients use c as is, instead of building their own http.Client,
+// in which case WithTLSConfig and WithHTTPTimeout have no effect for HTTP connections.
+func WithHTTPClient(c *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = c
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used for https:// and wss:// connections.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = config
+	}
+}
+
+// WithHTTPTimeout replaces the default 120 second timeout of the http.Client used by HTTP clients.
+func WithHTTPTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.httpTimeout = timeout
+	}
+}
+
+// WithRequestTimeout limits the duration of each individual HTTP request, on top of any deadline set
+// on the context passed to the Client methods.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.requestTimeout = timeout
+	}
+}
+
+// WithGzip compresses the body of HTTP requests with gzip, which can considerably reduce the upload size
+// of large batches or raw transactions.  Compressed responses are always accepted and decompressed.
+func WithGzip() Option {
+	return func(o *options) {
+		o.gzip = true
+	}
+}
+
+// wrap applies all the configured transport wrappers to t
+func (o *options) wrap(t transport) transport {
+	if len(o.interceptors) > 0 {
+		t = newInterceptedTransport(t, o.interceptors)
+	}
+
+	return t
+}
+
+// WithPollInterval sets how often subscriptions over HTTP, which are emulated with filters, poll the node
+// for changes.  The default is DefaultPollInterval.
+func WithPollInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.pollInterval = d
+	}
+}
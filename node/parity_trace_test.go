@@ -0,0 +1,136 @@
+package node_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+)
+
+func TestClient_ParityTraces(t *testing.T) {
+	ctx := context.Background()
+
+	var method string
+	var params jsonrpc.Params
+	var response func(r *jsonrpc.Request) *jsonrpc.RawResponse
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		method = r.Method
+		params = r.Params
+		return response(r), nil
+	})
+
+	client := nodetest.NewClient(t, requester, nil)
+
+	hash := "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b"
+	traces := `[{
+		"action": {"callType": "call", "from": "0x1000000000000000000000000000000000000001", "to": "0x2000000000000000000000000000000000000002", "gas": "0x5208", "input": "0x", "value": "0x1"},
+		"blockHash": "0xd4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3",
+		"blockNumber": 16,
+		"result": {"gasUsed": "0x0", "output": "0x"},
+		"subtraces": 0,
+		"traceAddress": [],
+		"transactionHash": "` + hash + `",
+		"transactionPosition": 0,
+		"type": "call"
+	}]`
+
+	t.Run("transaction", func(t *testing.T) {
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Result(r, traces)
+		}
+
+		result, err := client.TransactionTraces(ctx, hash)
+		require.NoError(t, err)
+		require.Equal(t, "trace_transaction", method)
+		require.JSONEq(t, `"`+hash+`"`, string(params[0]))
+		require.Len(t, result, 1)
+		require.Equal(t, eth.TraceTypeCall, result[0].Type)
+
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Result(r, `null`)
+		}
+		_, err = client.TransactionTraces(ctx, hash)
+		require.Equal(t, node.ErrTransactionNotFound, err)
+	})
+
+	t.Run("block", func(t *testing.T) {
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Result(r, traces)
+		}
+
+		result, err := client.BlockTraces(ctx, *eth.MustBlockNumberOrTag("0x10"))
+		require.NoError(t, err)
+		require.Equal(t, "trace_block", method)
+		require.JSONEq(t, `"0x10"`, string(params[0]))
+		require.Len(t, result, 1)
+
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Result(r, `null`)
+		}
+		_, err = client.BlockTraces(ctx, *eth.MustBlockNumberOrTag("0x10"))
+		require.Equal(t, node.ErrBlockNotFound, err)
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Result(r, traces)
+		}
+
+		count := uint64(10)
+		filter := eth.TraceFilter{
+			FromBlock: eth.MustBlockNumberOrTag("0x10"),
+			ToBlock:   eth.MustBlockNumberOrTag("latest"),
+			ToAddress: []eth.Address{*eth.MustAddress("0x2000000000000000000000000000000000000002")},
+			Count:     &count,
+		}
+
+		result, err := client.FilterTraces(ctx, filter)
+		require.NoError(t, err)
+		require.Equal(t, "trace_filter", method)
+		require.JSONEq(t, `{"fromBlock":"0x10","toBlock":"latest","toAddress":["0x2000000000000000000000000000000000000002"],"count":10}`, string(params[0]))
+		require.Len(t, result, 1)
+	})
+
+	t.Run("replay block transactions", func(t *testing.T) {
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Result(r, `[{"output":"0x","stateDiff":null,"trace":`+traces+`,"transactionHash":"`+hash+`","vmTrace":null}]`)
+		}
+
+		options := []eth.TraceOption{eth.TraceOptionTrace}
+		result, err := client.ReplayBlockTransactions(ctx, *eth.MustBlockNumberOrTag("0x10"), options)
+		require.NoError(t, err)
+		require.Equal(t, "trace_replayBlockTransactions", method)
+		require.JSONEq(t, `["trace"]`, string(params[1]))
+		require.Len(t, result, 1)
+		require.Equal(t, hash, result[0].TransactionHash.String())
+		require.Len(t, result[0].Trace, 1)
+		require.Nil(t, result[0].StateDiff)
+		require.Nil(t, result[0].VMTrace)
+
+		_, err = client.ReplayBlockTransactions(ctx, *eth.MustBlockNumberOrTag("0x10"), nil)
+		require.Error(t, err)
+	})
+
+	t.Run("call", func(t *testing.T) {
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Result(r, `{"output":"0x01","stateDiff":{},"trace":[],"vmTrace":null}`)
+		}
+
+		to := eth.MustAddress("0x2000000000000000000000000000000000000002")
+		msg := eth.NewCallMsg(&eth.Transaction{To: to})
+		options := []eth.TraceOption{eth.TraceOptionTrace, eth.TraceOptionStateDiff}
+		result, err := client.ReplayCall(ctx, msg, options, *eth.MustBlockNumberOrTag("latest"))
+		require.NoError(t, err)
+		require.Equal(t, "trace_call", method)
+		require.Len(t, params, 3)
+		require.JSONEq(t, `["trace","stateDiff"]`, string(params[1]))
+		require.JSONEq(t, `"latest"`, string(params[2]))
+		require.Equal(t, eth.Data("0x01"), result.Output)
+		require.NotNil(t, result.StateDiff)
+	})
+}
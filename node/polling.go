@@ -0,0 +1,366 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+)
+
+// DefaultPollInterval is how often subscriptions emulated over HTTP poll the node for changes
+const DefaultPollInterval = 4 * time.Second
+
+// NewPollingSubscriber returns a Subscriber that emulates eth_subscribe on top of a Requester by polling
+// filters with eth_getFilterChanges.  newHeads, newPendingTransactions and logs subscriptions are backed by
+// eth_newBlockFilter, eth_newPendingTransactionFilter and eth_newFilter respectively, while syncing
+// subscriptions poll eth_syncing.  Filters the node expired are recreated, although any changes that
+// happened between expiry and recreation are lost.  Subscriptions stop polling once ctx is done.  HTTP clients
+// use this automatically, see WithPollInterval.
+func NewPollingSubscriber(ctx context.Context, requester Requester, interval time.Duration) Subscriber {
+	return &pollingSubscriber{ctx: ctx, requester: requester, interval: interval}
+}
+
+type pollingSubscriber struct {
+	ctx       context.Context
+	requester Requester
+	interval  time.Duration
+}
+
+func (p *pollingSubscriber) Subscribe(ctx context.Context, r *jsonrpc.Request) (Subscription, error) {
+	return newPollingSubscription(ctx, p.ctx, p.requester, r, p.interval)
+}
+
+type pollingSubscription struct {
+	requester Requester
+	kind      string
+	install   *jsonrpc.Request
+	response  *jsonrpc.RawResponse
+	id        string
+	interval  time.Duration
+	syncing   bool
+
+	mu       sync.Mutex
+	filterID string
+
+	notificationsCh chan *jsonrpc.Notification
+	ctx             context.Context
+	cancel          context.CancelFunc
+	once            sync.Once
+}
+
+// newPollingSubscription installs the filter of the subscription r within ctx, and polls it until parent is done
+// or the subscription is unsubscribed
+func newPollingSubscription(ctx, parent context.Context, requester Requester, r *jsonrpc.Request, interval time.Duration) (*pollingSubscription, error) {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	s := pollingSubscription{
+		requester:       requester,
+		kind:            subscriptionKind(r),
+		interval:        interval,
+		notificationsCh: make(chan *jsonrpc.Notification),
+	}
+
+	switch s.kind {
+	case "newHeads":
+		s.install = &jsonrpc.Request{ID: jsonrpc.ID{Num: 1}, Method: "eth_newBlockFilter"}
+	case "newPendingTransactions":
+		s.install = &jsonrpc.Request{ID: jsonrpc.ID{Num: 1}, Method: "eth_newPendingTransactionFilter"}
+	case "logs":
+		filter := json.RawMessage(`{}`)
+		if len(r.Params) > 1 {
+			filter = json.RawMessage(r.Params[1])
+		}
+		s.install = &jsonrpc.Request{ID: jsonrpc.ID{Num: 1}, Method: "eth_newFilter", Params: jsonrpc.MustParams(filter)}
+	case "syncing":
+		// there's no filter for sync status, eth_syncing is polled directly
+	default:
+		return nil, errors.Errorf("subscription type %q cannot be emulated by polling", s.kind)
+	}
+
+	if s.install != nil {
+		if err := s.installFilter(ctx); err != nil {
+			return nil, err
+		}
+	} else {
+		status, err := s.syncStatus(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.syncing = status.Syncing
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, errors.Wrap(err, "could not generate subscription id")
+	}
+	s.id = "0x" + hex.EncodeToString(id)
+
+	result, err := json.Marshal(s.id)
+	if err != nil {
+		return nil, err
+	}
+	s.response = &jsonrpc.RawResponse{JSONRPC: "2.0", ID: r.ID, Result: result}
+
+	s.ctx, s.cancel = context.WithCancel(parent)
+	go s.run()
+
+	return &s, nil
+}
+
+func (s *pollingSubscription) Response() *jsonrpc.RawResponse {
+	return s.response
+}
+
+func (s *pollingSubscription) ID() string {
+	return s.id
+}
+
+func (s *pollingSubscription) Ch() <-chan *jsonrpc.Notification {
+	return s.notificationsCh
+}
+
+func (s *pollingSubscription) Unsubscribe(ctx context.Context) error {
+	s.once.Do(s.cancel)
+
+	s.mu.Lock()
+	filterID := s.filterID
+	s.mu.Unlock()
+
+	if filterID == "" {
+		return nil
+	}
+
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "eth_uninstallFilter",
+		Params: jsonrpc.MustParams(filterID),
+	}
+
+	response, err := s.requester.Request(ctx, &request)
+	if err != nil {
+		return errors.Wrap(err, "unsubscribe failed")
+	}
+
+	if response.Error != nil {
+		return errors.Errorf("%s", string(*response.Error))
+	}
+
+	return nil
+}
+
+func (s *pollingSubscription) run() {
+	defer close(s.notificationsCh)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		var results []json.RawMessage
+		if s.install != nil {
+			results = s.filterChanges()
+		} else {
+			results = s.syncingChanges()
+		}
+
+		for _, result := range results {
+			params, err := json.Marshal(&SubscriptionParams{Subscription: s.id, Result: result})
+			if err != nil {
+				log.Printf("[WARN] could not encode %s notification: %v", s.kind, err)
+				continue
+			}
+
+			n := jsonrpc.Notification{JSONRPC: "2.0", Method: "eth_subscription", Params: params}
+			select {
+			case s.notificationsCh <- &n:
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// installFilter creates the filter backing this subscription, replacing any previous one
+func (s *pollingSubscription) installFilter(ctx context.Context) error {
+	response, err := s.requester.Request(ctx, s.install)
+	if err != nil {
+		return errors.Wrapf(err, "could not make %s request", s.install.Method)
+	}
+
+	if response.Error != nil {
+		return errors.New(string(*response.Error))
+	}
+
+	filterID := ""
+	if err := json.Unmarshal(response.Result, &filterID); err != nil {
+		return errors.Wrap(err, "could not decode filter id")
+	}
+
+	s.mu.Lock()
+	s.filterID = filterID
+	s.mu.Unlock()
+	return nil
+}
+
+// filterChanges returns the notification results accumulated by the filter since the last poll
+func (s *pollingSubscription) filterChanges() []json.RawMessage {
+	s.mu.Lock()
+	filterID := s.filterID
+	s.mu.Unlock()
+
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "eth_getFilterChanges",
+		Params: jsonrpc.MustParams(filterID),
+	}
+
+	response, err := s.requester.Request(s.ctx, &request)
+	if err != nil {
+		if s.ctx.Err() == nil {
+			log.Printf("[WARN] could not poll %s filter %s: %v", s.kind, filterID, err)
+		}
+		return nil
+	}
+
+	if e := ResponseError(response); e != nil {
+		if !isFilterNotFound(e) {
+			log.Printf("[WARN] could not poll %s filter %s: %s", s.kind, filterID, e.Message)
+			return nil
+		}
+
+		log.Printf("[WARN] %s filter %s expired, recreating it: %s", s.kind, filterID, e.Message)
+		if err := s.installFilter(s.ctx); err != nil && s.ctx.Err() == nil {
+			log.Printf("[WARN] could not recreate %s filter: %v", s.kind, err)
+		}
+		return nil
+	}
+
+	changes := make([]json.RawMessage, 0)
+	if err := json.Unmarshal(response.Result, &changes); err != nil {
+		log.Printf("[WARN] could not decode %s filter changes: %v", s.kind, err)
+		return nil
+	}
+
+	if s.kind != "newHeads" {
+		return changes
+	}
+
+	// block filters only return hashes, but newHeads notifications carry the full header
+	heads := make([]json.RawMessage, 0, len(changes))
+	for _, change := range changes {
+		head, err := s.header(change)
+		if err != nil {
+			if s.ctx.Err() == nil {
+				log.Printf("[WARN] could not fetch new head %s: %v", string(change), err)
+			}
+			continue
+		}
+
+		heads = append(heads, head)
+	}
+
+	return heads
+}
+
+// isFilterNotFound reports whether e is the error of a node that dropped a filter, which nodes do when it hasn't
+// been polled for a while (5 minutes for geth) or on restart.  Geth, Erigon and Besu report "filter not found"
+// and Nethermind that the filter "does not exist".
+func isFilterNotFound(e *jsonrpc.Error) bool {
+	message := strings.ToLower(e.Message)
+	return strings.Contains(message, "filter not found") || (strings.Contains(message, "filter") && strings.Contains(message, "does not exist"))
+}
+
+// header fetches the block with the given JSON encoded hash and returns it encoded as a newHeads result
+func (s *pollingSubscription) header(hash json.RawMessage) (json.RawMessage, error) {
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "eth_getBlockByHash",
+		Params: jsonrpc.MustParams(hash, false),
+	}
+
+	response, err := s.requester.Request(s.ctx, &request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not make request")
+	}
+
+	if response.Error != nil {
+		return nil, errors.New(string(*response.Error))
+	}
+
+	if len(response.Result) == 0 || bytes.Equal(response.Result, json.RawMessage(`null`)) {
+		return nil, errors.New("block not found")
+	}
+
+	block := eth.Block{}
+	if err := json.Unmarshal(response.Result, &block); err != nil {
+		return nil, errors.Wrap(err, "could not decode block")
+	}
+
+	head := eth.NewHeadsResult{}
+	head.FromBlock(&block)
+	return json.Marshal(&head)
+}
+
+// syncingChanges returns the current sync status if it started or stopped syncing since the last poll
+func (s *pollingSubscription) syncingChanges() []json.RawMessage {
+	status, err := s.syncStatus(s.ctx)
+	if err != nil {
+		if s.ctx.Err() == nil {
+			log.Printf("[WARN] could not poll sync status: %v", err)
+		}
+		return nil
+	}
+
+	if status.Syncing == s.syncing {
+		return nil
+	}
+	s.syncing = status.Syncing
+
+	result, err := json.Marshal(status)
+	if err != nil {
+		log.Printf("[WARN] could not encode sync status: %v", err)
+		return nil
+	}
+
+	return []json.RawMessage{result}
+}
+
+func (s *pollingSubscription) syncStatus(ctx context.Context) (*eth.SyncStatus, error) {
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "eth_syncing",
+	}
+
+	response, err := s.requester.Request(ctx, &request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not make request")
+	}
+
+	if response.Error != nil {
+		return nil, errors.New(string(*response.Error))
+	}
+
+	status := eth.SyncStatus{}
+	if err := json.Unmarshal(response.Result, &status); err != nil {
+		return nil, errors.Wrap(err, "could not decode sync status")
+	}
+
+	return &status, nil
+}
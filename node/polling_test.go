@@ -0,0 +1,266 @@
+package node_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+)
+
+const pollingTestBlock = `{"difficulty":"0x0","extraData":"0x","gasLimit":"0x1c9c380","gasUsed":"0x0","hash":"0xb3b20624f8f0f86eb50dd04688409e5cea4bd02d700bf6e79e9384d47d6a5a35","logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","miner":"0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c","mixHash":"0x3d1fdd16f15aeab72e7db1013b9f034ee33641d92f71c0736beab4e67d34c7a7","nonce":"0x0000000000000000","number":"0x5bad55","parentHash":"0x61a8ad530a8a43e3583f8ec163f773ad370329b2375d66433eb82f005e1d6202","receiptsRoot":"0x5eced534b3d84d3d732ddbc714f5fd51d98a941b28182b6efe6df3a0fe90004b","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","size":"0x220","stateRoot":"0xf5208fffa2ba5a3f3a2f64ebd5ca3d098978bedd75f335f56b705d8715ee2305","timestamp":"0x5b541449","totalDifficulty":"0x0","transactions":[],"transactionsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","uncles":[]}`
+
+const pollingTestLog = `{"address":"0x8b406b4708a45f115347fc2d020735196f994c5f","blockHash":"0x2cdf35a15eaab70f694b1ef15b6375793848336e00b76b0551082b1fb6130ccd","blockNumber":"0xa4c6b2","data":"0x","logIndex":"%s","removed":false,"topics":[],"transactionHash":"0x9cd71724c1bad4c8e09a52b5bc1d8f037d5c08f4b78626236110ce5e6e1e8cfb","transactionIndex":"0xa"}`
+
+// newFilterServer serves JSONRPC requests over HTTP using handle, which returns the response members
+// following the id, and records the methods called
+func newFilterServer(t *testing.T, handle func(r *jsonrpc.Request) string) (*httptest.Server, func() []string) {
+	mu := sync.Mutex{}
+	var methods []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		request := jsonrpc.Request{}
+		require.NoError(t, json.Unmarshal(body, &request))
+
+		mu.Lock()
+		methods = append(methods, request.Method)
+		response := handle(&request)
+		mu.Unlock()
+
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,` + response + `}`))
+	}))
+
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), methods...)
+	}
+}
+
+func TestHTTPTransport_SubscribeLogs(t *testing.T) {
+	ctx := context.Background()
+
+	polls := map[string]int{}
+	server, methods := newFilterServer(t, func(r *jsonrpc.Request) string {
+		switch r.Method {
+		case "eth_newFilter":
+			require.Contains(t, string(r.Params[0]), "0x8b406b4708a45f115347fc2d020735196f994c5f")
+			if polls["0x1"] == 0 {
+				return `"result":"0x1"`
+			}
+			return `"result":"0x2"`
+		case "eth_getFilterChanges":
+			id := ""
+			require.NoError(t, r.Params.UnmarshalSingleParam(0, &id))
+			polls[id]++
+			switch {
+			case id == "0x1" && polls[id] == 1:
+				return `"result":[` + fmt.Sprintf(pollingTestLog, "0x1") + `]`
+			case id == "0x1":
+				return `"error":{"code":-32000,"message":"filter not found"}`
+			case id == "0x2" && polls[id] == 1:
+				return `"result":[` + fmt.Sprintf(pollingTestLog, "0x2") + `]`
+			default:
+				return `"result":[]`
+			}
+		case "eth_uninstallFilter":
+			return `"result":true`
+		default:
+			return `"error":{"code":-32601,"message":"method not found"}`
+		}
+	})
+	defer server.Close()
+
+	client, err := node.NewClient(ctx, server.URL, node.WithPollInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	require.False(t, client.IsBidirectional())
+
+	sub, err := client.SubscribeLogs(ctx, eth.LogFilter{
+		Address: []eth.Address{*eth.MustAddress("0x8b406b4708a45f115347fc2d020735196f994c5f")},
+	})
+	require.NoError(t, err)
+
+	first := <-sub.Ch()
+	require.Equal(t, uint64(1), first.LogIndex.UInt64())
+
+	second := <-sub.Ch()
+	require.Equal(t, uint64(2), second.LogIndex.UInt64(), "expired filters should be recreated")
+
+	require.NoError(t, sub.Unsubscribe(ctx))
+	seen := methods()
+	require.Contains(t, seen, "eth_uninstallFilter")
+	require.Equal(t, 2, count(seen, "eth_newFilter"))
+}
+
+func TestHTTPTransport_SubscribeNewHeads(t *testing.T) {
+	ctx := context.Background()
+
+	polls := 0
+	server, _ := newFilterServer(t, func(r *jsonrpc.Request) string {
+		switch r.Method {
+		case "eth_newBlockFilter":
+			return `"result":"0xa"`
+		case "eth_getFilterChanges":
+			polls++
+			if polls == 1 {
+				return `"result":["0xb3b20624f8f0f86eb50dd04688409e5cea4bd02d700bf6e79e9384d47d6a5a35"]`
+			}
+			return `"result":[]`
+		case "eth_getBlockByHash":
+			return `"result":` + pollingTestBlock
+		case "eth_uninstallFilter":
+			return `"result":true`
+		default:
+			return `"error":{"code":-32601,"message":"method not found"}`
+		}
+	})
+	defer server.Close()
+
+	client, err := node.NewClient(ctx, server.URL, node.WithPollInterval(10*time.Millisecond))
+	require.NoError(t, err)
+
+	sub, err := client.SubscribeNewHeads(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, sub.ID())
+
+	n := <-sub.Ch()
+	params := eth.NewHeadsNotificationParams{}
+	require.NoError(t, json.Unmarshal(n.Params, &params))
+	require.Equal(t, sub.ID(), params.Subscription)
+	require.Equal(t, "0x5bad55", params.Result.Number.String())
+	require.Equal(t, "0x61a8ad530a8a43e3583f8ec163f773ad370329b2375d66433eb82f005e1d6202", params.Result.ParentHash.String())
+
+	require.NoError(t, sub.Unsubscribe(ctx))
+	for range sub.Ch() {
+	}
+}
+
+func TestHTTPTransport_SubscribeTransientErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	polls := 0
+	server, methods := newFilterServer(t, func(r *jsonrpc.Request) string {
+		switch r.Method {
+		case "eth_newFilter":
+			return `"result":"0x1"`
+		case "eth_getFilterChanges":
+			polls++
+			if polls == 1 {
+				return `"error":{"code":-32005,"message":"request rate exceeded"}`
+			}
+			return `"result":[` + fmt.Sprintf(pollingTestLog, "0x1") + `]`
+		default:
+			return `"error":{"code":-32601,"message":"method not found"}`
+		}
+	})
+	defer server.Close()
+
+	client, err := node.NewClient(ctx, server.URL, node.WithPollInterval(10*time.Millisecond))
+	require.NoError(t, err)
+
+	sub, err := client.SubscribeLogs(context.Background(), eth.LogFilter{})
+	require.NoError(t, err)
+
+	l := <-sub.Ch()
+	require.Equal(t, uint64(1), l.LogIndex.UInt64())
+	require.Equal(t, 1, count(methods(), "eth_newFilter"), "filters should only be recreated once expired")
+
+	// polling stops with the context of the client
+	cancel()
+	for range sub.Ch() {
+	}
+}
+
+func TestHTTPTransport_SubscribeIntercepted(t *testing.T) {
+	ctx := context.Background()
+
+	server, _ := newFilterServer(t, func(r *jsonrpc.Request) string {
+		switch r.Method {
+		case "eth_newBlockFilter":
+			return `"result":"0xa"`
+		case "eth_getFilterChanges":
+			return `"result":["0xb3b20624f8f0f86eb50dd04688409e5cea4bd02d700bf6e79e9384d47d6a5a35"]`
+		case "eth_getBlockByHash":
+			return `"result":` + pollingTestBlock
+		case "eth_uninstallFilter":
+			return `"result":true`
+		default:
+			return `"error":{"code":-32601,"message":"method not found"}`
+		}
+	})
+	defer server.Close()
+
+	mu := sync.Mutex{}
+	var intercepted []string
+	client, err := node.NewClient(ctx, server.URL, node.WithPollInterval(10*time.Millisecond), node.WithInterceptors(node.Interceptor{
+		Request: func(ctx context.Context, r *jsonrpc.Request, next node.RequestFunc) (*jsonrpc.RawResponse, error) {
+			mu.Lock()
+			intercepted = append(intercepted, r.Method)
+			mu.Unlock()
+			return next(ctx, r)
+		},
+	}))
+	require.NoError(t, err)
+	require.True(t, client.SupportsSubscriptions())
+
+	sub, err := client.SubscribeNewHeads(ctx)
+	require.NoError(t, err)
+	<-sub.Ch()
+	require.NoError(t, sub.Unsubscribe(ctx))
+
+	mu.Lock()
+	seen := append([]string(nil), intercepted...)
+	mu.Unlock()
+	require.Contains(t, seen, "eth_newBlockFilter")
+	require.Contains(t, seen, "eth_getFilterChanges")
+	require.Contains(t, seen, "eth_uninstallFilter")
+
+	t.Run("balanced", func(t *testing.T) {
+		balanced, err := node.NewBalancedClient(ctx, []node.Endpoint{{Client: client}}, node.BalancerConfig{})
+		require.NoError(t, err)
+		require.False(t, balanced.IsBidirectional())
+		require.True(t, balanced.SupportsSubscriptions())
+
+		sub, err := balanced.SubscribeNewHeads(ctx)
+		require.NoError(t, err)
+		<-sub.Ch()
+		require.NoError(t, sub.Unsubscribe(ctx))
+	})
+}
+
+func TestHTTPTransport_SubscribeUnsupported(t *testing.T) {
+	ctx := context.Background()
+	client, err := node.NewClient(ctx, "http://localhost:8545")
+	require.NoError(t, err)
+
+	_, err = client.Subscribe(ctx, &jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "eth_subscribe",
+		Params: jsonrpc.MustParams("alchemy_minedTransactions"),
+	})
+	require.Error(t, err)
+}
+
+func count(values []string, value string) int {
+	n := 0
+	for _, v := range values {
+		if v == value {
+			n++
+		}
+	}
+	return n
+}
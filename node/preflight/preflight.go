@@ -0,0 +1,309 @@
+// Package preflight rejects transactions that would obviously fail before they're broadcast, by checking them
+// against the state of the node without executing them.
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/node"
+)
+
+const (
+	// DefaultMaxTxSize is the largest transaction accepted by the transaction pools of geth and most other clients
+	DefaultMaxTxSize = 128 * 1024
+	// MaxInitCodeSize is the largest contract creation input allowed by EIP-3860
+	MaxInitCodeSize = 2 * 24576
+)
+
+// Kind is the kind of a Violation
+type Kind string
+
+const (
+	KindUnsupportedType   Kind = "unsupported transaction type"
+	KindMissingFields     Kind = "missing fields"
+	KindSignature         Kind = "invalid signature"
+	KindChainID           Kind = "wrong chain id"
+	KindNonceTooLow       Kind = "nonce too low"
+	KindNonceGap          Kind = "nonce gap"
+	KindInsufficientFunds Kind = "insufficient funds"
+	KindIntrinsicGas      Kind = "intrinsic gas too low"
+	KindGasLimit          Kind = "exceeds block gas limit"
+	KindTxGasCap          Kind = "exceeds transaction gas cap"
+	KindFeeCapTooLow      Kind = "fee cap below base fee"
+	KindTipAboveFeeCap    Kind = "tip above fee cap"
+	KindOversized         Kind = "oversized transaction"
+	KindInitCodeTooLarge  Kind = "initcode too large"
+)
+
+// Violation is a reason a transaction would be rejected
+type Violation struct {
+	Kind    Kind
+	Message string
+}
+
+func (v Violation) Error() string {
+	return string(v.Kind) + ": " + v.Message
+}
+
+func violation(kind Kind, format string, args ...interface{}) Violation {
+	return Violation{Kind: kind, Message: fmt.Sprintf(format, args...)}
+}
+
+// Config configures a Validator, the zero value is valid
+type Config struct {
+	// ChainID is the chain id transactions must be signed for, defaults to the chain id of the node
+	ChainID *eth.Quantity
+
+	// AllowUnprotected accepts legacy transactions signed without EIP-155 replay protection
+	AllowUnprotected bool
+
+	// MaxTxSize is the largest encoded transaction accepted, defaults to DefaultMaxTxSize
+	MaxTxSize uint64
+
+	// Fork selects the rules of the minimum and maximum gas of transactions, defaults to eth.ForkLatest
+	Fork eth.Fork
+}
+
+// Validator checks signed transactions against the state of the node before they're broadcast
+type Validator struct {
+	client node.Client
+	config Config
+
+	mu      sync.Mutex
+	chainID *eth.Quantity
+}
+
+// New returns a Validator of transactions to be sent to client
+func New(client node.Client, config Config) *Validator {
+	if config.MaxTxSize == 0 {
+		config.MaxTxSize = DefaultMaxTxSize
+	}
+
+	if config.Fork == 0 {
+		config.Fork = eth.ForkLatest
+	}
+
+	return &Validator{client: client, config: config, chainID: config.ChainID}
+}
+
+// Validate returns the violations of the signed transaction tx, none meaning it should be accepted by the node.
+// Fees are checked against the base fee of the latest block, and the nonce and balance of the sender against its
+// latest state.  Only legacy, access list and dynamic fee transactions can be checked, other types are reported
+// as unsupported.  An error is returned if the node couldn't be queried.
+func (v *Validator) Validate(ctx context.Context, tx *eth.Transaction) ([]Violation, error) {
+	switch tx.TransactionType() {
+	case eth.TransactionTypeLegacy, eth.TransactionTypeAccessList, eth.TransactionTypeDynamicFee:
+	default:
+		return []Violation{violation(KindUnsupportedType, "transactions of type %d can't be checked", tx.TransactionType())}, nil
+	}
+
+	if err := tx.RequiredFields(); err != nil {
+		return []Violation{violation(KindMissingFields, "%s", err.Error())}, nil
+	}
+
+	if missing := missingFees(tx); len(missing) > 0 {
+		return []Violation{violation(KindMissingFields, "missing required fee field(s) %s", strings.Join(missing, ","))}, nil
+	}
+
+	violations := make([]Violation, 0)
+	violations = append(violations, v.checkSize(tx)...)
+
+	sender, signatureViolations, err := v.checkSignature(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	violations = append(violations, signatureViolations...)
+
+	if gas, minimum := tx.Gas.UInt64(), tx.MinimumGas(v.config.Fork); gas < minimum {
+		violations = append(violations, violation(KindIntrinsicGas, "gas %d is below the minimum gas %d", gas, minimum))
+	}
+
+	if gas := tx.Gas.UInt64(); v.config.Fork >= eth.ForkOsaka && gas > eth.MaxTxGas {
+		violations = append(violations, violation(KindTxGasCap, "gas %d exceeds the transaction gas cap %d", gas, eth.MaxTxGas))
+	}
+
+	latest, err := v.client.BlockByNumberOrTag(ctx, *eth.MustBlockNumberOrTag("latest"), false)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get latest block")
+	}
+	violations = append(violations, checkBlock(tx, latest)...)
+
+	if sender != nil {
+		accountViolations, err := v.checkAccount(ctx, tx, *sender)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, accountViolations...)
+	}
+
+	return violations, nil
+}
+
+func (v *Validator) checkSize(tx *eth.Transaction) []Violation {
+	violations := make([]Violation, 0)
+	if raw, err := tx.RawRepresentation(); err != nil {
+		violations = append(violations, violation(KindSignature, "could not encode transaction: %s", err.Error()))
+	} else if size := uint64(len(raw.Bytes())); size > v.config.MaxTxSize {
+		violations = append(violations, violation(KindOversized, "transaction of %d bytes exceeds %d bytes", size, v.config.MaxTxSize))
+	}
+
+	if tx.To == nil && len(tx.Input) > 2 {
+		if size := len(tx.Input.Bytes()); size > MaxInitCodeSize {
+			violations = append(violations, violation(KindInitCodeTooLarge, "initcode of %d bytes exceeds %d bytes", size, MaxInitCodeSize))
+		}
+	}
+
+	return violations
+}
+
+// checkSignature checks the chain id of the signature and recovers the sender, which is nil if it can't be
+func (v *Validator) checkSignature(ctx context.Context, tx *eth.Transaction) (*eth.Address, []Violation, error) {
+	expected, err := v.expectedChainID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	violations := make([]Violation, 0)
+	signature, err := tx.Signature()
+	if err != nil {
+		return nil, append(violations, violation(KindSignature, "%s", err.Error())), nil
+	}
+
+	chainID := eth.QuantityFromInt64(0)
+	if id, err := signature.ChainId(); err == nil {
+		chainID = *id
+		if id.Big().Cmp(expected.Big()) != 0 {
+			violations = append(violations, violation(KindChainID, "signed for chain %s instead of %s", id.String(), expected.String()))
+		}
+	} else if !v.config.AllowUnprotected {
+		violations = append(violations, violation(KindChainID, "transaction is not replay protected"))
+	}
+
+	hash, err := tx.SigningHash(chainID)
+	if err != nil {
+		return nil, append(violations, violation(KindSignature, "%s", err.Error())), nil
+	}
+
+	sender, err := signature.Recover(hash)
+	if err != nil {
+		return nil, append(violations, violation(KindSignature, "could not recover sender: %s", err.Error())), nil
+	}
+
+	if tx.From != "" && !strings.EqualFold(tx.From.String(), sender.String()) {
+		violations = append(violations, violation(KindSignature, "signed by %s instead of %s", sender.String(), tx.From.String()))
+	}
+
+	return sender, violations, nil
+}
+
+func (v *Validator) expectedChainID(ctx context.Context) (*eth.Quantity, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.chainID != nil {
+		return v.chainID, nil
+	}
+
+	id, err := v.client.ChainId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chain id")
+	}
+
+	chainID, err := eth.NewQuantity(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid chain id")
+	}
+
+	v.chainID = chainID
+	return chainID, nil
+}
+
+// checkBlock checks the gas and fees of tx against the gas limit and base fee of block
+func checkBlock(tx *eth.Transaction, block *eth.Block) []Violation {
+	violations := make([]Violation, 0)
+	if gas, limit := tx.Gas.UInt64(), block.GasLimit.UInt64(); gas > limit {
+		violations = append(violations, violation(KindGasLimit, "gas %d exceeds the block gas limit %d", gas, limit))
+	}
+
+	feeCap := feeCap(tx)
+	if tx.TransactionType() == eth.TransactionTypeDynamicFee && tx.MaxPriorityFeePerGas.Big().Cmp(feeCap) > 0 {
+		violations = append(violations, violation(KindTipAboveFeeCap, "max priority fee %s exceeds max fee %s", tx.MaxPriorityFeePerGas.Big(), feeCap))
+	}
+
+	if block.BaseFeePerGas != nil && feeCap.Cmp(block.BaseFeePerGas.Big()) < 0 {
+		violations = append(violations, violation(KindFeeCapTooLow, "max fee %s is below the base fee %s", feeCap, block.BaseFeePerGas.Big()))
+	}
+
+	return violations
+}
+
+// checkAccount checks the nonce and balance of sender
+func (v *Validator) checkAccount(ctx context.Context, tx *eth.Transaction, sender eth.Address) ([]Violation, error) {
+	latest := *eth.MustBlockNumberOrTag("latest")
+	violations := make([]Violation, 0)
+
+	mined, err := v.client.GetTransactionCount(ctx, sender, latest)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get nonce")
+	}
+
+	pending, err := v.client.GetTransactionCount(ctx, sender, *eth.MustBlockNumberOrTag("pending"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get pending nonce")
+	}
+
+	switch nonce := tx.Nonce.UInt64(); {
+	case nonce < mined:
+		violations = append(violations, violation(KindNonceTooLow, "nonce %d is below the account nonce %d", nonce, mined))
+	case nonce > pending:
+		violations = append(violations, violation(KindNonceGap, "nonce %d leaves a gap after the pending nonce %d", nonce, pending))
+	}
+
+	balance, err := v.client.GetBalance(ctx, sender, latest)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get balance")
+	}
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas.UInt64()), feeCap(tx))
+	cost.Add(cost, tx.Value.Big())
+	if balance.Big().Cmp(cost) < 0 {
+		violations = append(violations, violation(KindInsufficientFunds, "balance %s is below the cost %s", balance.Big(), cost))
+	}
+
+	return violations, nil
+}
+
+// missingFees returns the fee fields tx needs to be priced that aren't set
+func missingFees(tx *eth.Transaction) []string {
+	var fields []string
+	if tx.TransactionType() != eth.TransactionTypeDynamicFee {
+		if tx.GasPrice == nil {
+			fields = append(fields, "gasPrice")
+		}
+		return fields
+	}
+
+	if tx.MaxFeePerGas == nil {
+		fields = append(fields, "maxFeePerGas")
+	}
+	if tx.MaxPriorityFeePerGas == nil {
+		fields = append(fields, "maxPriorityFeePerGas")
+	}
+	return fields
+}
+
+// feeCap returns the highest price per gas tx can pay
+func feeCap(tx *eth.Transaction) *big.Int {
+	if tx.TransactionType() == eth.TransactionTypeDynamicFee {
+		return tx.MaxFeePerGas.Big()
+	}
+
+	return tx.GasPrice.Big()
+}
@@ -0,0 +1,290 @@
+package preflight_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+	"github.com/INFURA/go-ethlibs/node/preflight"
+)
+
+const privateKey = "0xfad9c8855b740a0b7ed4c221dbad0f33a83a49cad6b3fe8d5817ac83d38b6a19"
+
+const latestBlock = `{"baseFeePerGas":"0x3b9aca00","difficulty":"0x0","extraData":"0x","gasLimit":"0x1c9c380","gasUsed":"0x0","hash":"0x%064x","logsBloom":"0x%0512x","miner":"0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c","mixHash":"0x%064x","nonce":"0x0000000000000000","number":"0x64","parentHash":"0x%064x","receiptsRoot":"0x%064x","sha3Uncles":"0x%064x","size":"0x220","stateRoot":"0x%064x","timestamp":"0x5b541449","totalDifficulty":"0x0","transactions":[],"transactionsRoot":"0x%064x","uncles":[]}`
+
+// state is the state of the fake node, the sender having nonce 5 with 2 pending transactions
+type state struct {
+	balance string
+	calls   int
+}
+
+func (s *state) client(t *testing.T) node.Client {
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		s.calls++
+
+		var result string
+		switch r.Method {
+		case "eth_chainId":
+			result = `"0x1"`
+		case "eth_getBlockByNumber":
+			result = fmt.Sprintf(latestBlock, 1, 0, 0, 0, 0, 0, 0, 0)
+		case "eth_getTransactionCount":
+			if strings.Contains(string(r.Params[1]), "pending") {
+				result = `"0x7"`
+			} else {
+				result = `"0x5"`
+			}
+		case "eth_getBalance":
+			result = `"` + s.balance + `"`
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+
+		return nodetest.Result(r, result), nil
+	})
+
+	client := nodetest.NewClient(t, requester, nil)
+	return client
+}
+
+func signed(t *testing.T, tx eth.Transaction, chainId int64) *eth.Transaction {
+	id := eth.QuantityFromInt64(chainId)
+	if tx.TransactionType() != eth.TransactionTypeLegacy {
+		tx.ChainId = &id
+	}
+
+	_, err := tx.Sign(privateKey, id)
+	require.NoError(t, err)
+	return &tx
+}
+
+func kinds(violations []preflight.Violation) []preflight.Kind {
+	k := make([]preflight.Kind, len(violations))
+	for i := range violations {
+		k[i] = violations[i].Kind
+	}
+	return k
+}
+
+func TestValidator_Validate(t *testing.T) {
+	ctx := context.Background()
+
+	to := eth.MustAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+	gwei := eth.QuantityFromInt64(1e9)
+	twoGwei := eth.QuantityFromInt64(2e9)
+	dynamicFee := eth.QuantityFromInt64(eth.TransactionTypeDynamicFee)
+	valid := eth.Transaction{
+		Type:                 &dynamicFee,
+		Nonce:                eth.QuantityFromInt64(6),
+		To:                   to,
+		Gas:                  eth.QuantityFromInt64(21000),
+		MaxFeePerGas:         &twoGwei,
+		MaxPriorityFeePerGas: &gwei,
+		Value:                eth.QuantityFromInt64(1000),
+		Input:                eth.Data("0x"),
+	}
+
+	tests := []struct {
+		name     string
+		tx       func(t *testing.T) *eth.Transaction
+		balance  string
+		expected []preflight.Kind
+	}{
+		{
+			name:     "valid",
+			tx:       func(t *testing.T) *eth.Transaction { return signed(t, valid, 1) },
+			balance:  "0xde0b6b3a7640000",
+			expected: []preflight.Kind{},
+		},
+		{
+			name:     "wrong chain",
+			tx:       func(t *testing.T) *eth.Transaction { return signed(t, valid, 5) },
+			balance:  "0xde0b6b3a7640000",
+			expected: []preflight.Kind{preflight.KindChainID},
+		},
+		{
+			name: "nonce too low",
+			tx: func(t *testing.T) *eth.Transaction {
+				tx := valid
+				tx.Nonce = eth.QuantityFromInt64(4)
+				return signed(t, tx, 1)
+			},
+			balance:  "0xde0b6b3a7640000",
+			expected: []preflight.Kind{preflight.KindNonceTooLow},
+		},
+		{
+			name: "nonce gap",
+			tx: func(t *testing.T) *eth.Transaction {
+				tx := valid
+				tx.Nonce = eth.QuantityFromInt64(8)
+				return signed(t, tx, 1)
+			},
+			balance:  "0xde0b6b3a7640000",
+			expected: []preflight.Kind{preflight.KindNonceGap},
+		},
+		{
+			name: "insufficient funds",
+			tx:   func(t *testing.T) *eth.Transaction { return signed(t, valid, 1) },
+			// gas * maxFee + value - 1
+			balance:  "0x2632e314a3e7",
+			expected: []preflight.Kind{preflight.KindInsufficientFunds},
+		},
+		{
+			name: "intrinsic gas",
+			tx: func(t *testing.T) *eth.Transaction {
+				tx := valid
+				tx.Input = eth.Data("0x0001")
+				tx.AccessList = &eth.AccessList{{Address: *to, StorageKeys: []eth.Data32{"0x0000000000000000000000000000000000000000000000000000000000000001"}}}
+				tx.Gas = eth.QuantityFromInt64(21000 + 4 + 16 + 2400 + 1900 - 1)
+				return signed(t, tx, 1)
+			},
+			balance:  "0xde0b6b3a7640000",
+			expected: []preflight.Kind{preflight.KindIntrinsicGas},
+		},
+		{
+			name: "fees",
+			tx: func(t *testing.T) *eth.Transaction {
+				tx := valid
+				low := eth.QuantityFromInt64(1e9 - 1)
+				tx.MaxFeePerGas = &low
+				tx.MaxPriorityFeePerGas = &twoGwei
+				return signed(t, tx, 1)
+			},
+			balance:  "0xde0b6b3a7640000",
+			expected: []preflight.Kind{preflight.KindTipAboveFeeCap, preflight.KindFeeCapTooLow},
+		},
+		{
+			name: "legacy gas price below base fee and over block gas limit",
+			tx: func(t *testing.T) *eth.Transaction {
+				return signed(t, eth.Transaction{
+					Nonce:    eth.QuantityFromInt64(5),
+					To:       to,
+					Gas:      eth.QuantityFromInt64(30000001),
+					GasPrice: &eth.Quantity{},
+					Input:    eth.Data("0x"),
+				}, 1)
+			},
+			balance:  "0x0",
+			expected: []preflight.Kind{preflight.KindTxGasCap, preflight.KindGasLimit, preflight.KindFeeCapTooLow},
+		},
+		{
+			name: "unprotected",
+			tx: func(t *testing.T) *eth.Transaction {
+				return signed(t, eth.Transaction{
+					Nonce:    eth.QuantityFromInt64(5),
+					To:       to,
+					Gas:      eth.QuantityFromInt64(21000),
+					GasPrice: &twoGwei,
+					Input:    eth.Data("0x"),
+				}, 0)
+			},
+			balance:  "0xde0b6b3a7640000",
+			expected: []preflight.Kind{preflight.KindChainID},
+		},
+		{
+			name: "initcode too large",
+			tx: func(t *testing.T) *eth.Transaction {
+				tx := valid
+				tx.To = nil
+				tx.Input = eth.Data("0x" + strings.Repeat("60", preflight.MaxInitCodeSize+1))
+				tx.Gas = eth.QuantityFromInt64(2000000)
+				return signed(t, tx, 1)
+			},
+			balance:  "0xde0b6b3a7640000",
+			expected: []preflight.Kind{preflight.KindInitCodeTooLarge},
+		},
+		{
+			name: "forged sender",
+			tx: func(t *testing.T) *eth.Transaction {
+				tx := signed(t, valid, 1)
+				tx.From = *to
+				return tx
+			},
+			balance:  "0xde0b6b3a7640000",
+			expected: []preflight.Kind{preflight.KindSignature},
+		},
+		{
+			name: "missing fields",
+			tx: func(t *testing.T) *eth.Transaction {
+				tx := valid
+				tx.MaxFeePerGas = nil
+				return &tx
+			},
+			expected: []preflight.Kind{preflight.KindMissingFields},
+		},
+		{
+			name: "legacy without gas price",
+			tx: func(t *testing.T) *eth.Transaction {
+				tx := valid
+				tx.Type, tx.MaxFeePerGas, tx.MaxPriorityFeePerGas = nil, nil, nil
+				return &tx
+			},
+			expected: []preflight.Kind{preflight.KindMissingFields},
+		},
+		{
+			name: "blob",
+			tx: func(t *testing.T) *eth.Transaction {
+				tx := valid
+				blob := eth.QuantityFromInt64(eth.TransactionTypeBlob)
+				tx.Type, tx.MaxFeePerBlobGas = &blob, &gwei
+				tx.BlobVersionedHashes = []eth.Hash{*eth.MustHash("0x01" + strings.Repeat("00", 31))}
+				return &tx
+			},
+			expected: []preflight.Kind{preflight.KindUnsupportedType},
+		},
+		{
+			name: "gas above cap",
+			tx: func(t *testing.T) *eth.Transaction {
+				tx := valid
+				tx.Gas = eth.QuantityFromInt64(eth.MaxTxGas + 1)
+				return signed(t, tx, 1)
+			},
+			balance:  "0xde0b6b3a7640000",
+			expected: []preflight.Kind{preflight.KindTxGasCap},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := state{balance: tt.balance}
+			validator := preflight.New(s.client(t), preflight.Config{})
+
+			violations, err := validator.Validate(ctx, tt.tx(t))
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, kinds(violations), "%v", violations)
+		})
+	}
+}
+
+func TestValidator_Config(t *testing.T) {
+	ctx := context.Background()
+
+	to := eth.MustAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+	gasPrice := eth.QuantityFromInt64(2e9)
+	tx := signed(t, eth.Transaction{
+		Nonce:    eth.QuantityFromInt64(5),
+		To:       to,
+		Gas:      eth.QuantityFromInt64(200000),
+		GasPrice: &gasPrice,
+		Input:    eth.Data("0x" + strings.Repeat("01", 2000)),
+	}, 0)
+
+	s := state{balance: "0xde0b6b3a7640000"}
+	chainID := eth.QuantityFromInt64(1)
+	validator := preflight.New(s.client(t), preflight.Config{ChainID: &chainID, AllowUnprotected: true, MaxTxSize: 1024})
+
+	violations, err := validator.Validate(ctx, tx)
+	require.NoError(t, err)
+	require.Equal(t, []preflight.Kind{preflight.KindOversized}, kinds(violations))
+	require.Contains(t, violations[0].Error(), "oversized transaction: transaction of")
+
+	// the configured chain id is used instead of asking the node: block, 2 nonces and balance
+	require.Equal(t, 4, s.calls)
+}
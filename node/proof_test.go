@@ -0,0 +1,47 @@
+package node_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+)
+
+func TestClient_GetProof(t *testing.T) {
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		require.Equal(t, "eth_getProof", r.Method)
+		require.JSONEq(t, `"0x7f0d15c7faae65896648c8273b6d7e43f58fa842"`, string(r.Params[0]))
+		require.JSONEq(t, `["0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421"]`, string(r.Params[1]))
+		require.JSONEq(t, `"latest"`, string(r.Params[2]))
+
+		return nodetest.Result(r, `{
+			"address": "0x7f0d15c7faae65896648c8273b6d7e43f58fa842",
+			"accountProof": ["0xf90211a0", "0xf90211a1"],
+			"balance": "0x0",
+			"codeHash": "0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+			"nonce": "0x0",
+			"storageHash": "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+			"storageProof": [
+				{
+					"key": "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+					"proof": [],
+					"value": "0x0"
+				}
+			]
+		}`), nil
+	})
+
+	client := nodetest.NewClient(t, requester, nil)
+
+	result, err := client.GetProof(context.Background(), *eth.MustAddress("0x7F0d15C7FAae65896648C8273B6d7E43f58Fa842"), []eth.Data32{eth.Data32(eth.EmptyRootHash)}, *eth.MustBlockSpecifier("latest"))
+	require.NoError(t, err)
+	require.Len(t, result.AccountProof, 2)
+	require.Equal(t, eth.EmptyCodeHash, result.CodeHash)
+	require.Len(t, result.StorageProof, 1)
+	require.Empty(t, result.StorageProof[0].Proof)
+	require.Equal(t, uint64(0), result.StorageProof[0].Value.UInt64())
+}
@@ -0,0 +1,213 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+)
+
+// RetryPolicy controls which failed requests are retried by NewRetryInterceptor and how long to wait in between.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request, including the first one
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry, which is multiplied by Multiplier for
+	// every subsequent retry up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+
+	// Jitter is the fraction (0 to 1) of each delay that is randomized, to avoid synchronized retries
+	Jitter float64
+
+	// RetryTransportErrors controls whether errors not caused by an HTTP status code, such as
+	// connection resets or timeouts, are retried
+	RetryTransportErrors bool
+
+	// RetryableStatusCodes are the HTTP status codes that are retried
+	RetryableStatusCodes []int
+
+	// RetryableErrorCodes are the JSONRPC error codes that are retried
+	RetryableErrorCodes []jsonrpc.ErrorCode
+
+	// ExcludedMethods are never retried, because retrying them is unsafe or pointless
+	ExcludedMethods []string
+}
+
+// DefaultRetryPolicy returns a RetryPolicy that makes up to three attempts, retrying transport errors, common
+// transient HTTP status codes and the JSONRPC limit exceeded and resource unavailable errors.  Non-idempotent
+// methods such as eth_sendRawTransaction are excluded.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:          3,
+		InitialBackoff:       250 * time.Millisecond,
+		MaxBackoff:           10 * time.Second,
+		Multiplier:           2,
+		Jitter:               0.2,
+		RetryTransportErrors: true,
+		RetryableStatusCodes: []int{
+			http.StatusRequestTimeout,
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableErrorCodes: []jsonrpc.ErrorCode{
+			jsonrpc.ErrCodeResourceUnavailable,
+			jsonrpc.ErrCodeLimitExceeded,
+		},
+		ExcludedMethods: []string{
+			"eth_sendRawTransaction",
+			"eth_sendTransaction",
+			"eth_sendRawTransactionConditional",
+			"personal_sendTransaction",
+		},
+	}
+}
+
+// NewRetryInterceptor returns an Interceptor which retries failed requests according to policy, waiting
+// with exponential backoff and jitter in between attempts.  A Retry-After header on an HTTP error (or
+// a backoff_seconds hint in the data of a JSONRPC error) takes precedence over the computed backoff, but
+// requests asking for a longer delay than MaxBackoff aren't retried.  Retries never wait past the deadline
+// of the request context, in which case the last error or response is returned.  Subscriptions are not retried.
+func NewRetryInterceptor(policy RetryPolicy) Interceptor {
+	excluded := make(map[string]bool, len(policy.ExcludedMethods))
+	for _, m := range policy.ExcludedMethods {
+		excluded[m] = true
+	}
+
+	return Interceptor{
+		Request: func(ctx context.Context, r *jsonrpc.Request, next RequestFunc) (*jsonrpc.RawResponse, error) {
+			if excluded[r.Method] {
+				return next(ctx, r)
+			}
+
+			for attempt := 1; ; attempt++ {
+				response, err := next(ctx, r)
+
+				if attempt >= policy.MaxAttempts || ctx.Err() != nil {
+					return response, err
+				}
+
+				retry, hint := policy.classify(response, err)
+				if !retry {
+					return response, err
+				}
+
+				delay := policy.backoff(attempt)
+				if hint > 0 {
+					if policy.MaxBackoff > 0 && hint > policy.MaxBackoff {
+						// retrying sooner than the server asked would only be rejected again
+						return response, err
+					}
+					delay = hint
+				}
+
+				if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+					// there's no point waiting if the context will expire before we can retry
+					return response, err
+				}
+
+				timer := time.NewTimer(delay)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return response, err
+				case <-timer.C:
+				}
+			}
+		},
+	}
+}
+
+// classify decides if a request should be retried, and returns the server's requested delay if one was supplied
+func (p *RetryPolicy) classify(response *jsonrpc.RawResponse, err error) (bool, time.Duration) {
+	if err != nil {
+		if httpErr, ok := errors.Cause(err).(*HTTPError); ok {
+			for _, code := range p.RetryableStatusCodes {
+				if code == httpErr.StatusCode {
+					return true, retryAfter(httpErr.Header.Get("Retry-After"))
+				}
+			}
+
+			return false, 0
+		}
+
+		return p.RetryTransportErrors, 0
+	}
+
+	e := ResponseError(response)
+	if e == nil {
+		return false, 0
+	}
+
+	for _, code := range p.RetryableErrorCodes {
+		if code == e.Code {
+			return true, backoffHint(response)
+		}
+	}
+
+	return false, 0
+}
+
+// backoff returns the jittered delay to wait after the nth attempt
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	d := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		d -= d * p.Jitter * rand.Float64()
+	}
+
+	return time.Duration(d)
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date
+func retryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
+
+// backoffHint extracts the backoff_seconds that some providers include in the data of rate limiting errors
+func backoffHint(response *jsonrpc.RawResponse) time.Duration {
+	e := struct {
+		Data struct {
+			BackoffSeconds float64 `json:"backoff_seconds"`
+		} `json:"data"`
+	}{}
+
+	if err := json.Unmarshal(*response.Error, &e); err != nil || e.Data.BackoffSeconds <= 0 {
+		return 0
+	}
+
+	return time.Duration(e.Data.BackoffSeconds * float64(time.Second))
+}
@@ -0,0 +1,142 @@
+package node_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+)
+
+func fastRetryPolicy() node.RetryPolicy {
+	policy := node.DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	return policy
+}
+
+func TestRetryInterceptor_ErrorCodes(t *testing.T) {
+	ctx := context.Background()
+
+	attempts := 0
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		attempts++
+		switch {
+		case r.Method == "eth_chainId":
+			return nodetest.Error(r, `{"code":-32601,"message":"method not found"}`), nil
+		case attempts < 3:
+			return nodetest.Error(r, `{"code":-32005,"message":"limit exceeded"}`), nil
+		default:
+			return nodetest.Result(r, `"0x10"`), nil
+		}
+	})
+
+	client := nodetest.NewClient(t, requester, nil, node.WithInterceptors(node.NewRetryInterceptor(fastRetryPolicy())))
+
+	num, err := client.BlockNumber(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), num)
+	require.Equal(t, 3, attempts)
+
+	// non-retryable error codes are returned immediately
+	attempts = 0
+	_, err = client.ChainId(ctx)
+	require.Error(t, err)
+	require.Equal(t, 1, attempts)
+}
+
+func TestRetryInterceptor_HTTPStatus(t *testing.T) {
+	ctx := context.Background()
+
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`))
+	}))
+	defer server.Close()
+
+	client, err := node.NewClient(ctx, server.URL, node.WithInterceptors(node.NewRetryInterceptor(fastRetryPolicy())))
+	require.NoError(t, err)
+
+	num, err := client.BlockNumber(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), num)
+	require.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+
+	// eth_sendRawTransaction is excluded by default
+	atomic.StoreInt32(&attempts, 0)
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err = client.SendRawTransaction(ctx, "0x00")
+	require.Error(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+
+	httpErr, ok := errors.Cause(err).(*node.HTTPError)
+	require.True(t, ok, "error should be caused by an HTTPError")
+	require.Equal(t, http.StatusServiceUnavailable, httpErr.StatusCode)
+}
+
+func TestRetryInterceptor_RetryAfterRespectsDeadline(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	policy := fastRetryPolicy()
+	policy.MaxBackoff = time.Minute
+	client, err := node.NewClient(context.Background(), server.URL, node.WithInterceptors(node.NewRetryInterceptor(policy)))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, err = client.BlockNumber(ctx)
+	require.Error(t, err)
+	require.True(t, time.Since(start) < time.Second, "should give up instead of waiting past the deadline")
+	require.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryInterceptor_RetryAfterAboveMaxBackoff(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "86400")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client, err := node.NewClient(context.Background(), server.URL, node.WithInterceptors(node.NewRetryInterceptor(node.DefaultRetryPolicy())))
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.BlockNumber(context.Background())
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		require.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("should give up instead of waiting a day")
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+}
@@ -0,0 +1,104 @@
+package node_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+)
+
+func TestClient_Trace(t *testing.T) {
+	ctx := context.Background()
+
+	var method string
+	var params jsonrpc.Params
+	var response func(r *jsonrpc.Request) *jsonrpc.RawResponse
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		method = r.Method
+		params = r.Params
+		return response(r), nil
+	})
+
+	client := nodetest.NewClient(t, requester, nil)
+
+	hash := "0xd4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+	frame := `{"type":"CALL","from":"0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b","to":"0x1000000000000000000000000000000000000001","value":"0x1","gas":"0x5208","gasUsed":"0x5208","input":"0x"}`
+
+	t.Run("transaction", func(t *testing.T) {
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Result(r, frame)
+		}
+
+		result, err := client.TraceTransaction(ctx, hash, eth.TraceConfig{CallTracer: &eth.CallTracerConfig{OnlyTopCall: true}})
+		require.NoError(t, err)
+		require.Equal(t, "debug_traceTransaction", method)
+		require.Len(t, params, 2)
+		require.JSONEq(t, `"`+hash+`"`, string(params[0]))
+		require.JSONEq(t, `{"tracer":"callTracer","tracerConfig":{"onlyTopCall":true}}`, string(params[1]))
+		require.NotNil(t, result.CallFrame)
+		require.Equal(t, uint64(21000), result.CallFrame.GasUsed.UInt64())
+
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Result(r, `null`)
+		}
+		_, err = client.TraceTransaction(ctx, hash, eth.TraceConfig{})
+		require.Equal(t, node.ErrTransactionNotFound, err)
+	})
+
+	t.Run("call", func(t *testing.T) {
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Result(r, `{"pre":{},"post":{}}`)
+		}
+
+		to := eth.MustAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+		msg := eth.NewCallMsg(&eth.Transaction{To: to})
+		number := eth.QuantityFromUInt64(100)
+		config := eth.TraceCallConfig{
+			TraceConfig: eth.TraceConfig{PrestateTracer: &eth.PrestateTracerConfig{DiffMode: true}},
+			Overrides:   &eth.CallOverrides{Block: &eth.BlockOverrides{Number: &number}},
+		}
+
+		result, err := client.TraceCall(ctx, msg, *eth.MustBlockSpecifier("latest"), config)
+		require.NoError(t, err)
+		require.Equal(t, "debug_traceCall", method)
+		require.Len(t, params, 3)
+		require.JSONEq(t, `"latest"`, string(params[1]))
+		require.JSONEq(t, `{"tracer":"prestateTracer","tracerConfig":{"diffMode":true},"blockOverrides":{"number":"0x64"}}`, string(params[2]))
+		require.NotNil(t, result.PrestateDiff)
+
+		code := eth.Data("0x6000")
+		slot := eth.Data32("0x0000000000000000000000000000000000000000000000000000000000000001")
+		config.Overrides = &eth.CallOverrides{State: eth.StateOverride{
+			*to: {Code: &code, State: map[eth.Data32]eth.Data32{slot: slot}, StateDiff: map[eth.Data32]eth.Data32{slot: slot}},
+		}}
+		_, err = client.TraceCall(ctx, msg, *eth.MustBlockSpecifier("latest"), config)
+		require.Error(t, err)
+	})
+
+	t.Run("block by number", func(t *testing.T) {
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Result(r, `[{"txHash":"`+hash+`","result":`+frame+`},{"txHash":"`+hash+`","error":"execution timeout"}]`)
+		}
+
+		traces, err := client.TraceBlockByNumber(ctx, *eth.MustBlockNumberOrTag("0x10"), eth.TraceConfig{CallTracer: &eth.CallTracerConfig{}})
+		require.NoError(t, err)
+		require.Equal(t, "debug_traceBlockByNumber", method)
+		require.JSONEq(t, `"0x10"`, string(params[0]))
+		require.Len(t, traces, 2)
+		require.Equal(t, hash, traces[0].TxHash.String())
+		require.Equal(t, "CALL", traces[0].Result.CallFrame.Type)
+		require.Nil(t, traces[1].Result)
+		require.Equal(t, "execution timeout", traces[1].Error)
+
+		response = func(r *jsonrpc.Request) *jsonrpc.RawResponse {
+			return nodetest.Error(r, `{"code":-32000,"message":"block #16 not found"}`)
+		}
+		_, err = client.TraceBlockByNumber(ctx, *eth.MustBlockNumberOrTag("0x10"), eth.TraceConfig{})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,389 @@
+// Package tracker follows the head of the chain through a newHeads subscription, keeping a window of recent
+// canonical blocks and reporting the blocks removed and added by reorgs.
+package tracker
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/node"
+)
+
+// DefaultWindowSize is the number of canonical blocks kept by a Tracker unless configured otherwise
+const DefaultWindowSize = 128
+
+// EventType identifies the kind of change reported by an Event
+type EventType string
+
+const (
+	// EventRemoved reports blocks that are no longer canonical after a reorg, highest first
+	EventRemoved EventType = "removed"
+	// EventAdded reports blocks that became canonical, lowest first, including blocks filled in when the
+	// subscription skipped heads and the new head itself
+	EventAdded EventType = "added"
+	// EventNewHead reports the new canonical head, after any EventRemoved and EventAdded for the same update
+	EventNewHead EventType = "newHead"
+	// EventSafe reports a change of the block tagged safe
+	EventSafe EventType = "safe"
+	// EventFinalized reports a change of the block tagged finalized
+	EventFinalized EventType = "finalized"
+)
+
+// Event is a change to the canonical chain as seen by a Tracker
+type Event struct {
+	Type   EventType
+	Blocks []eth.NewHeadsResult
+}
+
+// Config configures a Tracker, the zero value is valid
+type Config struct {
+	// WindowSize is the number of canonical blocks below and including the head that are kept, which is
+	// also the deepest reorg that can be fully reported.  Defaults to DefaultWindowSize.
+	WindowSize int
+
+	// DisableFinality turns off fetching the safe and finalized blocks after every new head, which is
+	// useful for chains that don't support those tags.
+	DisableFinality bool
+}
+
+// Tracker keeps a bounded window of the canonical chain keyed by hash.  New heads are linked to the window
+// by walking their ParentHash, fetching missing ancestors with BlockByHash, so that skipped heads are filled
+// in and reorgs are detected.
+type Tracker struct {
+	client node.Client
+	config Config
+
+	// adding serializes AddHead, so that concurrent heads are linked to the chain one after the other
+	adding sync.Mutex
+
+	mu        sync.RWMutex
+	byHash    map[eth.Hash]eth.NewHeadsResult
+	byNumber  map[uint64]eth.Hash
+	head      *eth.NewHeadsResult
+	safe      *eth.NewHeadsResult
+	finalized *eth.NewHeadsResult
+
+	events chan Event
+}
+
+// New returns a Tracker following the chain served by client, see Run.
+func New(client node.Client, config Config) *Tracker {
+	if config.WindowSize <= 0 {
+		config.WindowSize = DefaultWindowSize
+	}
+
+	return &Tracker{
+		client:   client,
+		config:   config,
+		byHash:   make(map[eth.Hash]eth.NewHeadsResult),
+		byNumber: make(map[uint64]eth.Hash),
+		events:   make(chan Event),
+	}
+}
+
+// Events returns the channel on which Run delivers events.  Run blocks until each event is received, so
+// the channel must be drained for the Tracker to make progress.
+func (t *Tracker) Events() <-chan Event {
+	return t.events
+}
+
+// Run subscribes to newHeads and processes every head until ctx is done or the subscription ends.
+func (t *Tracker) Run(ctx context.Context) error {
+	sub, err := t.client.SubscribeNewHeads(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not subscribe to newHeads")
+	}
+
+	defer func() {
+		_ = sub.Unsubscribe(context.Background())
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case n, ok := <-sub.Ch():
+			if !ok {
+				return errors.New("newHeads subscription ended")
+			}
+
+			params := eth.NewHeadsNotificationParams{}
+			if err := json.Unmarshal(n.Params, &params); err != nil {
+				log.Printf("[WARN] could not decode newHeads notification: %v", err)
+				continue
+			}
+
+			events, err := t.AddHead(ctx, params.Result)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+
+				log.Printf("[WARN] could not process head %s: %v", params.Result.Hash.String(), err)
+				continue
+			}
+
+			for _, event := range events {
+				select {
+				case t.events <- event:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+	}
+}
+
+// AddHead links head to the canonical chain and returns the resulting events, without sending them on the
+// Events channel.  It can be used to drive a Tracker from a source of heads other than a subscription.
+// Concurrent calls are processed one at a time, in the order they acquire the tracker.
+func (t *Tracker) AddHead(ctx context.Context, head eth.NewHeadsResult) ([]Event, error) {
+	t.adding.Lock()
+	defer t.adding.Unlock()
+
+	segment, err := t.segment(ctx, head)
+	if err != nil {
+		return nil, err
+	}
+
+	events := t.apply(head, segment)
+
+	if !t.config.DisableFinality && len(events) > 0 {
+		events = append(events, t.updateFinality(ctx)...)
+	}
+
+	return events, nil
+}
+
+// Head returns the current canonical head, or nil if no head has been seen yet
+func (t *Tracker) Head() *eth.NewHeadsResult {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return copyHead(t.head)
+}
+
+// Safe returns the last seen block tagged safe, or nil if it isn't known
+func (t *Tracker) Safe() *eth.NewHeadsResult {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return copyHead(t.safe)
+}
+
+// Finalized returns the last seen block tagged finalized, or nil if it isn't known
+func (t *Tracker) Finalized() *eth.NewHeadsResult {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return copyHead(t.finalized)
+}
+
+// BlockByHash returns the canonical block with the given hash if it is inside the window
+func (t *Tracker) BlockByHash(hash eth.Hash) (*eth.NewHeadsResult, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	block, ok := t.byHash[hash]
+	if !ok {
+		return nil, false
+	}
+
+	return block.DeepCopy(), true
+}
+
+// BlockByNumber returns the canonical block with the given number if it is inside the window
+func (t *Tracker) BlockByNumber(number uint64) (*eth.NewHeadsResult, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	hash, ok := t.byNumber[number]
+	if !ok {
+		return nil, false
+	}
+
+	block := t.byHash[hash]
+	return block.DeepCopy(), true
+}
+
+// segment returns the blocks, lowest first and ending with head, that have to become canonical for head
+// to be the new head.  It stops walking back at the first ancestor found in the window, at genesis, or once
+// the whole window would be replaced.
+func (t *Tracker) segment(ctx context.Context, head eth.NewHeadsResult) ([]eth.NewHeadsResult, error) {
+	segment := []eth.NewHeadsResult{head}
+
+	t.mu.RLock()
+	empty := t.head == nil
+	lowest := t.lowest()
+	t.mu.RUnlock()
+
+	if empty {
+		return segment, nil
+	}
+
+	current := head
+	for current.Number.UInt64() > 0 && len(segment) < t.config.WindowSize {
+		number := current.Number.UInt64() - 1
+		if number < lowest {
+			break
+		}
+
+		t.mu.RLock()
+		hash, ok := t.byNumber[number]
+		t.mu.RUnlock()
+		if ok && hash == current.ParentHash {
+			break
+		}
+
+		block, err := t.client.BlockByHash(ctx, current.ParentHash.String(), false)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not fetch block %s", current.ParentHash.String())
+		}
+
+		parent := eth.NewHeadsResult{}
+		parent.FromBlock(block)
+		segment = append(segment, parent)
+		current = parent
+	}
+
+	// reverse so the segment is lowest first
+	for i, j := 0, len(segment)-1; i < j; i, j = i+1, j-1 {
+		segment[i], segment[j] = segment[j], segment[i]
+	}
+
+	return segment, nil
+}
+
+// apply replaces the canonical blocks from the start of segment onwards and returns the resulting events
+func (t *Tracker) apply(head eth.NewHeadsResult, segment []eth.NewHeadsResult) []Event {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.head != nil && t.head.Hash == head.Hash {
+		// the head was announced again
+		return nil
+	}
+
+	from := segment[0].Number.UInt64()
+	if hash, ok := t.byNumber[head.Number.UInt64()]; ok && hash == head.Hash {
+		// the head is already canonical, so the chain went back to one of its own ancestors
+		segment = nil
+		from = head.Number.UInt64() + 1
+	}
+
+	removed := make([]eth.NewHeadsResult, 0)
+	for _, number := range t.numbers() {
+		if number < from {
+			continue
+		}
+
+		hash := t.byNumber[number]
+		removed = append(removed, t.byHash[hash])
+		delete(t.byHash, hash)
+		delete(t.byNumber, number)
+	}
+
+	// highest first
+	for i, j := 0, len(removed)-1; i < j; i, j = i+1, j-1 {
+		removed[i], removed[j] = removed[j], removed[i]
+	}
+
+	for _, block := range segment {
+		t.byHash[block.Hash] = block
+		t.byNumber[block.Number.UInt64()] = block.Hash
+	}
+
+	t.head = head.DeepCopy()
+	t.prune()
+
+	events := make([]Event, 0, 3)
+	if len(removed) > 0 {
+		events = append(events, Event{Type: EventRemoved, Blocks: removed})
+	}
+
+	if len(segment) > 0 {
+		events = append(events, Event{Type: EventAdded, Blocks: segment})
+	}
+
+	return append(events, Event{Type: EventNewHead, Blocks: []eth.NewHeadsResult{head}})
+}
+
+// updateFinality fetches the safe and finalized blocks, returning events for those that changed
+func (t *Tracker) updateFinality(ctx context.Context) []Event {
+	events := make([]Event, 0)
+	for _, tag := range []eth.Tag{eth.TagSafe, eth.TagFinalized} {
+		block, err := t.client.BlockByNumberOrTag(ctx, *eth.MustBlockNumberOrTag(tag.String()), false)
+		if err != nil {
+			log.Printf("[WARN] could not fetch %s block: %v", tag, err)
+			continue
+		}
+
+		result := eth.NewHeadsResult{}
+		result.FromBlock(block)
+
+		t.mu.Lock()
+		current, eventType := &t.safe, EventSafe
+		if tag == eth.TagFinalized {
+			current, eventType = &t.finalized, EventFinalized
+		}
+
+		changed := *current == nil || (*current).Hash != result.Hash
+		if changed {
+			*current = result.DeepCopy()
+		}
+		t.mu.Unlock()
+
+		if changed {
+			events = append(events, Event{Type: eventType, Blocks: []eth.NewHeadsResult{result}})
+		}
+	}
+
+	return events
+}
+
+// prune drops blocks that fell out of the window, t.mu must be held
+func (t *Tracker) prune() {
+	head := t.head.Number.UInt64()
+	if head < uint64(t.config.WindowSize) {
+		return
+	}
+
+	for number, hash := range t.byNumber {
+		if number <= head-uint64(t.config.WindowSize) {
+			delete(t.byHash, hash)
+			delete(t.byNumber, number)
+		}
+	}
+}
+
+// numbers returns the numbers of the canonical blocks in the window in ascending order, t.mu must be held
+func (t *Tracker) numbers() []uint64 {
+	numbers := make([]uint64, 0, len(t.byNumber))
+	for number := range t.byNumber {
+		numbers = append(numbers, number)
+	}
+
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	return numbers
+}
+
+// lowest returns the number of the lowest block in the window, t.mu must be held
+func (t *Tracker) lowest() uint64 {
+	numbers := t.numbers()
+	if len(numbers) == 0 {
+		return 0
+	}
+
+	return numbers[0]
+}
+
+func copyHead(head *eth.NewHeadsResult) *eth.NewHeadsResult {
+	if head == nil {
+		return nil
+	}
+
+	return head.DeepCopy()
+}
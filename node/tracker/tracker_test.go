@@ -0,0 +1,217 @@
+package tracker_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+	"github.com/INFURA/go-ethlibs/node/tracker"
+)
+
+const blockTemplate = `{"difficulty":"0x0","extraData":"0x","gasLimit":"0x1c9c380","gasUsed":"0x0","hash":"%s","logsBloom":"0x%0512x","miner":"0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c","mixHash":"0x%064x","nonce":"0x0000000000000000","number":"0x%x","parentHash":"%s","receiptsRoot":"0x%064x","sha3Uncles":"0x%064x","size":"0x220","stateRoot":"0x%064x","timestamp":"0x5b541449","totalDifficulty":"0x0","transactions":[],"transactionsRoot":"0x%064x","uncles":[]}`
+
+// chain serves blocks of several forks, where each fork branches off the original chain at a given number
+type chain struct {
+	branches map[int]uint64
+	safe     string
+	final    string
+
+	// delays slow down fetching the blocks of some forks
+	delays map[int]time.Duration
+
+	mu sync.Mutex
+}
+
+func (c *chain) block(number uint64, fork int) string {
+	parentFork := fork
+	if number-1 < c.branches[fork] {
+		parentFork = 0
+	}
+
+	parent := nodetest.Hash(number-1, parentFork)
+	if number == 0 {
+		parent = fmt.Sprintf("0x%064x", 0)
+	}
+
+	return fmt.Sprintf(blockTemplate, nodetest.Hash(number, fork), 0, 0, number, parent, 0, 0, 0, 0)
+}
+
+func (c *chain) head(t *testing.T, number uint64, fork int) eth.NewHeadsResult {
+	block := eth.Block{}
+	require.NoError(t, json.Unmarshal([]byte(c.block(number, fork)), &block))
+
+	head := eth.NewHeadsResult{}
+	head.FromBlock(&block)
+	return head
+}
+
+func (c *chain) client(t *testing.T, fetched *[]string) node.Client {
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		result := "null"
+		switch r.Method {
+		case "eth_getBlockByHash":
+			h := ""
+			require.NoError(t, r.Params.UnmarshalSingleParam(0, &h))
+			c.mu.Lock()
+			*fetched = append(*fetched, h)
+			c.mu.Unlock()
+
+			number, fork, err := nodetest.ParseHash(h)
+			require.NoError(t, err)
+			time.Sleep(c.delays[fork])
+			result = c.block(number, fork)
+		case "eth_getBlockByNumber":
+			tag := ""
+			require.NoError(t, r.Params.UnmarshalSingleParam(0, &tag))
+			switch tag {
+			case "safe":
+				result = c.safe
+			case "finalized":
+				result = c.final
+			}
+		}
+
+		return nodetest.Result(r, result), nil
+	})
+
+	client := nodetest.NewClient(t, requester, nil)
+	return client
+}
+
+func hashes(blocks []eth.NewHeadsResult) []string {
+	out := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		out = append(out, b.Hash.String())
+	}
+	return out
+}
+
+func TestTracker_AddHead(t *testing.T) {
+	ctx := context.Background()
+	c := &chain{branches: map[int]uint64{1: 8}}
+	c.safe = c.block(5, 0)
+	c.final = c.block(3, 0)
+
+	var fetched []string
+	tr := tracker.New(c.client(t, &fetched), tracker.Config{WindowSize: 16})
+
+	events, err := tr.AddHead(ctx, c.head(t, 7, 0))
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.Equal(t, tracker.EventAdded, events[0].Type)
+	require.Equal(t, tracker.EventNewHead, events[1].Type)
+	require.Equal(t, tracker.EventSafe, events[2].Type)
+	require.Equal(t, tracker.EventFinalized, events[3].Type)
+	require.Equal(t, nodetest.Hash(3, 0), tr.Finalized().Hash.String())
+	require.Empty(t, fetched, "the first head doesn't need its ancestors")
+
+	// a skipped head is filled in from the parent hashes
+	events, err = tr.AddHead(ctx, c.head(t, 10, 0))
+	require.NoError(t, err)
+	require.Len(t, events, 2, "safe and finalized didn't change")
+	require.Equal(t, tracker.EventAdded, events[0].Type)
+	require.Equal(t, []string{nodetest.Hash(8, 0), nodetest.Hash(9, 0), nodetest.Hash(10, 0)}, hashes(events[0].Blocks))
+	require.Equal(t, []string{nodetest.Hash(9, 0), nodetest.Hash(8, 0)}, fetched)
+
+	// fork 1 branches off after block 7, so 8 through 10 are replaced
+	fetched = nil
+	c.safe = c.block(8, 1)
+	events, err = tr.AddHead(ctx, c.head(t, 11, 1))
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.Equal(t, tracker.EventRemoved, events[0].Type)
+	require.Equal(t, []string{nodetest.Hash(10, 0), nodetest.Hash(9, 0), nodetest.Hash(8, 0)}, hashes(events[0].Blocks))
+	require.Equal(t, tracker.EventAdded, events[1].Type)
+	require.Equal(t, []string{nodetest.Hash(8, 1), nodetest.Hash(9, 1), nodetest.Hash(10, 1), nodetest.Hash(11, 1)}, hashes(events[1].Blocks))
+	require.Equal(t, tracker.EventNewHead, events[2].Type)
+	require.Equal(t, tracker.EventSafe, events[3].Type)
+
+	block, ok := tr.BlockByNumber(9)
+	require.True(t, ok)
+	require.Equal(t, nodetest.Hash(9, 1), block.Hash.String())
+
+	_, ok = tr.BlockByHash(*eth.MustHash(nodetest.Hash(9, 0)))
+	require.False(t, ok, "removed blocks are no longer canonical")
+
+	// announcing the current head again is a no-op
+	events, err = tr.AddHead(ctx, c.head(t, 11, 1))
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	// going back to a canonical ancestor removes the blocks above it
+	events, err = tr.AddHead(ctx, c.head(t, 9, 1))
+	require.NoError(t, err)
+	require.Equal(t, tracker.EventRemoved, events[0].Type)
+	require.Equal(t, []string{nodetest.Hash(11, 1), nodetest.Hash(10, 1)}, hashes(events[0].Blocks))
+	require.Equal(t, tracker.EventNewHead, events[1].Type)
+	require.Equal(t, nodetest.Hash(9, 1), tr.Head().Hash.String())
+}
+
+func TestTracker_Window(t *testing.T) {
+	ctx := context.Background()
+	c := &chain{}
+
+	var fetched []string
+	tr := tracker.New(c.client(t, &fetched), tracker.Config{WindowSize: 4, DisableFinality: true})
+
+	for number := uint64(1); number <= 10; number++ {
+		_, err := tr.AddHead(ctx, c.head(t, number, 0))
+		require.NoError(t, err)
+	}
+
+	_, ok := tr.BlockByNumber(6)
+	require.False(t, ok)
+	_, ok = tr.BlockByNumber(7)
+	require.True(t, ok)
+	require.Empty(t, fetched)
+}
+
+func TestTracker_ConcurrentHeads(t *testing.T) {
+	ctx := context.Background()
+	c := &chain{branches: map[int]uint64{1: 5}, delays: map[int]time.Duration{0: 50 * time.Millisecond}}
+
+	var fetched []string
+	tr := tracker.New(c.client(t, &fetched), tracker.Config{WindowSize: 16, DisableFinality: true})
+	for number := uint64(4); number <= 8; number++ {
+		_, err := tr.AddHead(ctx, c.head(t, number, 0))
+		require.NoError(t, err)
+	}
+
+	// head 10 of fork 0 is slow to link, so fork 1 has to wait for it instead of replacing block 8 in the meantime
+	mu := sync.Mutex{}
+	var done []string
+	wg := sync.WaitGroup{}
+	for _, head := range []eth.NewHeadsResult{c.head(t, 10, 0), c.head(t, 8, 1)} {
+		wg.Add(1)
+		go func(head eth.NewHeadsResult) {
+			defer wg.Done()
+			_, err := tr.AddHead(ctx, head)
+			require.NoError(t, err)
+
+			mu.Lock()
+			done = append(done, head.Hash.String())
+			mu.Unlock()
+		}(head)
+		time.Sleep(10 * time.Millisecond)
+	}
+	wg.Wait()
+
+	require.Equal(t, []string{nodetest.Hash(10, 0), nodetest.Hash(8, 1)}, done)
+	require.Equal(t, nodetest.Hash(8, 1), tr.Head().Hash.String())
+	for number := uint64(8); number > 4; number-- {
+		block, ok := tr.BlockByNumber(number)
+		require.True(t, ok, "block %d is missing", number)
+		parent, ok := tr.BlockByNumber(number - 1)
+		require.True(t, ok, "block %d is missing", number-1)
+		require.Equal(t, parent.Hash, block.ParentHash, "block %d doesn't link to its parent", number)
+	}
+}
@@ -0,0 +1,539 @@
+// Package txmanager sends transactions from a single account and follows them until they are confirmed,
+// re-broadcasting them with higher fees when they aren't mined and again when a reorg drops them.
+package txmanager
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/nonce"
+)
+
+const (
+	// DefaultPollInterval is how often receipts are polled unless configured otherwise
+	DefaultPollInterval = 2 * time.Second
+	// DefaultRebroadcastTimeout is how long a transaction may stay unmined before its fees are bumped
+	DefaultRebroadcastTimeout = time.Minute
+	// MinFeeBumpPercent is the minimum fee increase nodes accept for a replacement transaction
+	MinFeeBumpPercent = 10
+	// MinBlobFeeBumpPercent is the minimum fee increase nodes accept for a replacement blob transaction
+	MinBlobFeeBumpPercent = 100
+)
+
+// ErrTransactionFailed is returned along with the receipt of a transaction that was mined but reverted
+var ErrTransactionFailed = errors.New("transaction failed")
+
+// Config configures a Manager
+type Config struct {
+	// PrivateKey is the hex encoded key of the sending account
+	PrivateKey string
+
+	// Confirmations is the number of blocks, including the one the transaction was mined in, required before
+	// a transaction is considered final.  Defaults to 1.
+	Confirmations uint64
+
+	// PollInterval is how often receipts are polled, defaults to DefaultPollInterval
+	PollInterval time.Duration
+
+	// RebroadcastTimeout is how long a transaction may stay unmined before it is replaced with one paying
+	// higher fees, defaults to DefaultRebroadcastTimeout
+	RebroadcastTimeout time.Duration
+
+	// FeeBumpPercent is how much fees are increased by on every replacement, at least MinFeeBumpPercent
+	FeeBumpPercent uint64
+
+	// MaxFeePerGas caps the gas price (or max fee per gas) replacements may pay, nil means no cap
+	MaxFeePerGas *big.Int
+
+	// Nonces hands out nonces for the account, defaults to a nonce.Manager without persistence
+	Nonces *nonce.Manager
+}
+
+// Result describes how a transaction sent by a Manager ended up being mined
+type Result struct {
+	// Receipt is the receipt of the version of the transaction that was mined
+	Receipt *eth.TransactionReceipt
+
+	// Transaction is the version of the transaction that was mined
+	Transaction *eth.Transaction
+
+	// Hashes are the hashes of every version broadcast, in order
+	Hashes []eth.Hash
+
+	// Resent counts how many times the transaction was broadcast again after a reorg dropped it
+	Resent int
+}
+
+// Manager fills in, signs, sends and confirms transactions from a single account
+type Manager struct {
+	client node.Client
+	config Config
+	from   eth.Address
+
+	mu      sync.Mutex
+	chainID *eth.Quantity
+}
+
+// New returns a Manager sending transactions from the account of config.PrivateKey through client
+func New(client node.Client, config Config) (*Manager, error) {
+	from, err := eth.PrivateKeyAddress(config.PrivateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid private key")
+	}
+
+	if config.Confirmations == 0 {
+		config.Confirmations = 1
+	}
+
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultPollInterval
+	}
+
+	if config.RebroadcastTimeout <= 0 {
+		config.RebroadcastTimeout = DefaultRebroadcastTimeout
+	}
+
+	if config.FeeBumpPercent < MinFeeBumpPercent {
+		config.FeeBumpPercent = MinFeeBumpPercent
+	}
+
+	if config.Nonces == nil {
+		config.Nonces = nonce.NewManager(client, nil)
+	}
+
+	return &Manager{client: client, config: config, from: *from}, nil
+}
+
+// From returns the address transactions are sent from
+func (m *Manager) From() eth.Address {
+	return m.from
+}
+
+// Send assigns tx a nonce, fills in its gas limit, fees and chain id when missing, signs it and broadcasts it,
+// then waits until it has the configured number of confirmations.  The nonce of tx is always replaced.
+//
+// If no version of the transaction is mined within RebroadcastTimeout, it is replaced by one paying higher
+// fees, and if a reorg drops the mined version, the latest version is broadcast again.  A transaction that was
+// mined but reverted is reported with its receipt and ErrTransactionFailed.
+func (m *Manager) Send(ctx context.Context, tx eth.Transaction) (*Result, error) {
+	reservation, err := m.config.Nonces.Reserve(ctx, m.from)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.From = m.from
+	tx.Nonce = eth.QuantityFromUInt64(reservation.Nonce)
+
+	raw, err := m.prepare(ctx, &tx)
+	if err == nil {
+		err = m.broadcast(ctx, raw)
+	}
+
+	if err != nil && (raw == nil || isRejected(err)) {
+		if releaseErr := reservation.Release(ctx, err); releaseErr != nil {
+			log.Printf("[WARN] could not release nonce %d: %v", reservation.Nonce, releaseErr)
+		}
+		return nil, err
+	}
+
+	if err != nil {
+		// the node may have received the transaction anyway, so the nonce is kept and the transaction is
+		// broadcast again with higher fees if it isn't mined
+		log.Printf("[WARN] could not send %s: %v", tx.Hash.String(), err)
+	}
+
+	reservation.Commit()
+	return m.wait(ctx, &tx, raw)
+}
+
+// attempt is a signed version of the transaction being sent
+type attempt struct {
+	tx  *eth.Transaction
+	raw *eth.Data
+}
+
+func (m *Manager) wait(ctx context.Context, tx *eth.Transaction, raw *eth.Data) (*Result, error) {
+	attempts := []attempt{{tx: tx, raw: raw}}
+	result := Result{Hashes: []eth.Hash{tx.Hash}}
+	broadcastAt := time.Now()
+	mined := false
+
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return &result, ctx.Err()
+		case <-ticker.C:
+		}
+
+		receipt, i, err := m.receipt(ctx, attempts)
+		if err != nil {
+			log.Printf("[WARN] could not get receipt for %s: %v", tx.Hash.String(), err)
+			continue
+		}
+
+		latest := attempts[len(attempts)-1]
+		if receipt == nil {
+			switch {
+			case mined:
+				// the block the transaction was mined in was reorged out and the new chain doesn't include it
+				mined = false
+				result.Resent++
+				broadcastAt = time.Now()
+				if err := m.broadcast(ctx, latest.raw); err != nil {
+					log.Printf("[WARN] could not re-send %s dropped by a reorg: %v", latest.tx.Hash.String(), err)
+				}
+			case time.Since(broadcastAt) >= m.config.RebroadcastTimeout:
+				broadcastAt = time.Now()
+				replacement, raw, err := m.bump(ctx, latest.tx)
+				if err != nil {
+					log.Printf("[WARN] could not bump fees of %s: %v", latest.tx.Hash.String(), err)
+					continue
+				}
+
+				if err := m.broadcast(ctx, raw); err != nil {
+					log.Printf("[WARN] could not send replacement of %s: %v", latest.tx.Hash.String(), err)
+					if isRejected(err) {
+						continue
+					}
+				}
+
+				attempts = append(attempts, attempt{tx: replacement, raw: raw})
+				result.Hashes = append(result.Hashes, replacement.Hash)
+			}
+			continue
+		}
+
+		mined = true
+		head, err := m.client.BlockNumber(ctx)
+		if err != nil {
+			log.Printf("[WARN] could not get block number: %v", err)
+			continue
+		}
+
+		number := receipt.BlockNumber.UInt64()
+		if head < number || head-number+1 < m.config.Confirmations {
+			continue
+		}
+
+		// the receipt may be stale if a reorg happened since it was fetched
+		block, err := m.client.BlockByNumber(ctx, number, false)
+		if err != nil || block.Hash == nil || *block.Hash != receipt.BlockHash {
+			continue
+		}
+
+		result.Receipt = receipt
+		result.Transaction = attempts[i].tx
+		if receipt.Status != nil && receipt.Status.UInt64() == 0 {
+			return &result, ErrTransactionFailed
+		}
+
+		return &result, nil
+	}
+}
+
+// receipt returns the receipt of whichever attempt was mined, and its index, or nil if none was
+func (m *Manager) receipt(ctx context.Context, attempts []attempt) (*eth.TransactionReceipt, int, error) {
+	for i := len(attempts) - 1; i >= 0; i-- {
+		receipt, err := m.client.TransactionReceipt(ctx, attempts[i].tx.Hash.String())
+		if err != nil {
+			if errors.Cause(err) == node.ErrTransactionNotFound {
+				continue
+			}
+			return nil, 0, err
+		}
+
+		return receipt, i, nil
+	}
+
+	return nil, 0, nil
+}
+
+// broadcast sends a signed transaction, treating transactions the node already has as sent.  Errors returned
+// by the node are *jsonrpc.Error, see isRejected.
+func (m *Manager) broadcast(ctx context.Context, raw *eth.Data) error {
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "eth_sendRawTransaction",
+		Params: jsonrpc.MustParams(raw.String()),
+	}
+
+	response, err := m.client.Request(ctx, &request)
+	if err != nil {
+		return errors.Wrap(err, "could not make request")
+	}
+
+	if e := node.ResponseError(response); e != nil && !nonce.IsAlreadyKnown(e) {
+		return e
+	}
+
+	return nil
+}
+
+// isRejected returns true if err is the node refusing a transaction, as opposed to a timeout or transport
+// error after which the transaction may still have reached the node
+func isRejected(err error) bool {
+	_, ok := errors.Cause(err).(*jsonrpc.Error)
+	return ok
+}
+
+// isDynamicFee returns true if tx is priced with MaxFeePerGas and MaxPriorityFeePerGas rather than GasPrice
+func isDynamicFee(tx *eth.Transaction) bool {
+	typ := tx.TransactionType()
+	return typ != eth.TransactionTypeLegacy && typ != eth.TransactionTypeAccessList
+}
+
+// prepare fills in the missing fields of tx and signs it
+func (m *Manager) prepare(ctx context.Context, tx *eth.Transaction) (*eth.Data, error) {
+	chainID, err := m.getChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx.Gas.UInt64() == 0 {
+		gas, err := m.client.EstimateGas(ctx, *tx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not estimate gas")
+		}
+		tx.Gas = eth.QuantityFromUInt64(gas)
+	}
+
+	if err := m.fillFees(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	if tx.Input == "" {
+		tx.Input = "0x"
+	}
+
+	if tx.TransactionType() != eth.TransactionTypeLegacy {
+		tx.ChainId = chainID
+	}
+
+	return tx.Sign(m.config.PrivateKey, *chainID)
+}
+
+func (m *Manager) fillFees(ctx context.Context, tx *eth.Transaction) error {
+	if tx.TransactionType() == eth.TransactionTypeBlob && tx.MaxFeePerBlobGas == nil {
+		blobBaseFee, err := m.blobBaseFee(ctx)
+		if err != nil {
+			return err
+		}
+
+		// twice the blob base fee, like the max fee per gas
+		q := eth.QuantityFromBigInt(new(big.Int).Mul(blobBaseFee, big.NewInt(2)))
+		tx.MaxFeePerBlobGas = &q
+	}
+
+	dynamic := tx.MaxFeePerGas != nil || tx.MaxPriorityFeePerGas != nil
+	if tx.Type != nil {
+		dynamic = isDynamicFee(tx)
+	}
+
+	if dynamic && tx.MaxFeePerGas != nil && tx.MaxPriorityFeePerGas != nil {
+		return nil
+	}
+
+	if !dynamic && tx.GasPrice != nil {
+		return nil
+	}
+
+	baseFee, err := m.baseFee(ctx)
+	if err != nil {
+		return err
+	}
+
+	if tx.Type == nil && baseFee != nil {
+		dynamic = true
+	}
+
+	if !dynamic {
+		price, err := m.client.GasPrice(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get gas price")
+		}
+
+		q := eth.QuantityFromUInt64(price)
+		tx.GasPrice = &q
+		return nil
+	}
+
+	if baseFee == nil {
+		return errors.New("dynamic fee transactions require a chain with a base fee")
+	}
+
+	if tx.MaxPriorityFeePerGas == nil {
+		tip, err := m.client.MaxPriorityFeePerGas(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get max priority fee per gas")
+		}
+
+		q := eth.QuantityFromUInt64(tip)
+		tx.MaxPriorityFeePerGas = &q
+	}
+
+	if tx.MaxFeePerGas == nil {
+		// twice the base fee leaves room for six consecutive full blocks
+		fee := new(big.Int).Mul(baseFee, big.NewInt(2))
+		fee.Add(fee, tx.MaxPriorityFeePerGas.Big())
+		q := eth.QuantityFromBigInt(fee)
+		tx.MaxFeePerGas = &q
+	}
+
+	if tx.Type == nil {
+		typ := eth.QuantityFromInt64(eth.TransactionTypeDynamicFee)
+		tx.Type = &typ
+	}
+
+	return nil
+}
+
+// bump returns a copy of tx paying fees high enough to replace it, signed
+func (m *Manager) bump(ctx context.Context, tx *eth.Transaction) (*eth.Transaction, *eth.Data, error) {
+	replacement := tx.DeepCopy()
+
+	percent := m.config.FeeBumpPercent
+	if tx.TransactionType() == eth.TransactionTypeBlob && percent < MinBlobFeeBumpPercent {
+		percent = MinBlobFeeBumpPercent
+	}
+
+	if !isDynamicFee(tx) {
+		price := bumped(tx.GasPrice.Big(), percent)
+		if current, err := m.client.GasPrice(ctx); err == nil && new(big.Int).SetUint64(current).Cmp(price) > 0 {
+			price.SetUint64(current)
+		}
+
+		if err := m.checkCap(price); err != nil {
+			return nil, nil, err
+		}
+
+		q := eth.QuantityFromBigInt(price)
+		replacement.GasPrice = &q
+	} else {
+		tip := bumped(tx.MaxPriorityFeePerGas.Big(), percent)
+		fee := bumped(tx.MaxFeePerGas.Big(), percent)
+		if baseFee, err := m.baseFee(ctx); err == nil && baseFee != nil {
+			min := new(big.Int).Mul(baseFee, big.NewInt(2))
+			min.Add(min, tip)
+			if min.Cmp(fee) > 0 {
+				fee = min
+			}
+		}
+
+		if err := m.checkCap(fee); err != nil {
+			return nil, nil, err
+		}
+
+		tq, fq := eth.QuantityFromBigInt(tip), eth.QuantityFromBigInt(fee)
+		replacement.MaxPriorityFeePerGas = &tq
+		replacement.MaxFeePerGas = &fq
+	}
+
+	if tx.MaxFeePerBlobGas != nil {
+		q := eth.QuantityFromBigInt(bumped(tx.MaxFeePerBlobGas.Big(), percent))
+		replacement.MaxFeePerBlobGas = &q
+	}
+
+	chainID, err := m.getChainID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	raw, err := replacement.Sign(m.config.PrivateKey, *chainID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return replacement, raw, nil
+}
+
+// bumped returns value increased by percent, rounded up and by at least 1
+func bumped(value *big.Int, percent uint64) *big.Int {
+	bumped := new(big.Int).Mul(value, big.NewInt(int64(100+percent)))
+	bumped.Add(bumped, big.NewInt(99))
+	bumped.Div(bumped, big.NewInt(100))
+	if bumped.Cmp(value) <= 0 {
+		bumped.Add(value, big.NewInt(1))
+	}
+
+	return bumped
+}
+
+func (m *Manager) checkCap(fee *big.Int) error {
+	if m.config.MaxFeePerGas != nil && fee.Cmp(m.config.MaxFeePerGas) > 0 {
+		return errors.Errorf("bumped fee %s exceeds the maximum of %s", fee.String(), m.config.MaxFeePerGas.String())
+	}
+
+	return nil
+}
+
+// baseFee returns the base fee of the latest block, or nil before EIP-1559
+func (m *Manager) baseFee(ctx context.Context) (*big.Int, error) {
+	block, err := m.client.BlockByNumberOrTag(ctx, *eth.MustBlockNumberOrTag(eth.TagLatest.String()), false)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get latest block")
+	}
+
+	if block.BaseFeePerGas == nil {
+		return nil, nil
+	}
+
+	return block.BaseFeePerGas.Big(), nil
+}
+
+// blobBaseFee returns the blob base fee of the next block, as reported by eth_blobBaseFee
+func (m *Manager) blobBaseFee(ctx context.Context) (*big.Int, error) {
+	request := jsonrpc.Request{
+		ID:     jsonrpc.ID{Num: 1},
+		Method: "eth_blobBaseFee",
+	}
+
+	response, err := m.client.Request(ctx, &request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get blob base fee")
+	}
+
+	if e := node.ResponseError(response); e != nil {
+		return nil, errors.Wrap(e, "could not get blob base fee")
+	}
+
+	q := eth.Quantity{}
+	if err := json.Unmarshal(response.Result, &q); err != nil {
+		return nil, errors.Wrap(err, "could not decode blob base fee")
+	}
+
+	return q.Big(), nil
+}
+
+func (m *Manager) getChainID(ctx context.Context) (*eth.Quantity, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.chainID != nil {
+		return m.chainID, nil
+	}
+
+	id, err := m.client.ChainId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chain id")
+	}
+
+	q, err := eth.NewQuantity(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid chain id")
+	}
+
+	m.chainID = q
+	return q, nil
+}
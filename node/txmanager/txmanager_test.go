@@ -0,0 +1,294 @@
+package txmanager_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+	"github.com/INFURA/go-ethlibs/node/txmanager"
+)
+
+const (
+	privateKey = "0xfad9c8855b740a0b7ed4c221dbad0f33a83a49cad6b3fe8d5817ac83d38b6a19"
+	sender     = "0x96216849c49358B10257cb55b28eA603c874b05E"
+)
+
+const blockTemplate = `{"baseFeePerGas":"0x%x","difficulty":"0x0","extraData":"0x","gasLimit":"0x1c9c380","gasUsed":"0x0","hash":"%s","logsBloom":"0x%0512x","miner":"0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c","mixHash":"0x%064x","nonce":"0x0000000000000000","number":"0x%x","parentHash":"0x%064x","receiptsRoot":"0x%064x","sha3Uncles":"0x%064x","size":"0x220","stateRoot":"0x%064x","timestamp":"0x5b541449","totalDifficulty":"0x0","transactions":[],"transactionsRoot":"0x%064x","uncles":[]}`
+
+const receiptTemplate = `{"blockHash":"%s","blockNumber":"0x%x","contractAddress":null,"cumulativeGasUsed":"0x5208","from":"%s","gasUsed":"0x5208","logs":[],"logsBloom":"0x%0512x","status":"0x1","to":"0x000000000000000000000000000000000000dead","transactionHash":"%s","transactionIndex":"0x0","type":"0x2"}`
+
+// chain is a fake node that mines a transaction when accept returns true for it
+type chain struct {
+	mu       sync.Mutex
+	head     uint64
+	fork     int
+	baseFee  uint64
+	tip      uint64
+	sent     []*eth.Transaction
+	mined    map[string]uint64
+	receipts int
+	accept   func(tx *eth.Transaction) bool
+
+	// fail, when set, may fail the nth eth_sendRawTransaction call (counting from 1) instead of handling it
+	fail  func(n int) (*jsonrpc.RawResponse, error)
+	sends int
+
+	blobFeeRequests int
+}
+
+func (c *chain) client(t *testing.T) node.Client {
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		result := "null"
+		switch r.Method {
+		case "eth_chainId":
+			result = `"0x5"`
+		case "eth_getTransactionCount":
+			result = `"0x7"`
+		case "eth_estimateGas":
+			result = `"0x5208"`
+		case "eth_blobBaseFee":
+			c.blobFeeRequests++
+			result = `"0x3"`
+		case "eth_maxPriorityFeePerGas":
+			result = fmt.Sprintf(`"0x%x"`, c.tip)
+		case "eth_blockNumber":
+			result = fmt.Sprintf(`"0x%x"`, c.head)
+		case "eth_getBlockByNumber":
+			tag := ""
+			require.NoError(t, r.Params.UnmarshalSingleParam(0, &tag))
+			number := c.head
+			if tag != "latest" {
+				q, err := eth.NewQuantity(tag)
+				require.NoError(t, err)
+				number = q.UInt64()
+			}
+			result = fmt.Sprintf(blockTemplate, c.baseFee, nodetest.Hash(number, c.fork), 0, 0, number, 0, 0, 0, 0, 0)
+		case "eth_sendRawTransaction":
+			c.sends++
+			if c.fail != nil {
+				if response, err := c.fail(c.sends); response != nil || err != nil {
+					return response, err
+				}
+			}
+
+			raw := ""
+			require.NoError(t, r.Params.UnmarshalSingleParam(0, &raw))
+			tx := eth.Transaction{}
+			require.NoError(t, tx.FromRaw(raw))
+			c.sent = append(c.sent, &tx)
+			if c.accept(&tx) {
+				c.head++
+				c.mined[tx.Hash.String()] = c.head
+			}
+			result = fmt.Sprintf(`"%s"`, tx.Hash.String())
+		case "eth_getTransactionReceipt":
+			h := ""
+			require.NoError(t, r.Params.UnmarshalSingleParam(0, &h))
+			if number, ok := c.mined[h]; ok {
+				c.receipts++
+				result = fmt.Sprintf(receiptTemplate, nodetest.Hash(number, c.fork), number, sender, 0, h)
+			}
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+
+		return nodetest.Result(r, result), nil
+	})
+
+	client := nodetest.NewClient(t, requester, nil)
+	return client
+}
+
+func TestManager_Send_BumpsFees(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	c := &chain{head: 100, baseFee: 1000, tip: 100, mined: map[string]uint64{}}
+	c.accept = func(tx *eth.Transaction) bool {
+		// only the second replacement pays enough
+		return tx.MaxPriorityFeePerGas.UInt64() >= 120
+	}
+
+	m, err := txmanager.New(c.client(t), txmanager.Config{
+		PrivateKey:         privateKey,
+		Confirmations:      1,
+		PollInterval:       5 * time.Millisecond,
+		RebroadcastTimeout: 20 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sender, m.From().String())
+
+	to := eth.MustAddress("0x000000000000000000000000000000000000dead")
+	result, err := m.Send(ctx, eth.Transaction{To: to, Value: eth.QuantityFromUInt64(1)})
+	require.NoError(t, err)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	require.Len(t, c.sent, 3)
+	first := c.sent[0]
+	require.Equal(t, eth.TransactionTypeDynamicFee, first.TransactionType())
+	require.Equal(t, uint64(7), first.Nonce.UInt64())
+	require.Equal(t, uint64(21000), first.Gas.UInt64())
+	require.Equal(t, uint64(5), first.ChainId.UInt64())
+	require.Equal(t, uint64(100), first.MaxPriorityFeePerGas.UInt64())
+	require.Equal(t, uint64(2100), first.MaxFeePerGas.UInt64())
+
+	for i := 1; i < len(c.sent); i++ {
+		prev, next := c.sent[i-1], c.sent[i]
+		require.Equal(t, prev.Nonce, next.Nonce)
+		minTip := new(big.Int).Div(new(big.Int).Mul(prev.MaxPriorityFeePerGas.Big(), big.NewInt(110)), big.NewInt(100))
+		minFee := new(big.Int).Div(new(big.Int).Mul(prev.MaxFeePerGas.Big(), big.NewInt(110)), big.NewInt(100))
+		require.True(t, next.MaxPriorityFeePerGas.Big().Cmp(minTip) >= 0, "tip of replacement %d not bumped by 10%%", i)
+		require.True(t, next.MaxFeePerGas.Big().Cmp(minFee) >= 0, "fee of replacement %d not bumped by 10%%", i)
+	}
+
+	require.Equal(t, []eth.Hash{c.sent[0].Hash, c.sent[1].Hash, c.sent[2].Hash}, result.Hashes)
+	require.Equal(t, c.sent[2].Hash, result.Transaction.Hash)
+	require.Equal(t, c.sent[2].Hash, result.Receipt.TransactionHash)
+	require.Equal(t, 0, result.Resent)
+}
+
+func TestManager_Send_ResendsAfterReorg(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	c := &chain{head: 100, baseFee: 1000, tip: 100, mined: map[string]uint64{}}
+	c.accept = func(tx *eth.Transaction) bool { return true }
+
+	m, err := txmanager.New(c.client(t), txmanager.Config{
+		PrivateKey:         privateKey,
+		Confirmations:      3,
+		PollInterval:       5 * time.Millisecond,
+		RebroadcastTimeout: time.Hour,
+	})
+	require.NoError(t, err)
+
+	go func() {
+		// once the transaction has been seen mined, a reorg drops it, and the new chain only includes it
+		// again when it is re-sent
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(5 * time.Millisecond):
+			}
+
+			c.mu.Lock()
+			if len(c.sent) == 1 && c.receipts > 0 {
+				c.mined = map[string]uint64{}
+				c.fork = 1
+				c.mu.Unlock()
+				return
+			}
+			c.mu.Unlock()
+		}
+	}()
+
+	gasPrice := eth.QuantityFromUInt64(5000)
+	legacy := eth.Transaction{To: eth.MustAddress("0x000000000000000000000000000000000000dead"), GasPrice: &gasPrice}
+	done := make(chan struct{})
+	var result *txmanager.Result
+	go func() {
+		defer close(done)
+		result, err = m.Send(ctx, legacy)
+	}()
+
+	// confirm the re-sent transaction
+	for {
+		c.mu.Lock()
+		if len(c.sent) == 2 {
+			c.head += 2
+			c.mu.Unlock()
+			break
+		}
+		c.mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	<-done
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Resent)
+	require.Len(t, result.Hashes, 1)
+	require.Equal(t, eth.TransactionTypeLegacy, result.Transaction.TransactionType())
+	require.Equal(t, uint64(5000), result.Transaction.GasPrice.UInt64())
+	require.Equal(t, uint64(102), result.Receipt.BlockNumber.UInt64())
+	require.Equal(t, nodetest.Hash(102, 1), result.Receipt.BlockHash.String())
+}
+
+func TestManager_Send_ReleasesRejectedNonces(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	c := &chain{head: 100, baseFee: 1000, tip: 100, mined: map[string]uint64{}}
+	c.accept = func(tx *eth.Transaction) bool { return true }
+	c.fail = func(n int) (*jsonrpc.RawResponse, error) {
+		switch n {
+		case 1:
+			e := json.RawMessage(`{"code":-32000,"message":"insufficient funds for gas * price + value"}`)
+			return &jsonrpc.RawResponse{JSONRPC: "2.0", ID: jsonrpc.ID{Num: 1}, Error: &e}, nil
+		case 2:
+			return nil, errors.New("connection reset by peer")
+		default:
+			return nil, nil
+		}
+	}
+
+	m, err := txmanager.New(c.client(t), txmanager.Config{
+		PrivateKey:         privateKey,
+		PollInterval:       5 * time.Millisecond,
+		RebroadcastTimeout: 20 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	to := eth.MustAddress("0x000000000000000000000000000000000000dead")
+	_, err = m.Send(ctx, eth.Transaction{To: to})
+	require.Error(t, err, "the node rejected the transaction")
+
+	// the rejected nonce is reused, and kept after a transport error since the node may have the transaction
+	result, err := m.Send(ctx, eth.Transaction{To: to})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), result.Transaction.Nonce.UInt64())
+	require.Len(t, result.Hashes, 2)
+
+	result, err = m.Send(ctx, eth.Transaction{To: to})
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), result.Transaction.Nonce.UInt64())
+}
+
+func TestManager_Send_FillsBlobFee(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	c := &chain{head: 100, baseFee: 1000, tip: 100, mined: map[string]uint64{}}
+	c.accept = func(tx *eth.Transaction) bool { return true }
+
+	m, err := txmanager.New(c.client(t), txmanager.Config{PrivateKey: privateKey})
+	require.NoError(t, err)
+
+	// blob transactions can't be signed yet, but their max fee per blob gas is filled in before signing
+	blob := eth.QuantityFromInt64(eth.TransactionTypeBlob)
+	_, err = m.Send(ctx, eth.Transaction{
+		Type:                &blob,
+		To:                  eth.MustAddress("0x000000000000000000000000000000000000dead"),
+		BlobVersionedHashes: []eth.Hash{*eth.MustHash("0x01" + strings.Repeat("00", 31))},
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, c.blobFeeRequests)
+	require.Equal(t, 0, c.sends)
+}
@@ -0,0 +1,123 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+)
+
+// typedSubscription holds what's common to subscriptions that decode the notifications of an
+// underlying Subscription onto a typed channel.
+type typedSubscription struct {
+	sub    Subscription
+	doneCh chan struct{}
+	once   sync.Once
+}
+
+func (s *typedSubscription) Response() *jsonrpc.RawResponse {
+	return s.sub.Response()
+}
+
+func (s *typedSubscription) ID() string {
+	return s.sub.ID()
+}
+
+func (s *typedSubscription) Unsubscribe(ctx context.Context) error {
+	s.once.Do(func() { close(s.doneCh) })
+	return s.sub.Unsubscribe(ctx)
+}
+
+// next returns the next notification from the underlying subscription, or false once it has ended or
+// been unsubscribed.
+func (s *typedSubscription) next() (*jsonrpc.Notification, bool) {
+	select {
+	case <-s.doneCh:
+		return nil, false
+	case n, ok := <-s.sub.Ch():
+		return n, ok
+	}
+}
+
+type logsSubscription struct {
+	typedSubscription
+	ch chan eth.Log
+}
+
+func newLogsSubscription(sub Subscription) *logsSubscription {
+	s := logsSubscription{
+		typedSubscription: typedSubscription{sub: sub, doneCh: make(chan struct{})},
+		ch:                make(chan eth.Log),
+	}
+
+	go func() {
+		defer close(s.ch)
+		for {
+			n, ok := s.next()
+			if !ok {
+				return
+			}
+
+			params := eth.LogsNotificationParams{}
+			if err := json.Unmarshal(n.Params, &params); err != nil {
+				log.Printf("[WARN] could not decode logs notification for subscription %s: %v", sub.ID(), err)
+				continue
+			}
+
+			select {
+			case s.ch <- params.Result:
+			case <-s.doneCh:
+				return
+			}
+		}
+	}()
+
+	return &s
+}
+
+func (s *logsSubscription) Ch() <-chan eth.Log {
+	return s.ch
+}
+
+type syncingSubscription struct {
+	typedSubscription
+	ch chan eth.SyncStatus
+}
+
+func newSyncingSubscription(sub Subscription) *syncingSubscription {
+	s := syncingSubscription{
+		typedSubscription: typedSubscription{sub: sub, doneCh: make(chan struct{})},
+		ch:                make(chan eth.SyncStatus),
+	}
+
+	go func() {
+		defer close(s.ch)
+		for {
+			n, ok := s.next()
+			if !ok {
+				return
+			}
+
+			params := eth.SyncingNotificationParams{}
+			if err := json.Unmarshal(n.Params, &params); err != nil {
+				log.Printf("[WARN] could not decode syncing notification for subscription %s: %v", sub.ID(), err)
+				continue
+			}
+
+			select {
+			case s.ch <- params.Result:
+			case <-s.doneCh:
+				return
+			}
+		}
+	}()
+
+	return &s
+}
+
+func (s *syncingSubscription) Ch() <-chan eth.SyncStatus {
+	return s.ch
+}
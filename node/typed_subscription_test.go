@@ -0,0 +1,90 @@
+package node_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/eth"
+	"github.com/INFURA/go-ethlibs/jsonrpc"
+	"github.com/INFURA/go-ethlibs/node"
+	"github.com/INFURA/go-ethlibs/node/nodetest"
+)
+
+func newSubscribingClient(t *testing.T, check func(r *jsonrpc.Request)) (node.Client, *fakeSubscription) {
+	sub := &fakeSubscription{id: "0x1", ch: make(chan *jsonrpc.Notification)}
+	requester := nodetest.RequesterFunc(func(ctx context.Context, r *jsonrpc.Request) (*jsonrpc.RawResponse, error) {
+		return nodetest.Result(r, `true`), nil
+	})
+	subscriber := nodetest.SubscriberFunc(func(ctx context.Context, r *jsonrpc.Request) (node.Subscription, error) {
+		check(r)
+		return sub, nil
+	})
+
+	client := nodetest.NewClient(t, requester, subscriber)
+	return client, sub
+}
+
+func notification(params string) *jsonrpc.Notification {
+	return &jsonrpc.Notification{JSONRPC: "2.0", Method: "eth_subscription", Params: jsonrpc.NotificationParams(params)}
+}
+
+func TestClient_SubscribeLogs(t *testing.T) {
+	ctx := context.Background()
+	client, sub := newSubscribingClient(t, func(r *jsonrpc.Request) {
+		require.Equal(t, "eth_subscribe", r.Method)
+		require.Len(t, r.Params, 2)
+		require.JSONEq(t, `"logs"`, string(r.Params[0]))
+		require.Contains(t, string(r.Params[1]), "0x8b406b4708a45f115347fc2d020735196f994c5f")
+	})
+
+	logs, err := client.SubscribeLogs(ctx, eth.LogFilter{
+		Address: []eth.Address{*eth.MustAddress("0x8b406b4708a45f115347fc2d020735196f994c5f")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0x1", logs.ID())
+
+	go func() {
+		sub.ch <- notification(`{"subscription":"0x1","result":"not a log"}`)
+		sub.ch <- notification(`{"subscription":"0x1","result":{"address":"0x8b406b4708a45f115347fc2d020735196f994c5f","blockHash":"0x2cdf35a15eaab70f694b1ef15b6375793848336e00b76b0551082b1fb6130ccd","blockNumber":"0xa4c6b2","data":"0x","logIndex":"0x3","removed":true,"topics":[],"transactionHash":"0x9cd71724c1bad4c8e09a52b5bc1d8f037d5c08f4b78626236110ce5e6e1e8cfb","transactionIndex":"0xa"}}`)
+	}()
+
+	l := <-logs.Ch()
+	require.True(t, l.Removed, "undecodable notifications are skipped and removed logs are delivered")
+	require.Equal(t, uint64(3), l.LogIndex.UInt64())
+	require.Equal(t, uint64(0xa4c6b2), l.BlockNumber.UInt64())
+
+	require.NoError(t, logs.Unsubscribe(ctx))
+	_, ok := <-logs.Ch()
+	require.False(t, ok, "channel should be closed after unsubscribing")
+}
+
+func TestClient_SubscribeSyncing(t *testing.T) {
+	ctx := context.Background()
+	client, sub := newSubscribingClient(t, func(r *jsonrpc.Request) {
+		require.Equal(t, "eth_subscribe", r.Method)
+		require.Len(t, r.Params, 1)
+		require.JSONEq(t, `"syncing"`, string(r.Params[0]))
+	})
+
+	syncing, err := client.SubscribeSyncing(ctx)
+	require.NoError(t, err)
+
+	go func() {
+		sub.ch <- notification(`{"subscription":"0x1","result":{"syncing":true,"status":{"startingBlock":1,"currentBlock":5,"highestBlock":10}}}`)
+		sub.ch <- notification(`{"subscription":"0x1","result":false}`)
+		close(sub.ch)
+	}()
+
+	status := <-syncing.Ch()
+	require.True(t, status.Syncing)
+	require.Equal(t, uint64(5), status.CurrentBlock.UInt64())
+	require.Equal(t, uint64(10), status.HighestBlock.UInt64())
+
+	status = <-syncing.Ch()
+	require.False(t, status.Syncing)
+
+	_, ok := <-syncing.Ch()
+	require.False(t, ok, "channel should be closed when the underlying subscription ends")
+}
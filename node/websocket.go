@@ -3,6 +3,7 @@ package node
 import (
 	"context"
 	"io/ioutil"
+	"net/http"
 	"net/url"
 
 	"github.com/gorilla/websocket"
@@ -15,8 +16,26 @@ type websocketTransport struct {
 
 // newWebsocketTransport creates a Connection to the passed in URL.  Use the supplied Context to shutdown the connection by
 // cancelling or otherwise aborting the context.
-func newWebsocketTransport(ctx context.Context, addr *url.URL) (transport, error) {
-	wsConn, _, err := websocket.DefaultDialer.DialContext(ctx, addr.String(), nil)
+func newWebsocketTransport(ctx context.Context, addr *url.URL, o *options) (transport, error) {
+	dialer := *websocket.DefaultDialer
+	if o.tlsConfig != nil {
+		dialer.TLSClientConfig = o.tlsConfig
+	}
+
+	headers := o.headers.Clone()
+	if o.tokenSource != nil {
+		token, err := o.tokenSource(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate authorization token")
+		}
+
+		if headers == nil {
+			headers = http.Header{}
+		}
+		headers.Set("Authorization", "Bearer "+token)
+	}
+
+	wsConn, _, err := dialer.DialContext(ctx, addr.String(), headers)
 	if err != nil {
 		return nil, err
 	}
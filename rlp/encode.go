@@ -1,73 +1,98 @@
 package rlp
 
-import (
-	"encoding/hex"
-	"math/big"
-	"strings"
-
-	"github.com/pkg/errors"
-)
+import "encoding/hex"
 
 // Encode returns the 0x prefixed hex string of the RLP value
 func (v Value) Encode() (string, error) {
-	// If String is value encode that
-	if v.IsString() {
-		if !strings.HasPrefix(v.String, "0x") {
-			return "", errors.New("invalid string value before encoding")
-		}
+	item, err := v.Item()
+	if err != nil {
+		return "", err
+	}
 
-		b, err := hex.DecodeString(v.String[2:])
-		if err != nil {
-			return "", errors.Wrap(err, "could not decode string value")
-		}
+	return "0x" + hex.EncodeToString(item.Encode()), nil
+}
 
-		switch {
-		case len(b) == 1 && b[0] <= 0x7f:
+// Encode returns the RLP encoding of the item
+func (i Item) Encode() []byte {
+	return i.AppendEncoded(make([]byte, 0, i.encodedSize()))
+}
+
+// AppendEncoded appends the RLP encoding of the item to dst and returns the extended slice
+func (i Item) AppendEncoded(dst []byte) []byte {
+	// If Bytes is valid encode that
+	if i.IsString() {
+		if len(i.Bytes) == 1 && i.Bytes[0] <= 0x7f {
 			// then the string is it's own encoding
-			return v.String, nil
-		case len(b) < 56:
-			return "0x" + asUnprefixedHex(uint64(0x80+len(b))) + v.String[2:], nil
-		default:
-			size := asUnprefixedHex(uint64(len(b)))
-			sizeSize := uint64(len(size) / 2)
-			return "0x" + asUnprefixedHex(0xb7+sizeSize) + size + v.String[2:], nil
-		}
-	} else {
-		// Otherwise encode the list, even if empty
-		count := len(v.List)
-		if count == 0 {
-			// return the empty list
-			return "0xc0", nil
+			return append(dst, i.Bytes[0])
 		}
 
-		data := make([]string, len(v.List))
-		for i, item := range v.List {
-			encoded, err := item.Encode()
-			if err != nil {
-				return "", errors.Wrap(err, "could not encode child item")
-			}
+		dst = appendHeader(dst, 0x80, uint64(len(i.Bytes)))
+		return append(dst, i.Bytes...)
+	}
 
-			// Discard the 0x prefix
-			data[i] = encoded[2:]
-		}
+	// Otherwise encode the list, even if empty
+	dst = appendHeader(dst, 0xc0, uint64(i.payloadSize()))
+	for _, item := range i.List {
+		dst = item.AppendEncoded(dst)
+	}
 
-		body := strings.Join(data, "")
-		bodySize := uint64(len(body) / 2)
-		if bodySize < 56 {
-			// 0xc0 + bodySize
-			return "0x" + asUnprefixedHex(bodySize+0xc0) + body, nil
-		} else {
-			bodySizeHex := asUnprefixedHex(bodySize)
-			bodySizeSize := uint64(len(bodySizeHex) / 2)
-			return "0x" + asUnprefixedHex(bodySizeSize+0xf7) + bodySizeHex + body, nil
-		}
+	return dst
+}
+
+// encodedSize returns the length of the RLP encoding of the item
+func (i Item) encodedSize() int {
+	if i.IsString() && len(i.Bytes) == 1 && i.Bytes[0] <= 0x7f {
+		return 1
 	}
+
+	size := i.payloadSize()
+	return headerSize(uint64(size)) + size
 }
 
-// asUnprefixedHex converts a uint64 to a hex string WITHOUT the 0x prefix
-func asUnprefixedHex(i uint64) string {
-	bn := big.NewInt(0).SetUint64(i)
-	b := bn.Bytes()
-	h := hex.EncodeToString(b)
-	return h
+// payloadSize returns the length of the string, or of the concatenated encodings of the list items
+func (i Item) payloadSize() int {
+	if i.IsString() {
+		return len(i.Bytes)
+	}
+
+	size := 0
+	for _, item := range i.List {
+		size += item.encodedSize()
+	}
+
+	return size
+}
+
+// appendHeader appends the prefix of a string (offset 0x80) or list (offset 0xc0) with a payload of size bytes
+func appendHeader(dst []byte, offset byte, size uint64) []byte {
+	if size < 56 {
+		return append(dst, offset+byte(size))
+	}
+
+	sizeSize := uint64Size(size)
+	// 0xb7 and 0xf7 for long strings and lists respectively
+	dst = append(dst, offset+55+byte(sizeSize))
+	for j := sizeSize - 1; j >= 0; j-- {
+		dst = append(dst, byte(size>>(8*uint(j))))
+	}
+
+	return dst
+}
+
+func headerSize(size uint64) int {
+	if size < 56 {
+		return 1
+	}
+
+	return 1 + uint64Size(size)
+}
+
+// uint64Size returns the number of bytes needed to represent i without leading zeroes
+func uint64Size(i uint64) int {
+	size := 0
+	for ; i > 0; i >>= 8 {
+		size++
+	}
+
+	return size
 }
@@ -1,6 +1,7 @@
 package rlp_test
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -66,3 +67,58 @@ func TestValue_Encode(t *testing.T) {
 		require.Equal(t, "0x820400", encoded)
 	}
 }
+
+func TestItem_Encode(t *testing.T) {
+	long := make([]byte, 256)
+	cases := []struct {
+		item     rlp.Item
+		expected string
+	}{
+		{rlp.Item{Bytes: []byte("dog")}, "83646f67"},
+		{rlp.Item{List: []rlp.Item{{Bytes: []byte("cat")}, {Bytes: []byte("dog")}}}, "c88363617483646f67"},
+		{rlp.Item{Bytes: []byte{}}, "80"},
+		{rlp.Item{}, "c0"},
+		{rlp.Item{Bytes: []byte{0x00}}, "00"},
+		{rlp.Item{Bytes: []byte{0x7f}}, "7f"},
+		{rlp.Item{Bytes: []byte{0x80}}, "8180"},
+		{rlp.Item{Bytes: long[:55]}, "b7" + hex.EncodeToString(long[:55])},
+		{rlp.Item{Bytes: long[:56]}, "b838" + hex.EncodeToString(long[:56])},
+		{rlp.Item{Bytes: long}, "b90100" + hex.EncodeToString(long)},
+		// the set theoretical representation of three: [ [], [[]], [ [], [[]] ] ]
+		{rlp.Item{List: []rlp.Item{{}, {List: []rlp.Item{{}}}, {List: []rlp.Item{{}, {List: []rlp.Item{{}}}}}}}, "c7c0c1c0c3c0c1c0"},
+		{rlp.Item{List: []rlp.Item{{Bytes: long[:54]}, {Bytes: []byte{}}}}, "f838b6" + hex.EncodeToString(long[:54]) + "80"},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.expected, hex.EncodeToString(c.item.Encode()))
+		require.Equal(t, "ff"+c.expected, hex.EncodeToString(c.item.AppendEncoded([]byte{0xff})))
+
+		encoded, err := c.item.Value().Encode()
+		require.NoError(t, err)
+		require.Equal(t, "0x"+c.expected, encoded)
+	}
+}
+
+func BenchmarkValue_Encode(b *testing.B) {
+	value, err := rlp.From(block)
+	require.NoError(b, err)
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		if _, err := value.Encode(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkItem_Encode(b *testing.B) {
+	input, err := hex.DecodeString(block[2:])
+	require.NoError(b, err)
+	item, err := rlp.Decode(input)
+	require.NoError(b, err)
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		item.Encode()
+	}
+}
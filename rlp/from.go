@@ -1,7 +1,7 @@
 package rlp
 
 import (
-	"strconv"
+	"encoding/hex"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -13,67 +13,91 @@ func From(input string) (*Value, error) {
 		return nil, errors.New("invalid hex input")
 	}
 
-	value, remainder, err := from(input[2:])
+	b, err := hex.DecodeString(input[2:])
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid hex input")
+	}
+
+	item, err := Decode(b)
+	if err != nil {
+		return nil, err
+	}
+
+	value := item.Value()
+	return &value, nil
+}
+
+// Decode parses RLP encoded bytes into an rlp.Item, whose strings are slices of input.  Like From("0x"), empty
+// input decodes to the empty string.
+func Decode(input []byte) (*Item, error) {
+	return (&decoder{}).decodeAll(input)
+}
+
+// DecodeStrict is like Decode but only accepts canonical RLP, as required by consensus rules, returning a
+// *NonCanonicalError for input that could have been encoded differently.
+func DecodeStrict(input []byte) (*Item, error) {
+	return (&decoder{strict: true}).decodeAll(input)
+}
+
+// decoder parses RLP, optionally enforcing canonical encodings
+type decoder struct {
+	strict bool
+
+	// zeros records the offsets of the strings starting with a zero byte, keyed by their first byte, so that
+	// UnmarshalStrict can locate integers with leading zeroes.
+	zeros map[*byte]int
+}
+
+func (d *decoder) decodeAll(input []byte) (*Item, error) {
+	if len(input) == 0 {
+		return &Item{Bytes: []byte{}}, nil
+	}
+
+	item, remainder, err := d.decode(input, 0)
 	if err != nil {
 		return nil, err
 	}
-	if remainder != "" {
+	if len(remainder) != 0 {
 		return nil, errors.New("extra data at end")
 	}
-	return value, nil
+	return &item, nil
 }
 
-// from parses the input string and returns an rlp.Value and any remaining unparsed text
-func from(input string) (*Value, string, error) {
+// decode parses the first item of input, found at offset of the whole input, and returns it along with the
+// remaining unparsed input
+func (d *decoder) decode(input []byte, offset int) (Item, []byte, error) {
 
 	// This code was heavily assisted by this series of articles:
 	//   https://medium.com/coinmonks/ethereum-under-the-hood-part-3-rlp-decoding-c0c07f5c0714
 	// And of course the RLP wiki spec:
 	//   https://github.com/ethereum/wiki/wiki/RLP
 
-	if input == "" {
-		return &Value{String: "0x"}, "", nil
+	if len(input) == 0 {
+		return Item{}, nil, errors.New("insufficient remaining input for prefix")
 	}
 
-	for _, r := range input {
-		if (r < 'a' || 'f' < r) && (r < 'A' || 'F' < r) && (r < '0' || '9' < r) {
-			return nil, "", errors.New("invalid rune in input")
-		}
-	}
-
-	remainder := input
-	if len(remainder) < 2 {
-		return nil, "", errors.New("insufficient remaining input for prefix")
-	}
-	b := remainder[0:2]
-	p, err := strconv.ParseUint(b, 16, 8)
-	if err != nil {
-		return nil, "", errors.Wrap(err, "could not decode prefix")
-	}
-
-	prefix := byte(p)
+	prefix := input[0]
+	remainder := input[1:]
 
 	switch {
 	// 0x00 - 0x7f - For a single byte whose value is in the [0x00, 0x7f] range, that byte is its own RLP encoding.
 	case /*0x00 <= prefix &&*/ prefix <= 0x7f:
-		// single byte value, append it
-		s := remainder[0:2]
-		remainder = remainder[2:]
-		return &Value{String: "0x" + s}, remainder, nil
+		// single byte value
+		return d.str(input[0:1:1], offset), remainder, nil
 
 	// 0x80 - 0xb7 - Otherwise, if a string is 0-55 bytes long, the RLP encoding consists of a single byte with value
 	//               0x80 plus the length of the string followed by the string. The range of the first byte is thus
 	//               [0x80, 0xb7]
 	case 0x80 <= prefix && prefix <= 0xb7:
 		// short string
-		size := uint64((prefix - 0x80) * 2)
-		if size > uint64(len(remainder)-2) {
-			return nil, "", errors.New("insufficient remaining input for short string")
+		size := int(prefix - 0x80)
+		if size > len(remainder) {
+			return Item{}, nil, errors.New("insufficient remaining input for short string")
+		}
+		if d.strict && size == 1 && remainder[0] <= 0x7f {
+			return Item{}, nil, &NonCanonicalError{Offset: offset, Rule: RuleSingleByte}
 		}
-		remainder = remainder[2:]
-		s := remainder[0:size]
-		remainder = remainder[size:]
-		return &Value{String: "0x" + s}, remainder, nil
+		return d.str(remainder[0:size:size], offset), remainder[size:], nil
 
 	// 0xbb - 0xbf - If a string is more than 55 bytes long, the RLP encoding consists of a single byte with value
 	//               0xb7 plus the length in bytes of the length of the string in binary form, followed by the length
@@ -81,26 +105,14 @@ func from(input string) (*Value, string, error) {
 	//               as \xb9\x04\x00 followed by the string. The range of the first byte is thus [0xb8, 0xbf].
 	case 0xb8 <= prefix && prefix <= 0xbf:
 		// long string
-		sizeSize := int((prefix - 0xb7) * 2)
-		if sizeSize > len(remainder)-2 {
-			return nil, "", errors.New("insufficient remaining input for size of long string")
-		}
-		remainder = remainder[2:]
-
-		size, err := strconv.ParseUint(remainder[0:sizeSize], 16, 64)
+		size, remainder, err := d.longSize(remainder, int(prefix-0xb7), "long string", offset)
 		if err != nil {
-			return nil, "", errors.Wrap(err, "could not decode long string size")
+			return Item{}, nil, err
 		}
-		size *= 2
-		remainder = remainder[sizeSize:]
-
-		if size > uint64(len(remainder)) {
-			return nil, "", errors.New("insufficient remaining input for long string")
+		if d.strict && size < 56 {
+			return Item{}, nil, &NonCanonicalError{Offset: offset, Rule: RuleShortString}
 		}
-
-		s := remainder[0:size]
-		remainder = remainder[size:]
-		return &Value{String: "0x" + s}, remainder, nil
+		return d.str(remainder[0:size:size], offset), remainder[size:], nil
 
 	// 0xc0 - 0xf7 - If the total payload of a list (i.e. the combined length of all its items being RLP encoded) is
 	//               0-55 bytes long, the RLP encoding consists of a single byte with value 0xc0 plus the length of the
@@ -108,72 +120,81 @@ func from(input string) (*Value, string, error) {
 	//               is thus [0xc0, 0xf7]
 	case 0xc0 <= prefix && prefix <= 0xf7:
 		// short list
-		size := uint64((prefix - 0xc0) * 2)
-		// copy the list as is
-		if size > uint64(len(remainder)-2) {
-			return nil, "", errors.New("insufficient remaining input for short list")
+		size := int(prefix - 0xc0)
+		if size > len(remainder) {
+			return Item{}, nil, errors.New("insufficient remaining input for short list")
 		}
-		remainder = remainder[2:]
-		l, err := parseListItems(remainder[0:size])
+		l, err := d.decodeListItems(remainder[0:size], offset+1)
 		if err != nil {
-			return nil, "", err
+			return Item{}, nil, err
 		}
-		remainder = remainder[size:]
-		return &Value{List: l}, remainder, nil
+		return Item{List: l}, remainder[size:], nil
 
 	// 0xf8 - 0xff - If the total payload of a list is more than 55 bytes long, the RLP encoding consists of a single
 	//               byte with value 0xf7 plus the length in bytes of the length of the payload in binary form,
 	//               followed by the length of the payload, followed by the concatenation of the RLP encodings of the
 	//               items. The range of the first byte is thus [0xf8, 0xff]
-	case 0xf8 <= prefix /*&& prefix <= 0xff*/ :
+	default:
 		// long list
-		sizeSize := int((prefix - 0xf7) * 2)
-		if sizeSize > len(remainder)-2 {
-			return nil, "", errors.New("insufficient remaining input for size of long list")
-		}
-		remainder = remainder[2:]
-		size, err := strconv.ParseInt(remainder[0:sizeSize], 16, 64)
+		sizeSize := int(prefix - 0xf7)
+		size, remainder, err := d.longSize(remainder, sizeSize, "long list", offset)
 		if err != nil {
-			return nil, "", errors.Wrap(err, "could not decode long list size")
-		}
-
-		maxsize := int64(^uint64(0) >> 2)
-		if size > maxsize || size < 0 {
-			return nil, "", errors.New("invalid list size")
+			return Item{}, nil, err
 		}
-		size *= 2
-		remainder = remainder[sizeSize:]
-
-		// copy the list as is
-		if size > int64(len(remainder)) {
-			return nil, "", errors.New("insufficient remaining input for short list")
+		if d.strict && size < 56 {
+			return Item{}, nil, &NonCanonicalError{Offset: offset, Rule: RuleShortList}
 		}
-		l, err := parseListItems(remainder[0:size])
+		l, err := d.decodeListItems(remainder[0:size], offset+1+sizeSize)
 		if err != nil {
-			return nil, "", err
+			return Item{}, nil, err
 		}
-		remainder = remainder[size:]
-		return &Value{List: l}, remainder, nil
+		return Item{List: l}, remainder[size:], nil
 	}
+}
 
-	// The golang compiler should recognize that the above switch is exhaustive but doesn't
-	panic("unreachable")
+// str returns the string item b whose encoding starts at offset
+func (d *decoder) str(b []byte, offset int) Item {
+	if d.zeros != nil && len(b) > 0 && b[0] == 0 {
+		d.zeros[&b[0]] = offset
+	}
+
+	return Item{Bytes: b}
 }
 
-// parseListItems breaks an RLP text string into an []rlp.Value slice
-func parseListItems(input string) ([]Value, error) {
-	l := make([]Value, 0)
-	for {
-		if input == "" {
-			break
-		}
+// longSize reads the sizeSize bytes long size of a long string or list, and checks that enough input remains
+func (d *decoder) longSize(input []byte, sizeSize int, kind string, offset int) (int, []byte, error) {
+	if sizeSize > len(input) {
+		return 0, nil, errors.Errorf("insufficient remaining input for size of %s", kind)
+	}
+
+	if d.strict && input[0] == 0 {
+		return 0, nil, &NonCanonicalError{Offset: offset, Rule: RuleSizeLeadingZero}
+	}
+
+	size := uint64(0)
+	for _, b := range input[0:sizeSize] {
+		size = size<<8 | uint64(b)
+	}
+
+	input = input[sizeSize:]
+	if size > uint64(len(input)) {
+		return 0, nil, errors.Errorf("insufficient remaining input for %s", kind)
+	}
+
+	return int(size), input, nil
+}
 
-		v, remainder, err := from(input)
+// decodeListItems breaks the RLP encoded payload of a list, found at offset, into an []rlp.Item slice
+func (d *decoder) decodeListItems(input []byte, offset int) ([]Item, error) {
+	l := make([]Item, 0)
+	for len(input) > 0 {
+		item, remainder, err := d.decode(input, offset)
 		if err != nil {
 			return nil, err
 		}
 
-		l = append(l, *v)
+		l = append(l, item)
+		offset += len(input) - len(remainder)
 		input = remainder
 	}
 
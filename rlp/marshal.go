@@ -0,0 +1,309 @@
+package rlp
+
+import (
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Marshaler is implemented by types that encode themselves as an rlp.Item
+type Marshaler interface {
+	MarshalRLP() (Item, error)
+}
+
+// Unmarshaler is implemented by types that decode themselves from an rlp.Item
+type Unmarshaler interface {
+	UnmarshalRLP(Item) error
+}
+
+var (
+	marshalerType   = reflect.TypeOf((*Marshaler)(nil)).Elem()
+	unmarshalerType = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+	itemType        = reflect.TypeOf(Item{})
+	valueType       = reflect.TypeOf(Value{})
+	bigIntType      = reflect.TypeOf(big.Int{})
+	bigIntPtrType   = reflect.TypeOf((*big.Int)(nil))
+)
+
+// Bytes returns a string Item holding b, where a nil b is the empty string
+func Bytes(b []byte) Item {
+	if b == nil {
+		b = []byte{}
+	}
+
+	return Item{Bytes: b}
+}
+
+// Marshal returns the RLP encoding of v, see MarshalItem
+func Marshal(v interface{}) ([]byte, error) {
+	item, err := MarshalItem(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.Encode(), nil
+}
+
+// MarshalItem converts v to an rlp.Item:
+//
+//   - Marshaler implementations encode themselves
+//   - bool, unsigned integers, *big.Int and big.Int are strings holding their big endian value without leading
+//     zeroes, i.e. zero is the empty string
+//   - strings, []byte and byte arrays are strings
+//   - structs, other slices and arrays are lists, structs holding their exported fields in order
+//   - nil pointers are the empty list if they point to a struct, slice or array, and the empty string otherwise
+//   - rlp.Item and rlp.Value are copied as is
+//
+// Struct fields can be tagged with `rlp:"-"` to be ignored, `rlp:"optional"` for trailing fields that are
+// omitted while they and every following field are zero, `rlp:"tail"` for a final slice whose items are
+// appended to the list of the struct rather than nested in a list of their own, and `rlp:"nil"` for pointers
+// that decode to nil rather than to a pointer to the zero value from an empty string or list.
+func MarshalItem(v interface{}) (Item, error) {
+	rv := reflect.ValueOf(v)
+	if rv.IsValid() && rv.Kind() != reflect.Ptr {
+		// copy the value so that pointer receiver Marshalers are usable
+		p := reflect.New(rv.Type())
+		p.Elem().Set(rv)
+		rv = p.Elem()
+	}
+
+	return marshalValue(rv)
+}
+
+func marshalValue(v reflect.Value) (Item, error) {
+	if !v.IsValid() {
+		return Bytes(nil), nil
+	}
+
+	t := v.Type()
+	switch t {
+	case itemType:
+		return v.Interface().(Item), nil
+	case valueType:
+		return v.Interface().(Value).Item()
+	case bigIntType:
+		if !v.CanAddr() {
+			i := v.Interface().(big.Int)
+			return bigItem(&i)
+		}
+		return bigItem(v.Addr().Interface().(*big.Int))
+	case bigIntPtrType:
+		return bigItem(v.Interface().(*big.Int))
+	}
+
+	switch {
+	case v.Kind() == reflect.Ptr && v.IsNil():
+		return emptyItem(t.Elem()), nil
+	case v.Kind() == reflect.Interface && v.IsNil():
+		return Bytes(nil), nil
+	}
+
+	if t.Implements(marshalerType) {
+		return v.Interface().(Marshaler).MarshalRLP()
+	}
+
+	if v.CanAddr() && reflect.PtrTo(t).Implements(marshalerType) {
+		return v.Addr().Interface().(Marshaler).MarshalRLP()
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return marshalValue(v.Elem())
+	case reflect.Bool:
+		if v.Bool() {
+			return Bytes([]byte{0x01}), nil
+		}
+		return Bytes(nil), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Bytes(uintBytes(v.Uint())), nil
+	case reflect.String:
+		return Bytes([]byte(v.String())), nil
+	case reflect.Slice:
+		if isByteSlice(t) {
+			return Bytes(v.Bytes()), nil
+		}
+		return marshalList(v)
+	case reflect.Array:
+		if isByteSlice(t) {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return Bytes(b), nil
+		}
+		return marshalList(v)
+	case reflect.Struct:
+		return marshalStruct(v)
+	default:
+		return Item{}, errors.Errorf("unsupported type %s", t.String())
+	}
+}
+
+func marshalList(v reflect.Value) (Item, error) {
+	list := make([]Item, v.Len())
+	for i := range list {
+		item, err := marshalValue(v.Index(i))
+		if err != nil {
+			return Item{}, errors.Wrapf(err, "could not encode list item %d", i)
+		}
+		list[i] = item
+	}
+
+	return Item{List: list}, nil
+}
+
+func marshalStruct(v reflect.Value) (Item, error) {
+	fields, err := structFields(v.Type())
+	if err != nil {
+		return Item{}, err
+	}
+
+	// trailing optional fields are omitted while they are zero
+	last := len(fields)
+	for last > 0 && fields[last-1].optional && v.Field(fields[last-1].index).IsZero() {
+		last--
+	}
+
+	list := make([]Item, 0, last)
+	for _, f := range fields[:last] {
+		fv := v.Field(f.index)
+		if f.tail {
+			tail, err := marshalList(fv)
+			if err != nil {
+				return Item{}, errors.Wrapf(err, "could not encode field %s", f.name)
+			}
+			list = append(list, tail.List...)
+			continue
+		}
+
+		item, err := marshalValue(fv)
+		if err != nil {
+			return Item{}, errors.Wrapf(err, "could not encode field %s", f.name)
+		}
+		list = append(list, item)
+	}
+
+	return Item{List: list}, nil
+}
+
+func bigItem(i *big.Int) (Item, error) {
+	if i.Sign() < 0 {
+		return Item{}, errors.New("cannot encode negative big.Int")
+	}
+
+	return Bytes(i.Bytes()), nil
+}
+
+// emptyItem returns the encoding of a nil pointer to t
+func emptyItem(t reflect.Type) Item {
+	switch t.Kind() {
+	case reflect.Struct:
+		if t != bigIntType {
+			return Item{}
+		}
+	case reflect.Slice, reflect.Array:
+		if !isByteSlice(t) {
+			return Item{}
+		}
+	}
+
+	return Bytes(nil)
+}
+
+func uintBytes(i uint64) []byte {
+	b := make([]byte, uint64Size(i))
+	for j := range b {
+		b[len(b)-1-j] = byte(i >> (8 * uint(j)))
+	}
+
+	return b
+}
+
+// isByteSlice reports whether t is a slice or array of bytes that are encoded as a string
+func isByteSlice(t reflect.Type) bool {
+	e := t.Elem()
+	return e.Kind() == reflect.Uint8 && !e.Implements(marshalerType) && !reflect.PtrTo(e).Implements(unmarshalerType)
+}
+
+// field is an encoded field of a struct
+type field struct {
+	index    int
+	name     string
+	optional bool
+	tail     bool
+	nilable  bool
+}
+
+type fieldsResult struct {
+	fields []field
+	err    error
+}
+
+var fieldsCache sync.Map
+
+// structFields returns the encoded fields of struct type t in order, checking their tags
+func structFields(t reflect.Type) ([]field, error) {
+	if cached, ok := fieldsCache.Load(t); ok {
+		r := cached.(fieldsResult)
+		return r.fields, r.err
+	}
+
+	fields, err := parseFields(t)
+	fieldsCache.Store(t, fieldsResult{fields: fields, err: err})
+	return fields, err
+}
+
+func parseFields(t reflect.Type) ([]field, error) {
+	fields := make([]field, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			// unexported
+			continue
+		}
+
+		f := field{index: i, name: sf.Name}
+		tag := sf.Tag.Get("rlp")
+		if tag == "-" {
+			continue
+		}
+
+		for _, option := range strings.Split(tag, ",") {
+			switch strings.TrimSpace(option) {
+			case "":
+			case "optional":
+				f.optional = true
+			case "tail":
+				f.tail = true
+			case "nil":
+				f.nilable = true
+			default:
+				return nil, errors.Errorf("invalid rlp tag %q on field %s of %s", tag, sf.Name, t.String())
+			}
+		}
+
+		if f.tail && (f.optional || sf.Type.Kind() != reflect.Slice) {
+			return nil, errors.Errorf("tail field %s of %s must be a slice and not optional", sf.Name, t.String())
+		}
+
+		if f.nilable && sf.Type.Kind() != reflect.Ptr {
+			return nil, errors.Errorf("nil field %s of %s must be a pointer", sf.Name, t.String())
+		}
+
+		if len(fields) > 0 {
+			previous := fields[len(fields)-1]
+			if previous.tail {
+				return nil, errors.Errorf("tail field %s of %s must be the last field", previous.name, t.String())
+			}
+
+			if previous.optional && !f.optional {
+				return nil, errors.Errorf("field %s of %s must be optional since it follows optional field %s", sf.Name, t.String(), previous.name)
+			}
+		}
+
+		fields = append(fields, f)
+	}
+
+	return fields, nil
+}
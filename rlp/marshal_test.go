@@ -0,0 +1,233 @@
+package rlp_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/rlp"
+)
+
+type pet struct {
+	Name string
+	Legs uint8
+}
+
+type owner struct {
+	Name    []byte
+	Age     uint64
+	Balance *big.Int
+	Savings big.Int
+	Code    [4]byte
+	Active  bool
+	Pet     *pet `rlp:"nil"`
+	Pets    []pet
+	Raw     rlp.Item
+	Cache   string `rlp:"-"`
+	secret  string
+	Nonce   uint64   `rlp:"optional"`
+	Extra   *big.Int `rlp:"optional"`
+}
+
+type tailed struct {
+	Kind uint
+	Rest []string `rlp:"tail"`
+}
+
+// hexString encodes as a string holding its hex decoded value
+type hexString string
+
+func (h hexString) MarshalRLP() (rlp.Item, error) {
+	b, err := hex.DecodeString(string(h))
+	return rlp.Bytes(b), err
+}
+
+func (h *hexString) UnmarshalRLP(item rlp.Item) error {
+	if item.IsList() {
+		return errors.New("hexString must be a string")
+	}
+	*h = hexString(hex.EncodeToString(item.Bytes))
+	return nil
+}
+
+func TestMarshal(t *testing.T) {
+	{
+		// The list [ "cat", "dog" ] = [ 0xc8, 0x83, 'c', 'a', 't', 0x83, 'd', 'o', 'g' ]
+		b, err := rlp.Marshal([]string{"cat", "dog"})
+		require.NoError(t, err)
+		require.Equal(t, "c88363617483646f67", hex.EncodeToString(b))
+
+		b, err = rlp.Marshal(pet{Name: "cat", Legs: 4})
+		require.NoError(t, err)
+		require.Equal(t, "c583636174"+"04", hex.EncodeToString(b))
+
+		b, err = rlp.Marshal(uint64(1024))
+		require.NoError(t, err)
+		require.Equal(t, "820400", hex.EncodeToString(b))
+
+		b, err = rlp.Marshal(uint16(0))
+		require.NoError(t, err)
+		require.Equal(t, "80", hex.EncodeToString(b))
+
+		b, err = rlp.Marshal((*pet)(nil))
+		require.NoError(t, err)
+		require.Equal(t, "c0", hex.EncodeToString(b))
+
+		b, err = rlp.Marshal([]hexString{"0400", "7f"})
+		require.NoError(t, err)
+		require.Equal(t, "c48204007f", hex.EncodeToString(b))
+	}
+
+	o := owner{
+		Name:    []byte("alice"),
+		Age:     42,
+		Balance: big.NewInt(1000000),
+		Code:    [4]byte{0xde, 0xad, 0xbe, 0xef},
+		Active:  true,
+		Pets:    []pet{{"cat", 4}, {"bird", 2}},
+		Raw:     rlp.Item{List: []rlp.Item{rlp.Bytes([]byte{0x01})}},
+		Cache:   "ignored",
+		secret:  "ignored",
+	}
+	o.Savings.SetUint64(7)
+
+	b, err := rlp.Marshal(&o)
+	require.NoError(t, err)
+
+	item, err := rlp.Decode(b)
+	require.NoError(t, err)
+	// the optional fields are omitted, and nil pointers to structs are empty lists
+	require.Len(t, item.List, 9)
+	require.Equal(t, []byte("alice"), item.List[0].Bytes)
+	require.Equal(t, []byte{0x0f, 0x42, 0x40}, item.List[2].Bytes)
+	require.Equal(t, []byte{0x07}, item.List[3].Bytes)
+	require.True(t, item.List[6].IsList())
+	require.Len(t, item.List[6].List, 0)
+
+	decoded := owner{}
+	require.NoError(t, rlp.Unmarshal(b, &decoded))
+	o.Cache, o.secret = "", ""
+	require.Equal(t, o, decoded)
+
+	// optional fields are encoded once any of them is set
+	o.Extra = big.NewInt(5)
+	o.Pet = &pet{"dog", 4}
+	b, err = rlp.Marshal(o)
+	require.NoError(t, err)
+	item, err = rlp.Decode(b)
+	require.NoError(t, err)
+	require.Len(t, item.List, 11)
+	require.Len(t, item.List[9].Bytes, 0)
+
+	decoded = owner{}
+	require.NoError(t, rlp.Unmarshal(b, &decoded))
+	require.Equal(t, o, decoded)
+}
+
+func TestMarshal_Tail(t *testing.T) {
+	b, err := rlp.Marshal(tailed{Kind: 1, Rest: []string{"a", "b"}})
+	require.NoError(t, err)
+	require.Equal(t, "c3016162", hex.EncodeToString(b))
+
+	decoded := tailed{}
+	require.NoError(t, rlp.Unmarshal(b, &decoded))
+	require.Equal(t, tailed{Kind: 1, Rest: []string{"a", "b"}}, decoded)
+
+	require.NoError(t, rlp.Unmarshal([]byte{0xc1, 0x01}, &decoded))
+	require.Equal(t, tailed{Kind: 1, Rest: []string{}}, decoded)
+}
+
+func TestUnmarshal(t *testing.T) {
+	var h hexString
+	require.NoError(t, rlp.Unmarshal([]byte{0x82, 0x04, 0x00}, &h))
+	require.Equal(t, hexString("0400"), h)
+
+	var p *pet
+	require.NoError(t, rlp.Unmarshal([]byte{0xc5, 0x83, 'd', 'o', 'g', 0x04}, &p))
+	require.Equal(t, &pet{"dog", 4}, p)
+	// pointers decode to the zero value rather than nil, which an empty list isn't for a pet
+	require.Error(t, rlp.Unmarshal([]byte{0xc0}, &p))
+
+	zero := uint64(0)
+	b, err := rlp.Marshal(&zero)
+	require.NoError(t, err)
+	var n *uint64
+	require.NoError(t, rlp.Unmarshal(b, &n))
+	require.Equal(t, &zero, n)
+
+	type named struct {
+		Name  *string
+		Owner *string `rlp:"nil"`
+	}
+	empty := ""
+	decoded := named{}
+	require.NoError(t, rlp.Unmarshal([]byte{0xc2, 0x80, 0x80}, &decoded))
+	require.Equal(t, named{Name: &empty}, decoded)
+
+	var item rlp.Item
+	require.NoError(t, rlp.Unmarshal([]byte{0xc5, 0x83, 'd', 'o', 'g', 0x04}, &item))
+	require.Equal(t, []byte("dog"), item.List[0].Bytes)
+
+	var value rlp.Value
+	require.NoError(t, rlp.Unmarshal([]byte{0xc5, 0x83, 'd', 'o', 'g', 0x04}, &value))
+	require.Equal(t, "0x646f67", value.List[0].String)
+
+	for _, c := range []struct {
+		input []byte
+		into  interface{}
+		err   string
+	}{
+		{[]byte{0x82, 0x04, 0x00}, new(uint8), "overflows uint8"},
+		{[]byte{0x82, 0x04, 0x00}, new([4]byte), "expected 4 bytes"},
+		{[]byte{0xc2, 0x01, 0x02}, new([3]uint), "expected 3 items"},
+		{[]byte{0xc4, 0x83, 'd', 'o', 'g'}, new(pet), "expected 2 items but only received 1"},
+		{[]byte{0xc6, 0x83, 'd', 'o', 'g', 0x04, 0x05}, new(pet), "expected at most 2 items but received 3"},
+		{[]byte{0xc5, 0x83, 'd', 'o', 'g', 0xc0}, new(pet), "could not decode list item 1 to Legs"},
+		{[]byte{0x02}, new(bool), "invalid bool"},
+		{[]byte{0x02}, new([]string), "cannot decode RLP string"},
+		{[]byte{0xc0}, new(string), "cannot decode RLP list"},
+		{[]byte{0x02}, new(int), "unsupported type int"},
+		{[]byte{0xc0}, new(hexString), "hexString must be a string"},
+	} {
+		err := rlp.Unmarshal(c.input, c.into)
+		require.Error(t, err, "%x", c.input)
+		require.True(t, strings.Contains(err.Error(), c.err), err.Error())
+	}
+
+	require.Error(t, rlp.Unmarshal([]byte{0x01}, pet{}))
+	require.Error(t, rlp.Unmarshal([]byte{0x01}, (*pet)(nil)))
+}
+
+func TestMarshal_Errors(t *testing.T) {
+	_, err := rlp.Marshal(big.NewInt(-1))
+	require.Error(t, err)
+
+	_, err = rlp.Marshal(int64(1))
+	require.Error(t, err)
+
+	_, err = rlp.Marshal(struct {
+		A uint `rlp:"optional"`
+		B uint
+	}{})
+	require.Error(t, err)
+
+	_, err = rlp.Marshal(struct {
+		A []uint `rlp:"tail"`
+		B uint
+	}{})
+	require.Error(t, err)
+
+	_, err = rlp.Marshal(struct {
+		A uint `rlp:"tail"`
+	}{})
+	require.Error(t, err)
+
+	_, err = rlp.Marshal(struct {
+		A uint `rlp:"nil"`
+	}{})
+	require.Error(t, err)
+}
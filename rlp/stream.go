@@ -0,0 +1,434 @@
+package rlp
+
+import (
+	"bufio"
+	"io"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// Kind is the kind of an RLP item
+type Kind int
+
+const (
+	// KindString is a byte string, including single bytes below 0x80
+	KindString Kind = iota
+	// KindList is a list of items
+	KindList
+)
+
+func (k Kind) String() string {
+	if k == KindList {
+		return "list"
+	}
+
+	return "string"
+}
+
+// ErrEndOfList is returned when reading past the last item of the current list, see Reader.Exit
+var ErrEndOfList = errors.New("end of list")
+
+// Reader incrementally decodes a stream of RLP items from an io.Reader, only reading the strings it is asked for
+// into memory.  Lists are traversed with Enter and Exit, and items can be skipped without being read.
+type Reader struct {
+	r interface {
+		io.Reader
+		io.ByteReader
+	}
+
+	offset int64
+
+	// lists holds the remaining payload size of each entered list
+	lists []uint64
+
+	// header is the header of the next item once peeked
+	header *header
+}
+
+// header is a decoded item prefix
+type header struct {
+	kind Kind
+
+	// size is the payload size, or 0 for a single byte below 0x80 which is its own encoding
+	size uint64
+
+	raw []byte
+}
+
+// single reports whether the item is a single byte below 0x80
+func (h *header) single() bool {
+	return len(h.raw) == 1 && h.raw[0] <= 0x7f
+}
+
+// NewReader returns a Reader decoding r, which is buffered unless it's already an io.ByteReader
+func NewReader(r io.Reader) *Reader {
+	if br, ok := r.(interface {
+		io.Reader
+		io.ByteReader
+	}); ok {
+		return &Reader{r: br}
+	}
+
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Offset returns the number of bytes read from the stream so far
+func (r *Reader) Offset() int64 {
+	return r.offset
+}
+
+// Kind returns the kind of the next item and the size of its payload without consuming it, io.EOF once the
+// stream ends outside of any list, and ErrEndOfList at the end of the current list.
+func (r *Reader) Kind() (Kind, uint64, error) {
+	h, err := r.peek()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if h.single() {
+		return KindString, 1, nil
+	}
+
+	return h.kind, h.size, nil
+}
+
+// More reports whether the current list has items left to read, which is always the case outside of any list
+func (r *Reader) More() bool {
+	return len(r.lists) == 0 || r.lists[len(r.lists)-1] > 0 || r.header != nil
+}
+
+// Enter starts reading the items of the next item, which must be a list, and returns its payload size
+func (r *Reader) Enter() (uint64, error) {
+	h, err := r.peek()
+	if err != nil {
+		return 0, err
+	}
+
+	if h.kind != KindList {
+		return 0, errors.New("cannot enter RLP string")
+	}
+
+	r.header = nil
+	r.lists = append(r.lists, h.size)
+	return h.size, nil
+}
+
+// Exit finishes reading the current list, all items of which must have been read or skipped
+func (r *Reader) Exit() error {
+	if len(r.lists) == 0 {
+		return errors.New("not in a list")
+	}
+
+	if r.header != nil || r.lists[len(r.lists)-1] > 0 {
+		return errors.New("list has unread items")
+	}
+
+	r.lists = r.lists[:len(r.lists)-1]
+	return nil
+}
+
+// ReadString returns the next item, which must be a string of at most limit bytes.  Too long strings are left
+// unread so that they can be skipped.
+func (r *Reader) ReadString(limit uint64) ([]byte, error) {
+	h, err := r.peek()
+	if err != nil {
+		return nil, err
+	}
+
+	if h.kind != KindString {
+		return nil, errors.New("cannot read RLP list as a string")
+	}
+
+	size := h.size
+	if h.single() {
+		size = 1
+	}
+	if size > limit {
+		return nil, errors.Errorf("string of %d bytes exceeds limit of %d", size, limit)
+	}
+
+	if h.single() {
+		r.header = nil
+		return []byte{h.raw[0]}, nil
+	}
+
+	r.header = nil
+	b := make([]byte, h.size)
+	if err := r.read(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// ReadUint64 returns the next item, which must be a string of at most 8 bytes, as a big endian integer
+func (r *Reader) ReadUint64() (uint64, error) {
+	b, err := r.ReadString(8)
+	if err != nil {
+		return 0, err
+	}
+
+	i := uint64(0)
+	for _, c := range b {
+		i = i<<8 | uint64(c)
+	}
+
+	return i, nil
+}
+
+// ReadRaw returns the RLP encoding of the next item, which must be at most limit bytes long.  Too long items are
+// left unread so that they can be skipped.
+func (r *Reader) ReadRaw(limit uint64) ([]byte, error) {
+	h, err := r.peek()
+	if err != nil {
+		return nil, err
+	}
+
+	size := uint64(len(h.raw)) + h.size
+	if size > limit {
+		return nil, errors.Errorf("%s of %d bytes exceeds limit of %d", h.kind, size, limit)
+	}
+
+	r.header = nil
+	b := make([]byte, size)
+	copy(b, h.raw)
+	if err := r.read(b[len(h.raw):]); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// ReadItem decodes the next item, whose encoding must be at most limit bytes long, see ReadRaw
+func (r *Reader) ReadItem(limit uint64) (*Item, error) {
+	b, err := r.ReadRaw(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return Decode(b)
+}
+
+// Skip discards the next item
+func (r *Reader) Skip() error {
+	h, err := r.peek()
+	if err != nil {
+		return err
+	}
+
+	r.header = nil
+	n, err := io.CopyN(io.Discard, r.r, int64(h.size))
+	r.offset += n
+	if err != nil {
+		return unexpected(err)
+	}
+
+	return nil
+}
+
+// peek reads the header of the next item unless already read
+func (r *Reader) peek() (*header, error) {
+	if r.header != nil {
+		return r.header, nil
+	}
+
+	if len(r.lists) > 0 && r.lists[len(r.lists)-1] == 0 {
+		return nil, ErrEndOfList
+	}
+
+	prefix, err := r.r.ReadByte()
+	if err != nil {
+		if err == io.EOF && len(r.lists) > 0 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	r.offset++
+
+	h := header{raw: []byte{prefix}}
+	switch {
+	case prefix <= 0x7f:
+		h.kind = KindString
+	case prefix <= 0xb7:
+		h.kind, h.size = KindString, uint64(prefix-0x80)
+	case prefix <= 0xbf:
+		h.kind = KindString
+		err = r.readSize(&h, int(prefix-0xb7))
+	case prefix <= 0xf7:
+		h.kind, h.size = KindList, uint64(prefix-0xc0)
+	default:
+		h.kind = KindList
+		err = r.readSize(&h, int(prefix-0xf7))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the whole item is consumed from the enclosing list as soon as its header is read
+	if len(r.lists) > 0 {
+		remaining := &r.lists[len(r.lists)-1]
+		size := uint64(len(h.raw)) + h.size
+		if size < h.size || size > *remaining {
+			return nil, errors.Errorf("%s of %d bytes overflows enclosing list", h.kind, h.size)
+		}
+		*remaining -= size
+	}
+
+	r.header = &h
+	return r.header, nil
+}
+
+// readSize reads the sizeSize bytes long size of a long string or list into h
+func (r *Reader) readSize(h *header, sizeSize int) error {
+	b := make([]byte, sizeSize)
+	if err := r.read(b); err != nil {
+		return err
+	}
+
+	h.raw = append(h.raw, b...)
+	for _, c := range b {
+		h.size = h.size<<8 | uint64(c)
+	}
+	if sizeSize == 8 && h.size > math.MaxInt64 {
+		return errors.Errorf("%s size %d is too large", h.kind, h.size)
+	}
+
+	return nil
+}
+
+// read fills b from the stream
+func (r *Reader) read(b []byte) error {
+	n, err := io.ReadFull(r.r, b)
+	r.offset += int64(n)
+	return unexpected(err)
+}
+
+// unexpected turns io.EOF into io.ErrUnexpectedEOF, since an item was expected
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// Writer incrementally encodes RLP items to an io.Writer.  Lists started with StartList are buffered until
+// EndList since their size is needed for their header, while lists started with StartListSized are written
+// through.
+type Writer struct {
+	w io.Writer
+
+	// buf holds the payloads of the open buffered lists
+	buf []byte
+
+	lists    []*list
+	buffered int
+}
+
+// list is an open list of a Writer
+type list struct {
+	// size is the declared payload size, or -1 for buffered lists
+	size int64
+
+	// written is the payload size written so far
+	written int64
+
+	// start is the offset in the buffer of the payload of buffered lists
+	start int
+}
+
+// NewWriter returns a Writer encoding to w
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// WriteString writes b as an RLP string
+func (w *Writer) WriteString(b []byte) error {
+	if len(b) == 1 && b[0] <= 0x7f {
+		return w.write(b)
+	}
+
+	if err := w.write(appendHeader(nil, 0x80, uint64(len(b)))); err != nil {
+		return err
+	}
+
+	return w.write(b)
+}
+
+// WriteUint64 writes i as an RLP string without leading zero bytes
+func (w *Writer) WriteUint64(i uint64) error {
+	return w.WriteString(uintBytes(i))
+}
+
+// WriteItem writes the encoding of item
+func (w *Writer) WriteItem(item Item) error {
+	return w.write(item.Encode())
+}
+
+// WriteRaw writes b, which must be the encoding of one or more RLP items
+func (w *Writer) WriteRaw(b []byte) error {
+	return w.write(b)
+}
+
+// StartList starts a list whose payload is buffered until EndList
+func (w *Writer) StartList() {
+	w.lists = append(w.lists, &list{size: -1, start: len(w.buf)})
+	w.buffered++
+}
+
+// StartListSized starts a list with a payload of exactly size bytes, which is written through as it is written
+// unless an enclosing list is buffered.
+func (w *Writer) StartListSized(size uint64) error {
+	if err := w.write(appendHeader(nil, 0xc0, size)); err != nil {
+		return err
+	}
+
+	w.lists = append(w.lists, &list{size: int64(size)})
+	return nil
+}
+
+// EndList ends the list started last
+func (w *Writer) EndList() error {
+	if len(w.lists) == 0 {
+		return errors.New("not in a list")
+	}
+
+	l := w.lists[len(w.lists)-1]
+	w.lists = w.lists[:len(w.lists)-1]
+
+	if l.size >= 0 {
+		if l.written != l.size {
+			return errors.Errorf("list of %d bytes ended after %d bytes", l.size, l.written)
+		}
+		if len(w.lists) > 0 {
+			w.lists[len(w.lists)-1].written += l.size
+		}
+		return nil
+	}
+
+	w.buffered--
+	payload := w.buf[l.start:]
+	encoded := append(appendHeader(nil, 0xc0, uint64(len(payload))), payload...)
+	w.buf = w.buf[:l.start]
+	return w.write(encoded)
+}
+
+// write writes encoded items to the current list
+func (w *Writer) write(b []byte) error {
+	if len(w.lists) > 0 {
+		l := w.lists[len(w.lists)-1]
+		l.written += int64(len(b))
+		if l.size >= 0 && l.written > l.size {
+			return errors.Errorf("list of %d bytes overflowed", l.size)
+		}
+	}
+
+	if w.buffered > 0 {
+		w.buf = append(w.buf, b...)
+		return nil
+	}
+
+	_, err := w.w.Write(b)
+	return err
+}
@@ -0,0 +1,189 @@
+package rlp_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/rlp"
+)
+
+func TestReader(t *testing.T) {
+	b, err := hex.DecodeString(block[2:])
+	require.NoError(t, err)
+	expected, err := rlp.Decode(b)
+	require.NoError(t, err)
+
+	// a block is a list of the header, the transactions and the uncles
+	r := rlp.NewReader(bytes.NewReader(b))
+	kind, _, err := r.Kind()
+	require.NoError(t, err)
+	require.Equal(t, rlp.KindList, kind)
+	_, err = r.Enter()
+	require.NoError(t, err)
+
+	header, err := r.ReadItem(1024)
+	require.NoError(t, err)
+	require.Equal(t, expected.List[0], *header)
+
+	_, err = r.Enter()
+	require.NoError(t, err)
+	count := 0
+	for r.More() {
+		raw, err := r.ReadRaw(128 * 1024)
+		require.NoError(t, err)
+		require.Equal(t, expected.List[1].List[count].Encode(), raw)
+		count++
+	}
+	require.Equal(t, len(expected.List[1].List), count)
+	_, err = r.ReadRaw(1024)
+	require.Equal(t, rlp.ErrEndOfList, err)
+	require.NoError(t, r.Exit())
+
+	require.NoError(t, r.Skip())
+	require.NoError(t, r.Exit())
+	require.Equal(t, int64(len(b)), r.Offset())
+
+	_, _, err = r.Kind()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestReader_Strings(t *testing.T) {
+	// [ "cat", 0x05, "", [ 1024 ] ]
+	r := rlp.NewReader(bytes.NewReader([]byte{0xca, 0x83, 'c', 'a', 't', 0x05, 0x80, 0xc3, 0x82, 0x04, 0x00}))
+	_, err := r.Enter()
+	require.NoError(t, err)
+
+	// too long strings are left to be skipped
+	_, err = r.ReadString(2)
+	require.Error(t, err)
+	kind, size, err := r.Kind()
+	require.NoError(t, err)
+	require.Equal(t, rlp.KindString, kind)
+	require.Equal(t, uint64(3), size)
+	s, err := r.ReadString(3)
+	require.NoError(t, err)
+	require.Equal(t, []byte("cat"), s)
+
+	_, size, err = r.Kind()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), size)
+	s, err = r.ReadString(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x05}, s)
+
+	s, err = r.ReadString(0)
+	require.NoError(t, err)
+	require.Equal(t, []byte{}, s)
+
+	_, err = r.ReadString(1024)
+	require.Error(t, err)
+	require.Error(t, r.Exit())
+	_, err = r.Enter()
+	require.NoError(t, err)
+	i, err := r.ReadUint64()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1024), i)
+	require.NoError(t, r.Exit())
+	require.False(t, r.More())
+	require.NoError(t, r.Exit())
+	require.Error(t, r.Exit())
+}
+
+func TestReader_Errors(t *testing.T) {
+	for _, c := range []struct {
+		input string
+		read  func(r *rlp.Reader) error
+	}{
+		// truncated string
+		{"83ca", func(r *rlp.Reader) error {
+			_, err := r.ReadString(3)
+			return err
+		}},
+		// truncated list
+		{"c3ca", func(r *rlp.Reader) error {
+			if _, err := r.Enter(); err != nil {
+				return err
+			}
+			_, err := r.ReadString(3)
+			return err
+		}},
+		// list item larger than the list
+		{"c283636174", func(r *rlp.Reader) error {
+			if _, err := r.Enter(); err != nil {
+				return err
+			}
+			return r.Skip()
+		}},
+		// size larger than the input
+		{"bf7fffffffffffffff", func(r *rlp.Reader) error {
+			return r.Skip()
+		}},
+		// size overflowing an int64
+		{"bfffffffffffffffff", func(r *rlp.Reader) error {
+			return r.Skip()
+		}},
+		{"83636174", func(r *rlp.Reader) error {
+			_, err := r.Enter()
+			return err
+		}},
+		{"c0", func(r *rlp.Reader) error {
+			_, err := r.ReadString(1)
+			return err
+		}},
+		{"820400", func(r *rlp.Reader) error {
+			_, err := r.ReadRaw(2)
+			return err
+		}},
+	} {
+		input, err := hex.DecodeString(c.input)
+		require.NoError(t, err)
+		require.Error(t, c.read(rlp.NewReader(bytes.NewReader(input))), c.input)
+	}
+}
+
+func TestWriter(t *testing.T) {
+	b, err := hex.DecodeString(block[2:])
+	require.NoError(t, err)
+	expected, err := rlp.Decode(b)
+	require.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	w := rlp.NewWriter(&buf)
+
+	// the header is streamed as is, the transactions buffered, and the uncles written as an item
+	w.StartList()
+	require.NoError(t, w.WriteRaw(expected.List[0].Encode()))
+	w.StartList()
+	for _, tx := range expected.List[1].List {
+		require.NoError(t, w.WriteItem(tx))
+	}
+	require.NoError(t, w.EndList())
+	require.NoError(t, w.WriteItem(expected.List[2]))
+	require.NoError(t, w.EndList())
+	require.Equal(t, b, buf.Bytes())
+
+	// sized lists are written through
+	buf.Reset()
+	require.NoError(t, w.StartListSized(9))
+	require.NoError(t, w.WriteString([]byte("cat")))
+	require.Equal(t, []byte{0xc9, 0x83, 'c', 'a', 't'}, buf.Bytes())
+	w.StartList()
+	require.NoError(t, w.WriteUint64(1024))
+	require.NoError(t, w.WriteUint64(0))
+	require.Equal(t, 5, buf.Len())
+	require.NoError(t, w.EndList())
+	require.NoError(t, w.EndList())
+	require.Equal(t, "c983636174c482040080", hex.EncodeToString(buf.Bytes()))
+
+	// the sizes of sized lists are checked
+	require.NoError(t, w.StartListSized(2))
+	require.NoError(t, w.WriteUint64(1))
+	require.Error(t, w.EndList())
+	require.NoError(t, w.StartListSized(1))
+	require.Error(t, w.WriteString([]byte("cat")))
+	require.Error(t, rlp.NewWriter(&buf).EndList())
+}
@@ -0,0 +1,54 @@
+package rlp
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Rule is a canonical encoding rule enforced by strict decoding
+type Rule string
+
+const (
+	// RuleSingleByte requires single bytes below 0x80 to be their own encoding, without a string header
+	RuleSingleByte Rule = "single byte below 0x80 must not have a string header"
+	// RuleShortString requires strings shorter than 56 bytes to use the short form header
+	RuleShortString Rule = "string shorter than 56 bytes must not use the long form"
+	// RuleShortList requires lists with payloads shorter than 56 bytes to use the short form header
+	RuleShortList Rule = "list shorter than 56 bytes must not use the long form"
+	// RuleSizeLeadingZero requires the sizes of the long forms to have no leading zero bytes
+	RuleSizeLeadingZero Rule = "size must not have leading zero bytes"
+	// RuleIntegerLeadingZero requires integers to have no leading zero bytes, zero being the empty string
+	RuleIntegerLeadingZero Rule = "integer must not have leading zero bytes"
+)
+
+// NonCanonicalError is returned by strict decoding for input that isn't canonical RLP
+type NonCanonicalError struct {
+	// Offset is the position in the input of the first byte of the offending item
+	Offset int
+	Rule   Rule
+}
+
+func (e *NonCanonicalError) Error() string {
+	return fmt.Sprintf("non-canonical RLP at offset %d: %s", e.Offset, e.Rule)
+}
+
+// Integer is implemented by Unmarshalers that decode integers, so that UnmarshalStrict rejects leading zero
+// bytes for them like it does for unsigned integers and big.Int
+type Integer interface {
+	Unmarshaler
+	RLPInteger()
+}
+
+var integerType = reflect.TypeOf((*Integer)(nil)).Elem()
+
+// UnmarshalStrict is like Unmarshal but only accepts canonical RLP, see DecodeStrict, and integers without
+// leading zero bytes.
+func UnmarshalStrict(data []byte, v interface{}) error {
+	d := decoder{strict: true, zeros: map[*byte]int{}}
+	item, err := d.decodeAll(data)
+	if err != nil {
+		return err
+	}
+
+	return (&unmarshaler{strict: true, zeros: d.zeros}).unmarshal(*item, v)
+}
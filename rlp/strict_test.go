@@ -0,0 +1,91 @@
+package rlp_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/INFURA/go-ethlibs/rlp"
+)
+
+func TestDecodeStrict(t *testing.T) {
+	long := make([]byte, 60)
+	for _, c := range []struct {
+		input  string
+		offset int
+		rule   rlp.Rule
+	}{
+		{"8100", 0, rlp.RuleSingleByte},
+		{"817f", 0, rlp.RuleSingleByte},
+		{"c3018105", 2, rlp.RuleSingleByte},
+		{"b80161", 0, rlp.RuleShortString},
+		{"c4b8026162", 1, rlp.RuleShortString},
+		{"f80180", 0, rlp.RuleShortList},
+		{"b9003c" + hex.EncodeToString(long), 0, rlp.RuleSizeLeadingZero},
+		{"c2c0f90001", 3, rlp.RuleSizeLeadingZero},
+		{"f840c0b83e3c" + hex.EncodeToString(long), 0, rlp.RuleShortList},
+	} {
+		input, err := hex.DecodeString(c.input)
+		require.NoError(t, err)
+
+		_, err = rlp.Decode(input)
+		if err == nil {
+			_, err = rlp.DecodeStrict(input)
+			require.Error(t, err, c.input)
+			nc, ok := errors.Cause(err).(*rlp.NonCanonicalError)
+			require.True(t, ok, err.Error())
+			require.Equal(t, c.offset, nc.Offset, c.input)
+			require.Equal(t, c.rule, nc.Rule, c.input)
+			continue
+		}
+
+		// the input is invalid either way
+		_, err = rlp.DecodeStrict(input)
+		require.Error(t, err, c.input)
+	}
+
+	// canonical encodings are accepted
+	for _, c := range []string{"00", "7f", "8180", "80", "c0", "b838" + hex.EncodeToString(long[:56]), "c88363617483646f67", block[2:]} {
+		input, err := hex.DecodeString(c)
+		require.NoError(t, err)
+		decoded, err := rlp.DecodeStrict(input)
+		require.NoError(t, err)
+		require.Equal(t, input, decoded.Encode())
+	}
+}
+
+func TestUnmarshalStrict(t *testing.T) {
+	type account struct {
+		Nonce   uint64
+		Balance *big.Int
+		Code    []byte
+	}
+
+	var a account
+	require.NoError(t, rlp.UnmarshalStrict([]byte{0xc5, 0x01, 0x82, 0x01, 0x00, 0x00}, &a))
+	require.Equal(t, account{Nonce: 1, Balance: big.NewInt(256), Code: []byte{0x00}}, a)
+
+	for _, c := range []struct {
+		input  []byte
+		offset int
+	}{
+		{[]byte{0xc5, 0x00, 0x82, 0x01, 0x00, 0x80}, 1},
+		{[]byte{0xc5, 0x01, 0x82, 0x00, 0x01, 0x80}, 2},
+	} {
+		require.NoError(t, rlp.Unmarshal(c.input, &a))
+
+		err := rlp.UnmarshalStrict(c.input, &a)
+		require.Error(t, err)
+		nc, ok := errors.Cause(err).(*rlp.NonCanonicalError)
+		require.True(t, ok, err.Error())
+		require.Equal(t, c.offset, nc.Offset)
+		require.Equal(t, rlp.RuleIntegerLeadingZero, nc.Rule)
+	}
+
+	err := rlp.UnmarshalStrict([]byte{0xc4, 0x81, 0x01, 0x80, 0x80}, &a)
+	require.Error(t, err)
+	require.Equal(t, "non-canonical RLP at offset 1: single byte below 0x80 must not have a string header", err.Error())
+}
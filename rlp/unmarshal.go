@@ -0,0 +1,265 @@
+package rlp
+
+import (
+	"math/big"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// Unmarshal decodes the RLP encoded data into v, see UnmarshalItem
+func Unmarshal(data []byte, v interface{}) error {
+	item, err := Decode(data)
+	if err != nil {
+		return err
+	}
+
+	return UnmarshalItem(*item, v)
+}
+
+// UnmarshalItem decodes item into v, which must be a non-nil pointer, following the rules of MarshalItem.
+// Additionally:
+//
+//   - pointers are allocated and decoded into, so that the empty string or list decodes to a pointer to the zero
+//     value, unless the pointer is a struct field tagged `rlp:"nil"` which is set to nil instead
+//   - byte arrays and fixed size arrays require exactly as many bytes or items as their length
+//   - optional struct fields that are missing from the list are set to their zero value
+//   - rlp.Item values share memory with item, everything else is copied
+func UnmarshalItem(item Item, v interface{}) error {
+	return (&unmarshaler{}).unmarshal(item, v)
+}
+
+// unmarshaler decodes items into Go values, strict ones rejecting integers with leading zero bytes
+type unmarshaler struct {
+	strict bool
+
+	// zeros are the offsets of the strings starting with a zero byte, see decoder
+	zeros map[*byte]int
+}
+
+func (u *unmarshaler) unmarshal(item Item, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("can only decode into a non-nil pointer")
+	}
+
+	return u.unmarshalValue(item, rv.Elem())
+}
+
+func (u *unmarshaler) unmarshalValue(item Item, v reflect.Value) error {
+	t := v.Type()
+	switch t {
+	case itemType:
+		v.Set(reflect.ValueOf(item))
+		return nil
+	case valueType:
+		v.Set(reflect.ValueOf(item.Value()))
+		return nil
+	case bigIntType, bigIntPtrType:
+		b, err := u.integerBytes(item, t)
+		if err != nil {
+			return err
+		}
+		i := new(big.Int).SetBytes(b)
+		if t == bigIntType {
+			v.Set(reflect.ValueOf(i).Elem())
+		} else {
+			v.Set(reflect.ValueOf(i))
+		}
+		return nil
+	}
+
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(t.Elem()))
+		}
+		return u.unmarshalValue(item, v.Elem())
+	}
+
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		if reflect.PtrTo(t).Implements(integerType) {
+			if _, err := u.integerBytes(item, t); err != nil {
+				return err
+			}
+		}
+		return v.Addr().Interface().(Unmarshaler).UnmarshalRLP(item)
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		b, err := stringBytes(item, t)
+		if err != nil {
+			return err
+		}
+		switch {
+		case len(b) == 0:
+			v.SetBool(false)
+		case len(b) == 1 && b[0] == 0x01:
+			v.SetBool(true)
+		default:
+			return errors.Errorf("invalid bool 0x%x", b)
+		}
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		b, err := u.integerBytes(item, t)
+		if err != nil {
+			return err
+		}
+		if len(b) > int(t.Size()) {
+			return errors.Errorf("0x%x overflows %s", b, t.String())
+		}
+		i := uint64(0)
+		for _, c := range b {
+			i = i<<8 | uint64(c)
+		}
+		v.SetUint(i)
+		return nil
+	case reflect.String:
+		b, err := stringBytes(item, t)
+		if err != nil {
+			return err
+		}
+		v.SetString(string(b))
+		return nil
+	case reflect.Slice:
+		if isByteSlice(t) {
+			b, err := stringBytes(item, t)
+			if err != nil {
+				return err
+			}
+			v.SetBytes(append([]byte{}, b...))
+			return nil
+		}
+
+		if !item.IsList() {
+			return errors.Errorf("cannot decode RLP string into %s", t.String())
+		}
+		s := reflect.MakeSlice(t, len(item.List), len(item.List))
+		if err := u.unmarshalList(item.List, s); err != nil {
+			return err
+		}
+		v.Set(s)
+		return nil
+	case reflect.Array:
+		if isByteSlice(t) {
+			b, err := stringBytes(item, t)
+			if err != nil {
+				return err
+			}
+			if len(b) != v.Len() {
+				return errors.Errorf("expected %d bytes for %s but received %d", v.Len(), t.String(), len(b))
+			}
+			reflect.Copy(v, reflect.ValueOf(b))
+			return nil
+		}
+
+		if !item.IsList() {
+			return errors.Errorf("cannot decode RLP string into %s", t.String())
+		}
+		if len(item.List) != v.Len() {
+			return errors.Errorf("expected %d items for %s but received %d", v.Len(), t.String(), len(item.List))
+		}
+		return u.unmarshalList(item.List, v)
+	case reflect.Struct:
+		return u.unmarshalStruct(item, v)
+	default:
+		return errors.Errorf("unsupported type %s", t.String())
+	}
+}
+
+// unmarshalList decodes list into the items of the slice or array v, which must have the same length
+func (u *unmarshaler) unmarshalList(list []Item, v reflect.Value) error {
+	for i := range list {
+		if err := u.unmarshalValue(list[i], v.Index(i)); err != nil {
+			return errors.Wrapf(err, "could not decode list item %d", i)
+		}
+	}
+
+	return nil
+}
+
+func (u *unmarshaler) unmarshalStruct(item Item, v reflect.Value) error {
+	fields, err := structFields(v.Type())
+	if err != nil {
+		return err
+	}
+
+	required := 0
+	for _, f := range fields {
+		if !f.optional && !f.tail {
+			required++
+		}
+	}
+
+	if len(item.List) < required {
+		return errors.Errorf("expected %d items but only received %d", required, len(item.List))
+	}
+
+	if !item.IsList() {
+		return errors.Errorf("cannot decode RLP string into %s", v.Type().String())
+	}
+
+	list := item.List
+	for _, f := range fields {
+		fv := v.Field(f.index)
+		if f.tail {
+			s := reflect.MakeSlice(fv.Type(), len(list), len(list))
+			if err := u.unmarshalList(list, s); err != nil {
+				return errors.Wrapf(err, "could not decode field %s", f.name)
+			}
+			fv.Set(s)
+			list = nil
+			break
+		}
+
+		if len(list) == 0 {
+			// missing optional field
+			fv.Set(reflect.Zero(fv.Type()))
+			continue
+		}
+
+		if f.nilable && len(list[0].Bytes) == 0 && len(list[0].List) == 0 {
+			fv.Set(reflect.Zero(fv.Type()))
+			list = list[1:]
+			continue
+		}
+
+		if err := u.unmarshalValue(list[0], fv); err != nil {
+			return errors.Wrapf(err, "could not decode list item %d to %s", len(item.List)-len(list), f.name)
+		}
+		list = list[1:]
+	}
+
+	if len(list) > 0 {
+		return errors.Errorf("expected at most %d items but received %d", len(item.List)-len(list), len(item.List))
+	}
+
+	return nil
+}
+
+// stringBytes returns the bytes of item, which must be a string to decode into t
+func stringBytes(item Item, t reflect.Type) ([]byte, error) {
+	if item.IsList() {
+		return nil, errors.Errorf("cannot decode RLP list into %s", t.String())
+	}
+
+	return item.Bytes, nil
+}
+
+// integerBytes returns the bytes of item, which must be a string to decode into the integer type t
+func (u *unmarshaler) integerBytes(item Item, t reflect.Type) ([]byte, error) {
+	b, err := stringBytes(item, t)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.strict && len(b) > 0 && b[0] == 0 {
+		offset, ok := u.zeros[&b[0]]
+		if !ok {
+			offset = -1
+		}
+		return nil, &NonCanonicalError{Offset: offset, Rule: RuleIntegerLeadingZero}
+	}
+
+	return b, nil
+}
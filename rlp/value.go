@@ -1,5 +1,12 @@
 package rlp
 
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
 // Value represents a decoded RLP value, which is either a String or List.
 type Value struct {
 	// Only one of String or List is valid.  If String is "" then List is assumed valid.
@@ -14,3 +21,66 @@ func (v *Value) IsList() bool {
 func (v *Value) IsString() bool {
 	return v.String != ""
 }
+
+// Item is the byte oriented counterpart of Value, holding strings as raw bytes rather than hex.  Items returned
+// by Decode share memory with the decoded input instead of copying it.
+type Item struct {
+	// Only one of Bytes or List is valid.  If Bytes is nil then List is assumed valid, the empty string is a
+	// non-nil empty slice.
+	Bytes []byte
+	List  []Item
+}
+
+func (i *Item) IsList() bool {
+	return i.Bytes == nil
+}
+
+func (i *Item) IsString() bool {
+	return i.Bytes != nil
+}
+
+// Value converts the Item to an rlp.Value
+func (i Item) Value() Value {
+	if i.IsString() {
+		return Value{String: "0x" + hex.EncodeToString(i.Bytes)}
+	}
+
+	list := make([]Value, len(i.List))
+	for j := range i.List {
+		list[j] = i.List[j].Value()
+	}
+
+	return Value{List: list}
+}
+
+// Item converts the Value to an rlp.Item, failing if any of its strings isn't 0x prefixed hex
+func (v Value) Item() (Item, error) {
+	if v.IsString() {
+		if !strings.HasPrefix(v.String, "0x") {
+			return Item{}, errors.New("invalid string value before encoding")
+		}
+
+		b, err := hex.DecodeString(v.String[2:])
+		if err != nil {
+			return Item{}, errors.Wrap(err, "could not decode string value")
+		}
+
+		if b == nil {
+			b = []byte{}
+		}
+
+		return Item{Bytes: b}, nil
+	}
+
+	list := make([]Item, len(v.List))
+	for j := range v.List {
+		item, err := v.List[j].Item()
+		if err != nil {
+			return Item{}, errors.Wrap(err, "could not encode child item")
+		}
+
+		list[j] = item
+	}
+
+	return Item{List: list}, nil
+}